@@ -41,7 +41,7 @@ TF_ACC=1 go test \
     ./internal/enum/... \
     ./internal/envvar/... \
     ./internal/errs/... \
-    ./internal/experimental/... \
+    ./internal/depgraph/... \
     ./internal/flex/... \
     ./internal/framework/... \
     ./internal/function/... \
@@ -58,6 +58,7 @@ TF_ACC=1 go test \
     ./internal/semver/... \
     ./internal/slices/... \
     ./internal/sweep/... \
+    ./internal/sync/... \
     ./internal/tags/... \
     ./internal/tfresource/... \
     ./internal/types/... \
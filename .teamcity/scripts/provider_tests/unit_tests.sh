@@ -13,7 +13,7 @@ go test \
     ./internal/enum/... \
     ./internal/envvar/... \
     ./internal/errs/... \
-    ./internal/experimental/... \
+    ./internal/depgraph/... \
     ./internal/flex/... \
     ./internal/framework/... \
     ./internal/function/... \
@@ -30,6 +30,7 @@ go test \
     ./internal/semver/... \
     ./internal/slices/... \
     ./internal/sweep/... \
+    ./internal/sync/... \
     ./internal/tags/... \
     ./internal/tfresource/... \
     ./internal/types/... \
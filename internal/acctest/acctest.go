@@ -59,7 +59,6 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
-	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 	tfaccount "github.com/hashicorp/terraform-provider-aws/internal/service/account"
 	tfacmpca "github.com/hashicorp/terraform-provider-aws/internal/service/acmpca"
@@ -67,6 +66,7 @@ import (
 	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	tforganizations "github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
 	tfsts "github.com/hashicorp/terraform-provider-aws/internal/service/sts"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 	"github.com/jmespath/go-jmespath"
@@ -160,20 +160,36 @@ func protoV5ProviderFactoriesInit(ctx context.Context, providerNames ...string)
 	return factories
 }
 
-// ProtoV6ProviderFactories initializes v6 provider factories
-// currently only initializes echo provider for testing ephemeral resources
-func ProtoV6ProviderFactories(_ context.Context, providerNames ...string) map[string]func() (tfprotov6.ProviderServer, error) {
+// ProtoV6ProviderFactories initializes v6 provider factories.
+// The echo provider is used for testing ephemeral resources. All other names are AWS provider instances.
+func ProtoV6ProviderFactories(ctx context.Context, providerNames ...string) map[string]func() (tfprotov6.ProviderServer, error) {
 	factories := make(map[string]func() (tfprotov6.ProviderServer, error))
 
 	for _, name := range providerNames {
 		if name == ProviderNameEcho {
 			factories[name] = echoprovider.NewProviderServer()
+			continue
+		}
+
+		factories[name] = func() (tfprotov6.ProviderServer, error) {
+			providerServerFactory, _, err := provider.ProtoV6ProviderServerFactory(ctx)
+
+			if err != nil {
+				return nil, err
+			}
+
+			return providerServerFactory(), nil
 		}
 	}
 
 	return factories
 }
 
+// isAWSProviderName returns whether the provider name used in a test refers to an AWS provider instance.
+func isAWSProviderName(name string) bool {
+	return name != ProviderNameEcho
+}
+
 func protoV5ProviderFactoriesNamedInit(ctx context.Context, t *testing.T, providers map[string]*schema.Provider, providerNames ...string) map[string]func() (tfprotov5.ProviderServer, error) {
 	t.Helper()
 
@@ -298,10 +314,13 @@ func PreCheck(ctx context.Context, t *testing.T) {
 	// Since we are outside the scope of the Terraform configuration we must
 	// call Configure() to properly initialize the provider configuration.
 	testAccProviderConfigure.Do(func() {
-		envvar.FailIfAllEmpty(t, []string{envvar.Profile, envvar.AccessKeyId, envvar.ContainerCredentialsFullURI}, "credentials for running acceptance testing")
+		// Local AWS API emulators don't require credentials.
+		if !isLocalEndpoint() {
+			envvar.FailIfAllEmpty(t, []string{envvar.Profile, envvar.AccessKeyId, envvar.ContainerCredentialsFullURI}, "credentials for running acceptance testing")
 
-		if os.Getenv(envvar.AccessKeyId) != "" {
-			envvar.FailIfEmpty(t, envvar.SecretAccessKey, "static credentials value when using "+envvar.AccessKeyId)
+			if os.Getenv(envvar.AccessKeyId) != "" {
+				envvar.FailIfEmpty(t, envvar.SecretAccessKey, "static credentials value when using "+envvar.AccessKeyId)
+			}
 		}
 
 		// Setting the AWS_DEFAULT_REGION environment variable here allows all tests to omit
@@ -314,7 +333,17 @@ func PreCheck(ctx context.Context, t *testing.T) {
 		region := Region()
 		os.Setenv(envvar.DefaultRegion, region)
 
-		diags := Provider.Configure(ctx, terraformsdk.NewResourceConfigRaw(nil))
+		// When replaying recorded cassettes no AWS API calls are made outside of recorded interactions.
+		var raw map[string]any
+		if isVCRReplaying() {
+			raw = map[string]any{
+				"skip_credentials_validation": true,
+				"skip_metadata_api_check":     "true",
+				"skip_requesting_account_id":  true,
+			}
+		}
+
+		diags := Provider.Configure(ctx, terraformsdk.NewResourceConfigRaw(raw))
 		if err := sdkdiag.DiagnosticsError(diags); err != nil {
 			t.Fatalf("configuring provider: %s", err)
 		}
@@ -894,6 +923,11 @@ func PrimaryInstanceState(s *terraform.State, name string) (*terraform.InstanceS
 // AccountID returns the account ID of Provider
 // Must be used within a resource.TestCheckFunc
 func AccountID(ctx context.Context) string {
+	// Account IDs in recorded cassettes are replaced by a placeholder.
+	if isVCRReplaying() {
+		return vcrAccountID
+	}
+
 	return ProviderAccountID(ctx, Provider)
 }
 
@@ -1048,6 +1082,44 @@ func PreCheckPartition(t *testing.T, partition string) {
 	}
 }
 
+// localEndpointServicePackages are the service packages whose acceptance tests are known to work against a local AWS API emulator.
+var localEndpointServicePackages = []string{
+	names.ACM,
+	names.APIGateway,
+	names.CloudWatch,
+	names.DynamoDB,
+	names.Events,
+	names.Firehose,
+	names.IAM,
+	names.Kinesis,
+	names.KMS,
+	names.Lambda,
+	names.Logs,
+	names.Route53,
+	names.S3,
+	names.SecretsManager,
+	names.SFN,
+	names.SNS,
+	names.SQS,
+	names.SSM,
+	names.STS,
+}
+
+func isLocalEndpoint() bool {
+	return os.Getenv(conns.LocalEndpointEnvVar) != ""
+}
+
+// PreCheckLocalEndpoint skips the test if acceptance tests are running against a local AWS API emulator
+// and the service package is not known to work against the emulator.
+// Call it from the test's PreCheck with the service package of the resources under test, e.g. names.Logs.
+func PreCheckLocalEndpoint(_ context.Context, t *testing.T, servicePackageName string) {
+	t.Helper()
+
+	if isLocalEndpoint() && !slices.Contains(localEndpointServicePackages, servicePackageName) {
+		t.Skipf("skipping tests; %s is not supported by the local AWS API emulator (%s)", servicePackageName, os.Getenv(conns.LocalEndpointEnvVar))
+	}
+}
+
 // PreCheckPartitionNot checks that the test partition is not one of the specified partitions.
 func PreCheckPartitionNot(t *testing.T, partitions ...string) {
 	t.Helper()
@@ -2139,17 +2211,12 @@ func RunSerialTests2Levels(t *testing.T, testCases map[string]map[string]func(*t
 }
 
 // RunLimitedConcurrencyTests2Levels runs test cases with concurrency limited via `semaphore`.
-func RunLimitedConcurrencyTests2Levels(t *testing.T, semaphore tfsync.Semaphore, testCases map[string]map[string]func(*testing.T, tfsync.Semaphore)) {
+func RunLimitedConcurrencyTests2Levels(t *testing.T, semaphore *tfsync.Semaphore, testCases map[string]map[string]func(*testing.T, *tfsync.Semaphore)) {
 	t.Helper()
 
 	for group, m := range testCases {
 		for name, tc := range m {
 			t.Run(fmt.Sprintf("%s_%s", group, name), func(t *testing.T) {
-				t.Cleanup(func() {
-					if os.Getenv(resource.EnvTfAcc) != "" {
-						semaphore.Notify()
-					}
-				})
 				tc(t, semaphore)
 			})
 		}
@@ -3,7 +3,26 @@
 
 package acctest
 
+import (
+	"net/http"
+
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+	"gopkg.in/dnaeon/go-vcr.v3/recorder"
+)
+
 // Exports for use in tests only.
 var (
-	CloseVCRRecorder = closeVCRRecorder
+	CloseVCRRecorder  = closeVCRRecorder
+	VCRRecorder       = vcrRecorder
+	VCRRequestMatcher = vcrRequestMatcher
 )
+
+func NewVCRTransport(r *recorder.Recorder) http.RoundTripper {
+	return &vcrTransport{recorder: r}
+}
+
+func NewVCRScrubber(primaryAccountID string) func(*cassette.Interaction) error {
+	return newVCRScrubber(func() string {
+		return primaryAccountID
+	}).scrub
+}
@@ -0,0 +1,218 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package fakeaws provides an in-process fake of the AWS APIs for unit testing.
+// A Server is an http.RoundTripper that is plugged in to AWS SDK for Go v2 clients,
+// or to the provider via conns.AWSClient.SetHTTPClient, and serves each API call
+// from a Handler registered for the call's service and operation.
+package fakeaws
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/YakDriver/regexache"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+)
+
+const (
+	// AccountID is the AWS account ID returned by the default STS GetCallerIdentity handler.
+	AccountID = "123456789012"
+	// Region is the AWS Region used by clients in tests.
+	Region = "us-west-2" //lintignore:AWSAT003
+)
+
+// Call is a record of an AWS API call made to a Server.
+type Call struct {
+	Body      []byte
+	Operation string
+	Region    string
+	Service   string
+}
+
+// String returns the call's "Service.Operation" name.
+func (c Call) String() string {
+	return c.Service + "." + c.Operation
+}
+
+type operationKey struct {
+	service   string
+	operation string
+}
+
+// Server is an http.RoundTripper serving AWS API calls from registered Handlers.
+// Handlers are keyed by AWS SDK for Go v2 service ID (e.g. "CloudWatch Logs") and operation name (e.g. "CreateLogGroup").
+// Calls to operations with no registered Handler fail without being retried.
+type Server struct {
+	calls    []Call
+	handlers map[operationKey]Handler
+	mu       sync.Mutex
+}
+
+// New returns a new Server.
+// A handler for STS GetCallerIdentity, used when configuring the provider, is registered by default.
+func New() *Server {
+	s := &Server{
+		handlers: make(map[operationKey]Handler),
+	}
+
+	s.On("STS", "GetCallerIdentity", QueryResponse(fmt.Sprintf(`<Arn>arn:aws:iam::%[1]s:user/fakeaws</Arn><UserId>AIDAFAKEAWS</UserId><Account>%[1]s</Account>`, AccountID)))
+
+	return s
+}
+
+// On registers the Handler for the specified service and operation, replacing any existing Handler.
+func (s *Server) On(service, operation string, handler Handler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.handlers[operationKey{service: service, operation: operation}] = handler
+}
+
+// HTTPClient returns an http.Client that sends all requests to the Server.
+func (s *Server) HTTPClient() *http.Client {
+	return &http.Client{
+		Transport: s,
+	}
+}
+
+// Calls returns the API calls made to the Server, in order.
+func (s *Server) Calls() []Call {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	calls := make([]Call, len(s.calls))
+	copy(calls, s.calls)
+
+	return calls
+}
+
+// Operations returns the "Service.Operation" names of the API calls made to the Server, in order.
+func (s *Server) Operations() []string {
+	var operations []string
+
+	for _, call := range s.Calls() {
+		operations = append(operations, call.String())
+	}
+
+	return operations
+}
+
+// ResetCalls clears the record of API calls made to the Server.
+func (s *Server) ResetCalls() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.calls = nil
+}
+
+func (s *Server) RoundTrip(r *http.Request) (*http.Response, error) {
+	request, err := newRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	s.calls = append(s.calls, Call{
+		Body:      request.Body,
+		Operation: request.Operation,
+		Region:    request.Region,
+		Service:   request.Service,
+	})
+	handler, ok := s.handlers[operationKey{service: request.Service, operation: request.Operation}]
+	s.mu.Unlock()
+
+	if !ok {
+		return nil, &unhandledOperationError{service: request.Service, operation: request.Operation}
+	}
+
+	return handler(request).httpResponse(r), nil
+}
+
+// Request is an AWS API call made to a Server.
+type Request struct {
+	*http.Request
+
+	// Body is the request's body, which has already been read.
+	Body      []byte
+	Operation string
+	Region    string
+	Service   string
+}
+
+// DecodeJSON decodes the JSON body of an AWS JSON or REST-JSON protocol request into v.
+func (r *Request) DecodeJSON(v any) error {
+	return decodeJSON(r.Body, v)
+}
+
+// Params returns the parameters of an AWS Query or EC2 protocol request.
+func (r *Request) Params() (url.Values, error) {
+	return url.ParseQuery(string(r.Body))
+}
+
+func newRequest(r *http.Request) (*Request, error) {
+	var body []byte
+	if r.Body != nil {
+		var err error
+		body, err = io.ReadAll(r.Body)
+		r.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	// The AWS SDK for Go v2 middleware stack's metadata is available from the request's Context.
+	ctx := r.Context()
+	request := &Request{
+		Request:   r,
+		Body:      body,
+		Operation: awsmiddleware.GetOperationName(ctx),
+		Region:    awsmiddleware.GetRegion(ctx),
+		Service:   awsmiddleware.GetServiceID(ctx),
+	}
+
+	// Fall back to the request's contents for requests made without the middleware stack.
+	if request.Operation == "" {
+		if v := r.Header.Get("X-Amz-Target"); v != "" {
+			_, request.Operation, _ = strings.Cut(v, ".")
+		} else if values, err := url.ParseQuery(string(body)); err == nil {
+			request.Operation = values.Get("Action")
+		}
+	}
+	if request.Service == "" || request.Region == "" {
+		if m := credentialScopeRegexp.FindStringSubmatch(r.Header.Get("Authorization")); m != nil {
+			if request.Region == "" {
+				request.Region = m[1]
+			}
+			if request.Service == "" {
+				request.Service = m[2]
+			}
+		}
+	}
+
+	return request, nil
+}
+
+// credentialScopeRegexp matches the Region and signing name in a Signature Version 4 Authorization header.
+var credentialScopeRegexp = regexache.MustCompile(`Credential=[^/]+/\d{8}/([^/]+)/([^/]+)/aws4_request`)
+
+// unhandledOperationError is returned when no Handler is registered for an API call.
+type unhandledOperationError struct {
+	service   string
+	operation string
+}
+
+func (e *unhandledOperationError) Error() string {
+	return fmt.Sprintf("fakeaws: no handler registered for %s.%s", e.service, e.operation)
+}
+
+// CanceledError prevents the AWS SDK for Go v2 from retrying requests with no registered Handler.
+func (e *unhandledOperationError) CanceledError() bool {
+	return true
+}
@@ -0,0 +1,191 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fakeaws"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+// noBackoffRetryer returns a standard Retryer that doesn't wait between attempts.
+func noBackoffRetryer() aws.Retryer {
+	return retry.NewStandard(func(o *retry.StandardOptions) {
+		o.Backoff = retry.BackoffDelayerFunc(func(int, error) (time.Duration, error) {
+			return 0, nil
+		})
+	})
+}
+
+func newLogsClient(s *fakeaws.Server) *cloudwatchlogs.Client {
+	return cloudwatchlogs.New(cloudwatchlogs.Options{
+		Credentials: credentials.NewStaticCredentialsProvider("AKIAFAKEAWS", "secret", ""),
+		HTTPClient:  s.HTTPClient(),
+		Region:      fakeaws.Region,
+		Retryer:     noBackoffRetryer(),
+	})
+}
+
+func TestServerStateful(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := fakeaws.New()
+	logGroups := make(map[string]any)
+	s.On("CloudWatch Logs", "CreateLogGroup", func(r *fakeaws.Request) *fakeaws.Response {
+		var input struct {
+			LogGroupName string `json:"logGroupName"`
+		}
+		if err := r.DecodeJSON(&input); err != nil {
+			return fakeaws.Error(http.StatusBadRequest, "InvalidParameterException", err.Error())(r)
+		}
+		logGroups[input.LogGroupName] = map[string]any{
+			"logGroupName": input.LogGroupName,
+		}
+		return nil
+	})
+	s.On("CloudWatch Logs", "DescribeLogGroups", func(r *fakeaws.Request) *fakeaws.Response {
+		var v []any
+		for _, logGroup := range logGroups {
+			v = append(v, logGroup)
+		}
+		return fakeaws.JSONResponse(map[string]any{"logGroups": v})(r)
+	})
+
+	conn := newLogsClient(s)
+
+	if _, err := conn.CreateLogGroup(ctx, &cloudwatchlogs.CreateLogGroupInput{LogGroupName: aws.String("test")}); err != nil {
+		t.Fatalf("CreateLogGroup: unexpected error: %s", err)
+	}
+
+	output, err := conn.DescribeLogGroups(ctx, &cloudwatchlogs.DescribeLogGroupsInput{})
+	if err != nil {
+		t.Fatalf("DescribeLogGroups: unexpected error: %s", err)
+	}
+
+	if got, want := len(output.LogGroups), 1; got != want {
+		t.Fatalf("LogGroups = %d, want %d", got, want)
+	}
+	if got, want := aws.ToString(output.LogGroups[0].LogGroupName), "test"; got != want {
+		t.Errorf("LogGroupName = %q, want %q", got, want)
+	}
+
+	calls := s.Calls()
+	if got, want := s.Operations(), []string{"CloudWatch Logs.CreateLogGroup", "CloudWatch Logs.DescribeLogGroups"}; !cmp.Equal(got, want) {
+		t.Errorf("unexpected operations: %s", cmp.Diff(want, got))
+	}
+	if got, want := calls[0].Region, fakeaws.Region; got != want {
+		t.Errorf("Region = %q, want %q", got, want)
+	}
+}
+
+func TestServerError(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := fakeaws.New()
+	s.On("CloudWatch Logs", "DeleteLogGroup", fakeaws.Error(http.StatusBadRequest, "ResourceNotFoundException", "The specified log group does not exist."))
+
+	conn := newLogsClient(s)
+
+	_, err := conn.DeleteLogGroup(ctx, &cloudwatchlogs.DeleteLogGroupInput{LogGroupName: aws.String("test")})
+
+	if !errs.IsAErrorMessageContains[*awstypes.ResourceNotFoundException](err, "does not exist") {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestServerUnhandledOperation(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := fakeaws.New()
+
+	conn := newLogsClient(s)
+
+	_, err := conn.DeleteLogGroup(ctx, &cloudwatchlogs.DeleteLogGroupInput{LogGroupName: aws.String("test")})
+
+	if !errs.Contains(err, "no handler registered for CloudWatch Logs.DeleteLogGroup") {
+		t.Errorf("unexpected error: %v", err)
+	}
+	// Calls to unhandled operations aren't retried.
+	if got, want := len(s.Calls()), 1; got != want {
+		t.Errorf("calls = %d, want %d", got, want)
+	}
+}
+
+func TestServerQueryProtocol(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := fakeaws.New()
+
+	conn := sts.New(sts.Options{
+		Credentials: credentials.NewStaticCredentialsProvider("AKIAFAKEAWS", "secret", ""),
+		HTTPClient:  s.HTTPClient(),
+		Region:      fakeaws.Region,
+	})
+
+	output, err := conn.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		t.Fatalf("GetCallerIdentity: unexpected error: %s", err)
+	}
+
+	if got, want := aws.ToString(output.Account), fakeaws.AccountID; got != want {
+		t.Errorf("Account = %q, want %q", got, want)
+	}
+}
+
+func TestThrottled(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := fakeaws.New()
+	s.On("CloudWatch Logs", "CreateLogGroup", fakeaws.Throttled(2, fakeaws.EmptyResponse()))
+
+	conn := newLogsClient(s)
+
+	if _, err := conn.CreateLogGroup(ctx, &cloudwatchlogs.CreateLogGroupInput{LogGroupName: aws.String("test")}); err != nil {
+		t.Fatalf("CreateLogGroup: unexpected error: %s", err)
+	}
+
+	if got, want := len(s.Calls()), 3; got != want {
+		t.Errorf("calls = %d, want %d", got, want)
+	}
+}
+
+func TestEventuallyConsistent(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := fakeaws.New()
+	s.On("CloudWatch Logs", "DescribeLogGroups", fakeaws.EventuallyConsistent(1,
+		fakeaws.JSONResponse(map[string]any{"logGroups": []any{}}),
+		fakeaws.JSONResponse(map[string]any{"logGroups": []any{map[string]any{"logGroupName": "test"}}}),
+	))
+
+	conn := newLogsClient(s)
+
+	for _, want := range []int{0, 1, 1} {
+		output, err := conn.DescribeLogGroups(ctx, &cloudwatchlogs.DescribeLogGroupsInput{})
+		if err != nil {
+			t.Fatalf("DescribeLogGroups: unexpected error: %s", err)
+		}
+
+		if got := len(output.LogGroups); got != want {
+			t.Errorf("LogGroups = %d, want %d", got, want)
+		}
+	}
+}
@@ -0,0 +1,262 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	requestID = "fakeaws-request-id"
+)
+
+// Handler returns the response to an AWS API call.
+// Stateful handlers keep their state in closures.
+type Handler func(*Request) *Response
+
+// Response is the response to an AWS API call.
+// A nil Response is an empty successful response.
+type Response struct {
+	Body       []byte
+	Header     http.Header
+	StatusCode int
+}
+
+func (r *Response) httpResponse(request *http.Request) *http.Response {
+	if r == nil {
+		r = &Response{}
+	}
+
+	header := r.Header.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+	header.Set("X-Amzn-Requestid", requestID)
+	header.Set("Content-Length", strconv.Itoa(len(r.Body)))
+
+	statusCode := r.StatusCode
+	if statusCode == 0 {
+		statusCode = http.StatusOK
+	}
+
+	return &http.Response{
+		Body:          io.NopCloser(bytes.NewReader(r.Body)),
+		ContentLength: int64(len(r.Body)),
+		Header:        header,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Request:       request,
+		Status:        fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+		StatusCode:    statusCode,
+	}
+}
+
+// EmptyResponse returns a Handler that responds with an empty successful response.
+func EmptyResponse() Handler {
+	return func(*Request) *Response {
+		return nil
+	}
+}
+
+// JSONResponse returns a Handler that responds to an AWS JSON or REST-JSON protocol call with v encoded as JSON.
+// v must use the API's wire names (e.g. "logGroupName"), so is usually a map rather than an AWS SDK for Go v2 output type.
+func JSONResponse(v any) Handler {
+	body, err := json.Marshal(v)
+
+	return func(*Request) *Response {
+		if err != nil {
+			return &Response{StatusCode: http.StatusInternalServerError, Body: []byte(err.Error())}
+		}
+
+		return &Response{
+			Body:   body,
+			Header: http.Header{"Content-Type": []string{"application/x-amz-json-1.1"}},
+		}
+	}
+}
+
+// QueryResponse returns a Handler that responds to an AWS Query or EC2 protocol call.
+// result is the XML content of the operation's result element, which is added along with the response element.
+func QueryResponse(result string) Handler {
+	return func(r *Request) *Response {
+		var body string
+		if requestProtocol(r) == protocolEC2 {
+			body = fmt.Sprintf(`<%[1]sResponse>%[2]s<requestId>%[3]s</requestId></%[1]sResponse>`, r.Operation, result, requestID)
+		} else {
+			body = fmt.Sprintf(`<%[1]sResponse><%[1]sResult>%[2]s</%[1]sResult><ResponseMetadata><RequestId>%[3]s</RequestId></ResponseMetadata></%[1]sResponse>`, r.Operation, result, requestID)
+		}
+
+		return &Response{
+			Body:   []byte(body),
+			Header: http.Header{"Content-Type": []string{"text/xml"}},
+		}
+	}
+}
+
+// XMLResponse returns a Handler that responds to an AWS REST-XML protocol call with the specified XML body.
+func XMLResponse(body string) Handler {
+	return func(*Request) *Response {
+		return &Response{
+			Body:   []byte(body),
+			Header: http.Header{"Content-Type": []string{"application/xml"}},
+		}
+	}
+}
+
+// Error returns a Handler that responds with an API error, encoded for the call's protocol.
+// The AWS SDK for Go v2 deserializes errors whose code matches a modeled exception (e.g. "ResourceNotFoundException") to that exception type.
+func Error(statusCode int, code, message string) Handler {
+	return func(r *Request) *Response {
+		return errorResponse(r, statusCode, code, message)
+	}
+}
+
+// Throttle returns a Handler that responds with the call's protocol's throttling error.
+func Throttle() Handler {
+	return func(r *Request) *Response {
+		switch requestProtocol(r) {
+		case protocolEC2:
+			return errorResponse(r, http.StatusServiceUnavailable, "RequestLimitExceeded", "Request limit exceeded.")
+		case protocolQuery, protocolRESTXML:
+			if r.Service == "S3" {
+				return errorResponse(r, http.StatusServiceUnavailable, "SlowDown", "Please reduce your request rate.")
+			}
+			return errorResponse(r, http.StatusBadRequest, "Throttling", "Rate exceeded")
+		default:
+			return errorResponse(r, http.StatusBadRequest, "ThrottlingException", "Rate exceeded")
+		}
+	}
+}
+
+// Sequence returns a Handler that delegates each call to the next of the specified handlers in turn.
+// The last handler serves all remaining calls.
+func Sequence(handlers ...Handler) Handler {
+	var mu sync.Mutex
+	var n int
+
+	return func(r *Request) *Response {
+		mu.Lock()
+		handler := handlers[min(n, len(handlers)-1)]
+		n++
+		mu.Unlock()
+
+		return handler(r)
+	}
+}
+
+// Repeat returns n copies of the specified handler, for use with Sequence.
+func Repeat(n int, handler Handler) []Handler {
+	handlers := make([]Handler, n)
+	for i := range handlers {
+		handlers[i] = handler
+	}
+
+	return handlers
+}
+
+// Throttled returns a Handler that responds to the first n calls with a throttling error and then delegates to the specified handler.
+// The AWS SDK for Go v2 retries throttled calls, so Throttled exercises API retry paths.
+func Throttled(n int, handler Handler) Handler {
+	return Sequence(append(Repeat(n, Throttle()), handler)...)
+}
+
+// EventuallyConsistent returns a Handler that delegates the first n calls to stale and then delegates to the specified handler.
+// For example, stale may respond with a not found error to simulate a newly created resource not yet being visible,
+// exercising the provider's waiters and retries.
+func EventuallyConsistent(n int, stale, handler Handler) Handler {
+	return Sequence(append(Repeat(n, stale), handler)...)
+}
+
+type protocol int
+
+const (
+	protocolJSON protocol = iota
+	protocolQuery
+	protocolEC2
+	protocolRESTJSON
+	protocolRESTXML
+)
+
+// restXMLServices are the service IDs of the services using the REST-XML protocol.
+var restXMLServices = map[string]bool{
+	"CloudFront": true,
+	"Route 53":   true,
+	"S3":         true,
+	"S3 Control": true,
+}
+
+func requestProtocol(r *Request) protocol {
+	switch {
+	case r.Header.Get("X-Amz-Target") != "":
+		return protocolJSON
+	case strings.HasPrefix(r.Header.Get("Content-Type"), "application/x-www-form-urlencoded"):
+		if r.Service == "EC2" {
+			return protocolEC2
+		}
+		return protocolQuery
+	case restXMLServices[r.Service]:
+		return protocolRESTXML
+	default:
+		return protocolRESTJSON
+	}
+}
+
+func errorResponse(r *Request, statusCode int, code, message string) *Response {
+	var body, contentType string
+
+	switch requestProtocol(r) {
+	case protocolEC2:
+		body = fmt.Sprintf(`<Response><Errors><Error><Code>%s</Code><Message>%s</Message></Error></Errors><RequestID>%s</RequestID></Response>`, escapeXML(code), escapeXML(message), requestID)
+		contentType = "text/xml"
+	case protocolQuery:
+		body = fmt.Sprintf(`<ErrorResponse><Error><Type>Sender</Type><Code>%s</Code><Message>%s</Message></Error><RequestId>%s</RequestId></ErrorResponse>`, escapeXML(code), escapeXML(message), requestID)
+		contentType = "text/xml"
+	case protocolRESTXML:
+		if r.Service == "S3" {
+			body = fmt.Sprintf(`<Error><Code>%s</Code><Message>%s</Message><RequestId>%s</RequestId></Error>`, escapeXML(code), escapeXML(message), requestID)
+		} else {
+			body = fmt.Sprintf(`<ErrorResponse><Error><Type>Sender</Type><Code>%s</Code><Message>%s</Message></Error><RequestId>%s</RequestId></ErrorResponse>`, escapeXML(code), escapeXML(message), requestID)
+		}
+		contentType = "application/xml"
+	default:
+		v, _ := json.Marshal(map[string]string{
+			"__type":  code,
+			"message": message,
+		})
+		body = string(v)
+		contentType = "application/x-amz-json-1.1"
+	}
+
+	return &Response{
+		Body: []byte(body),
+		Header: http.Header{
+			"Content-Type":     []string{contentType},
+			"X-Amzn-Errortype": []string{code},
+		},
+		StatusCode: statusCode,
+	}
+}
+
+func escapeXML(s string) string {
+	var b strings.Builder
+	_ = xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
+
+func decodeJSON(body []byte, v any) error {
+	if len(body) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(body, v)
+}
@@ -0,0 +1,560 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fakeaws"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// InProcessProvider drives the provider's resources through Create, Read, Update and Delete
+// using the provider protocol, with all AWS API calls served by a fakeaws.Server.
+// Both Terraform Plugin SDKv2 and Terraform Plugin Framework resources are supported.
+// No Terraform CLI or AWS credentials are required, so InProcessProvider is used in unit tests.
+type InProcessProvider struct {
+	schemas *tfprotov5.GetProviderSchemaResponse
+	server  tfprotov5.ProviderServer
+}
+
+// InProcessState is a resource's state as returned by an InProcessProvider.
+type InProcessState struct {
+	// Attributes is the resource's state in flatmap format, as used by resource.TestCheckResourceAttr.
+	Attributes map[string]string
+
+	private  []byte
+	typeName string
+	value    tftypes.Value
+}
+
+// NewInProcessProvider returns a configured provider whose AWS API calls are served by the specified fakeaws.Server.
+// Calls made while configuring the provider are cleared from the server's record of calls.
+func NewInProcessProvider(ctx context.Context, t *testing.T, s *fakeaws.Server) *InProcessProvider {
+	t.Helper()
+
+	providerServerFactory, primary, err := provider.ProtoV5ProviderServerFactory(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// As the HTTP client is used in the provider's ConfigureContextFunc
+	// we must do this setup before calling the ConfigureContextFunc.
+	configureContextFunc := primary.ConfigureContextFunc
+	primary.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (any, diag.Diagnostics) {
+		meta, ok := primary.Meta().(*conns.AWSClient)
+		if !ok {
+			meta = new(conns.AWSClient)
+		}
+		meta.SetHTTPClient(ctx, s.HTTPClient())
+		primary.SetMeta(meta)
+
+		return configureContextFunc(ctx, d)
+	}
+
+	p := &InProcessProvider{
+		server: providerServerFactory(),
+	}
+
+	p.schemas, err = p.server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err == nil {
+		err = diagnosticsError(p.schemas.Diagnostics)
+	}
+	if err != nil {
+		t.Fatalf("getting provider schema: %s", err)
+	}
+
+	config, err := configValue(p.schemas.Provider.Block, map[string]any{
+		"access_key":              "AKIAFAKEAWS",
+		names.AttrRegion:          fakeaws.Region,
+		"secret_key":              "secret",
+		"skip_metadata_api_check": "true",
+	})
+	if err != nil {
+		t.Fatalf("configuring provider: %s", err)
+	}
+
+	dv, err := tfprotov5.NewDynamicValue(p.schemas.Provider.ValueType(), config)
+	if err != nil {
+		t.Fatalf("configuring provider: %s", err)
+	}
+
+	response, err := p.server.ConfigureProvider(ctx, &tfprotov5.ConfigureProviderRequest{
+		Config:           &dv,
+		TerraformVersion: "1.10.0",
+	})
+	if err == nil {
+		err = diagnosticsError(response.Diagnostics)
+	}
+	if err != nil {
+		t.Fatalf("configuring provider: %s", err)
+	}
+
+	s.ResetCalls()
+
+	return p
+}
+
+// Create plans and applies the creation of a resource of the specified type from the specified configuration.
+// config's keys are attribute and block names and its values are Go strings, bools, numbers, slices and maps.
+func (p *InProcessProvider) Create(ctx context.Context, typeName string, config map[string]any) (*InProcessState, error) {
+	s, err := p.resourceSchema(typeName)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.apply(ctx, typeName, s, tftypes.NewValue(s.ValueType(), nil), nil, config)
+}
+
+// Read refreshes the specified resource state.
+// A nil state is returned if the resource has been removed.
+func (p *InProcessProvider) Read(ctx context.Context, state *InProcessState) (*InProcessState, error) {
+	s, err := p.resourceSchema(state.typeName)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := tfprotov5.NewDynamicValue(s.ValueType(), state.value)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.server.ReadResource(ctx, &tfprotov5.ReadResourceRequest{
+		CurrentState: &current,
+		Private:      state.private,
+		TypeName:     state.typeName,
+	})
+	if err == nil {
+		err = diagnosticsError(response.Diagnostics)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", state.typeName, err)
+	}
+
+	value, err := response.NewState.Unmarshal(s.ValueType())
+	if err != nil {
+		return nil, err
+	}
+
+	if value.IsNull() {
+		return nil, nil
+	}
+
+	return newInProcessState(state.typeName, value, response.Private), nil
+}
+
+// Update plans and applies the change of the specified resource state to the specified configuration.
+func (p *InProcessProvider) Update(ctx context.Context, state *InProcessState, config map[string]any) (*InProcessState, error) {
+	s, err := p.resourceSchema(state.typeName)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.apply(ctx, state.typeName, s, state.value, state.private, config)
+}
+
+// Delete plans and applies the destruction of the specified resource state.
+func (p *InProcessProvider) Delete(ctx context.Context, state *InProcessState) error {
+	s, err := p.resourceSchema(state.typeName)
+	if err != nil {
+		return err
+	}
+
+	_, err = p.apply(ctx, state.typeName, s, state.value, state.private, nil)
+
+	return err
+}
+
+func (p *InProcessProvider) resourceSchema(typeName string) (*tfprotov5.Schema, error) {
+	s, ok := p.schemas.ResourceSchemas[typeName]
+	if !ok {
+		return nil, fmt.Errorf("resource type %q not found", typeName)
+	}
+
+	return s, nil
+}
+
+// apply plans and applies a resource change. A nil config destroys the resource.
+func (p *InProcessProvider) apply(ctx context.Context, typeName string, s *tfprotov5.Schema, prior tftypes.Value, private []byte, config map[string]any) (*InProcessState, error) {
+	typ := s.ValueType()
+	configVal, proposedNewVal := tftypes.NewValue(typ, nil), tftypes.NewValue(typ, nil)
+
+	if config != nil {
+		var err error
+		configVal, err = configValue(s.Block, config)
+		if err != nil {
+			return nil, fmt.Errorf("%s configuration: %w", typeName, err)
+		}
+
+		configDV, err := tfprotov5.NewDynamicValue(typ, configVal)
+		if err != nil {
+			return nil, err
+		}
+
+		response, err := p.server.ValidateResourceTypeConfig(ctx, &tfprotov5.ValidateResourceTypeConfigRequest{
+			Config:   &configDV,
+			TypeName: typeName,
+		})
+		if err == nil {
+			err = diagnosticsError(response.Diagnostics)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("validating %s: %w", typeName, err)
+		}
+
+		proposedNewVal, err = proposedNewValue(s.Block, prior, configVal)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	configDV, err := tfprotov5.NewDynamicValue(typ, configVal)
+	if err != nil {
+		return nil, err
+	}
+	priorDV, err := tfprotov5.NewDynamicValue(typ, prior)
+	if err != nil {
+		return nil, err
+	}
+	proposedNewDV, err := tfprotov5.NewDynamicValue(typ, proposedNewVal)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := p.server.PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{
+		Config:           &configDV,
+		PriorPrivate:     private,
+		PriorState:       &priorDV,
+		ProposedNewState: &proposedNewDV,
+		TypeName:         typeName,
+	})
+	if err == nil {
+		err = diagnosticsError(plan.Diagnostics)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("planning %s: %w", typeName, err)
+	}
+
+	response, err := p.server.ApplyResourceChange(ctx, &tfprotov5.ApplyResourceChangeRequest{
+		Config:         &configDV,
+		PlannedPrivate: plan.PlannedPrivate,
+		PlannedState:   plan.PlannedState,
+		PriorState:     &priorDV,
+		TypeName:       typeName,
+	})
+	if err == nil {
+		err = diagnosticsError(response.Diagnostics)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("applying %s: %w", typeName, err)
+	}
+
+	value, err := response.NewState.Unmarshal(typ)
+	if err != nil {
+		return nil, err
+	}
+
+	if value.IsNull() {
+		return nil, nil
+	}
+
+	return newInProcessState(typeName, value, response.Private), nil
+}
+
+func newInProcessState(typeName string, value tftypes.Value, private []byte) *InProcessState {
+	state := &InProcessState{
+		Attributes: make(map[string]string),
+		private:    private,
+		typeName:   typeName,
+		value:      value,
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := value.As(&attributes); err == nil {
+		for k, v := range attributes {
+			flattenValue(state.Attributes, k, v)
+		}
+	}
+
+	return state
+}
+
+// flattenValue adds the flatmap representation of v to m.
+func flattenValue(m map[string]string, key string, v tftypes.Value) {
+	if v.IsNull() || !v.IsKnown() {
+		return
+	}
+
+	typ := v.Type()
+	switch {
+	case typ.Is(tftypes.String):
+		var s string
+		_ = v.As(&s)
+		m[key] = s
+	case typ.Is(tftypes.Bool):
+		var b bool
+		_ = v.As(&b)
+		m[key] = strconv.FormatBool(b)
+	case typ.Is(tftypes.Number):
+		var f big.Float
+		_ = v.As(&f)
+		m[key] = f.Text('f', -1)
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var elems []tftypes.Value
+		_ = v.As(&elems)
+		m[key+".#"] = strconv.Itoa(len(elems))
+		for i, elem := range elems {
+			flattenValue(m, key+"."+strconv.Itoa(i), elem)
+		}
+	case typ.Is(tftypes.Map{}), typ.Is(tftypes.Object{}):
+		var elems map[string]tftypes.Value
+		_ = v.As(&elems)
+		if typ.Is(tftypes.Map{}) {
+			m[key+".%"] = strconv.Itoa(len(elems))
+		}
+		for k, elem := range elems {
+			flattenValue(m, key+"."+k, elem)
+		}
+	}
+}
+
+// configValue returns the configuration value of a block from Go values.
+// As in Terraform, absent list and set nested blocks are empty rather than null.
+func configValue(block *tfprotov5.SchemaBlock, config map[string]any) (tftypes.Value, error) {
+	typ := block.ValueType().(tftypes.Object)
+	values := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+
+	for _, attribute := range block.Attributes {
+		v, err := goToValue(typ.AttributeTypes[attribute.Name], config[attribute.Name])
+		if err != nil {
+			return tftypes.Value{}, fmt.Errorf("%s: %w", attribute.Name, err)
+		}
+		values[attribute.Name] = v
+	}
+
+	for _, nested := range block.BlockTypes {
+		attrType := typ.AttributeTypes[nested.TypeName]
+		v := config[nested.TypeName]
+
+		switch nested.Nesting {
+		case tfprotov5.SchemaNestedBlockNestingModeList, tfprotov5.SchemaNestedBlockNestingModeSet:
+			var elems []tftypes.Value
+			if v != nil {
+				rv := reflect.ValueOf(v)
+				if rv.Kind() != reflect.Slice {
+					return tftypes.Value{}, fmt.Errorf("%s: expected slice, got %T", nested.TypeName, v)
+				}
+				for i := range rv.Len() {
+					m, ok := rv.Index(i).Interface().(map[string]any)
+					if !ok {
+						return tftypes.Value{}, fmt.Errorf("%s: expected map[string]any, got %T", nested.TypeName, rv.Index(i).Interface())
+					}
+					elem, err := configValue(nested.Block, m)
+					if err != nil {
+						return tftypes.Value{}, fmt.Errorf("%s.%d: %w", nested.TypeName, i, err)
+					}
+					elems = append(elems, elem)
+				}
+			}
+			values[nested.TypeName] = tftypes.NewValue(attrType, elems)
+		case tfprotov5.SchemaNestedBlockNestingModeSingle, tfprotov5.SchemaNestedBlockNestingModeGroup:
+			if v == nil {
+				values[nested.TypeName] = tftypes.NewValue(attrType, nil)
+				continue
+			}
+			m, ok := v.(map[string]any)
+			if !ok {
+				return tftypes.Value{}, fmt.Errorf("%s: expected map[string]any, got %T", nested.TypeName, v)
+			}
+			elem, err := configValue(nested.Block, m)
+			if err != nil {
+				return tftypes.Value{}, fmt.Errorf("%s: %w", nested.TypeName, err)
+			}
+			values[nested.TypeName] = elem
+		default:
+			elem, err := goToValue(attrType, v)
+			if err != nil {
+				return tftypes.Value{}, fmt.Errorf("%s: %w", nested.TypeName, err)
+			}
+			values[nested.TypeName] = elem
+		}
+	}
+
+	for k := range config {
+		if _, ok := typ.AttributeTypes[k]; !ok {
+			return tftypes.Value{}, fmt.Errorf("unsupported argument %q", k)
+		}
+	}
+
+	return tftypes.NewValue(typ, values), nil
+}
+
+// goToValue returns the value of the specified type from a Go value.
+func goToValue(typ tftypes.Type, v any) (tftypes.Value, error) {
+	if v == nil {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	rv := reflect.ValueOf(v)
+
+	switch {
+	case typ.Is(tftypes.String):
+		if rv.Kind() != reflect.String {
+			return tftypes.Value{}, fmt.Errorf("expected string, got %T", v)
+		}
+		return tftypes.NewValue(typ, rv.String()), nil
+	case typ.Is(tftypes.Bool):
+		if rv.Kind() != reflect.Bool {
+			return tftypes.Value{}, fmt.Errorf("expected bool, got %T", v)
+		}
+		return tftypes.NewValue(typ, rv.Bool()), nil
+	case typ.Is(tftypes.Number):
+		switch {
+		case rv.CanInt():
+			return tftypes.NewValue(typ, new(big.Float).SetInt64(rv.Int())), nil
+		case rv.CanUint():
+			return tftypes.NewValue(typ, new(big.Float).SetUint64(rv.Uint())), nil
+		case rv.CanFloat():
+			return tftypes.NewValue(typ, big.NewFloat(rv.Float())), nil
+		}
+		return tftypes.Value{}, fmt.Errorf("expected number, got %T", v)
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}):
+		if rv.Kind() != reflect.Slice {
+			return tftypes.Value{}, fmt.Errorf("expected slice, got %T", v)
+		}
+		var elemType tftypes.Type
+		if t, ok := typ.(tftypes.List); ok {
+			elemType = t.ElementType
+		} else {
+			elemType = typ.(tftypes.Set).ElementType
+		}
+		elems := make([]tftypes.Value, 0, rv.Len())
+		for i := range rv.Len() {
+			elem, err := goToValue(elemType, rv.Index(i).Interface())
+			if err != nil {
+				return tftypes.Value{}, fmt.Errorf("%d: %w", i, err)
+			}
+			elems = append(elems, elem)
+		}
+		return tftypes.NewValue(typ, elems), nil
+	case typ.Is(tftypes.Map{}):
+		if rv.Kind() != reflect.Map || rv.Type().Key().Kind() != reflect.String {
+			return tftypes.Value{}, fmt.Errorf("expected map with string keys, got %T", v)
+		}
+		elems := make(map[string]tftypes.Value, rv.Len())
+		for iter := rv.MapRange(); iter.Next(); {
+			k := iter.Key().String()
+			elem, err := goToValue(typ.(tftypes.Map).ElementType, iter.Value().Interface())
+			if err != nil {
+				return tftypes.Value{}, fmt.Errorf("%s: %w", k, err)
+			}
+			elems[k] = elem
+		}
+		return tftypes.NewValue(typ, elems), nil
+	case typ.Is(tftypes.Object{}):
+		m, ok := v.(map[string]any)
+		if !ok {
+			return tftypes.Value{}, fmt.Errorf("expected map[string]any, got %T", v)
+		}
+		attributeTypes := typ.(tftypes.Object).AttributeTypes
+		attributes := make(map[string]tftypes.Value, len(attributeTypes))
+		for k, attrType := range attributeTypes {
+			attribute, err := goToValue(attrType, m[k])
+			if err != nil {
+				return tftypes.Value{}, fmt.Errorf("%s: %w", k, err)
+			}
+			attributes[k] = attribute
+		}
+		return tftypes.NewValue(typ, attributes), nil
+	}
+
+	return tftypes.Value{}, fmt.Errorf("unsupported type %s", typ)
+}
+
+// proposedNewValue returns the proposed new state of a block, as computed by Terraform when planning.
+// Computed attributes that are null in configuration take their prior state values.
+func proposedNewValue(block *tfprotov5.SchemaBlock, prior, config tftypes.Value) (tftypes.Value, error) {
+	if prior.IsNull() || config.IsNull() {
+		return config, nil
+	}
+
+	var priorValues, configValues map[string]tftypes.Value
+	if err := prior.As(&priorValues); err != nil {
+		return tftypes.Value{}, err
+	}
+	if err := config.As(&configValues); err != nil {
+		return tftypes.Value{}, err
+	}
+
+	values := make(map[string]tftypes.Value, len(configValues))
+	for k, v := range configValues {
+		values[k] = v
+	}
+
+	for _, attribute := range block.Attributes {
+		if attribute.Computed && configValues[attribute.Name].IsNull() {
+			values[attribute.Name] = priorValues[attribute.Name]
+		}
+	}
+
+	for _, nested := range block.BlockTypes {
+		priorValue, configValue := priorValues[nested.TypeName], configValues[nested.TypeName]
+
+		switch nested.Nesting {
+		case tfprotov5.SchemaNestedBlockNestingModeSingle, tfprotov5.SchemaNestedBlockNestingModeGroup:
+			v, err := proposedNewValue(nested.Block, priorValue, configValue)
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+			values[nested.TypeName] = v
+		case tfprotov5.SchemaNestedBlockNestingModeList:
+			// List elements are matched by index.
+			var priorElems, configElems []tftypes.Value
+			if err := priorValue.As(&priorElems); err != nil {
+				return tftypes.Value{}, err
+			}
+			if err := configValue.As(&configElems); err != nil {
+				return tftypes.Value{}, err
+			}
+			if len(priorElems) != len(configElems) {
+				continue
+			}
+			elems := make([]tftypes.Value, 0, len(configElems))
+			for i := range configElems {
+				v, err := proposedNewValue(nested.Block, priorElems[i], configElems[i])
+				if err != nil {
+					return tftypes.Value{}, err
+				}
+				elems = append(elems, v)
+			}
+			values[nested.TypeName] = tftypes.NewValue(configValue.Type(), elems)
+		}
+	}
+
+	return tftypes.NewValue(config.Type(), values), nil
+}
+
+func diagnosticsError(diags []*tfprotov5.Diagnostic) error {
+	var errs []error
+
+	for _, d := range diags {
+		if d.Severity == tfprotov5.DiagnosticSeverityError {
+			errs = append(errs, fmt.Errorf("%s: %s", d.Summary, d.Detail))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -13,11 +13,11 @@ import (
 )
 
 func Diff(x, y string) string {
-	xform := cmp.Transformer("jsoncmp", func(s string) (m map[string]any) {
-		if err := json.Unmarshal([]byte(s), &m); err != nil {
+	xform := cmp.Transformer("jsoncmp", func(s string) (v any) {
+		if err := json.Unmarshal([]byte(s), &v); err != nil {
 			panic(fmt.Sprintf("json.Unmarshal(%s): %s", s, err))
 		}
-		return m
+		return v
 	})
 	opt := cmp.FilterPath(func(p cmp.Path) bool {
 		for _, ps := range p {
@@ -28,6 +28,34 @@ func TestDiff(t *testing.T) {
 			y:        `{"A":"test1", "B":41, "C":{"A":true}, "D": ["test3"]}`,
 			wantDiff: true,
 		},
+		{
+			testName: "array no diff",
+			x:        `[{"A": "test1", "B": 42}, {"C": true}]`,
+			y:        `[{"B":42, "A":"test1"}, {"C":true}]`,
+		},
+		{
+			testName: "array has diff",
+			x:        `[{"A": "test1"}, {"C": true}]`,
+			y:        `[{"C": true}, {"A": "test1"}]`,
+			wantDiff: true,
+		},
+		{
+			testName: "array and object",
+			x:        `[{"A": "test1"}]`,
+			y:        `{"A": "test1"}`,
+			wantDiff: true,
+		},
+		{
+			testName: "scalar no diff",
+			x:        `"test1"`,
+			y:        ` "test1" `,
+		},
+		{
+			testName: "scalar has diff",
+			x:        `42`,
+			y:        `41`,
+			wantDiff: true,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package statecheck
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/jsoncmp"
+)
+
+var _ knownvalue.Check = jsonEquivalent{}
+
+type jsonEquivalent struct {
+	value string
+}
+
+// CheckValue determines whether the passed value is of type string, and
+// contains a JSON document equivalent to the expected value, ignoring
+// whitespace and object key order.
+func (v jsonEquivalent) CheckValue(other any) error {
+	otherVal, ok := other.(string)
+
+	if !ok {
+		return fmt.Errorf("expected string value for JSONEquivalent check, got: %T", other)
+	}
+
+	if !json.Valid([]byte(v.value)) {
+		return fmt.Errorf("invalid expected JSON value for JSONEquivalent check: %s", v.value)
+	}
+
+	if !json.Valid([]byte(otherVal)) {
+		return fmt.Errorf("expected JSON value for JSONEquivalent check, got: %s", otherVal)
+	}
+
+	if diff := jsoncmp.Diff(v.value, otherVal); diff != "" {
+		return fmt.Errorf("unexpected JSON value for JSONEquivalent check (-want +got): %s", diff)
+	}
+
+	return nil
+}
+
+// String returns the string representation of the value.
+func (v jsonEquivalent) String() string {
+	return v.value
+}
+
+// JSONEquivalent returns a Check for asserting equivalence between the
+// JSON document in the supplied string and the value passed to the
+// CheckValue method.
+func JSONEquivalent(value string) knownvalue.Check {
+	return jsonEquivalent{
+		value: value,
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package statecheck_test
+
+import (
+	"testing"
+
+	tfknownvalue "github.com/hashicorp/terraform-provider-aws/internal/acctest/knownvalue"
+)
+
+func TestJSONEquivalent(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		testName  string
+		value     string
+		other     any
+		wantError bool
+	}{
+		{
+			testName: "identical",
+			value:    `{"A":"test1","B":42}`,
+			other:    `{"A":"test1","B":42}`,
+		},
+		{
+			testName: "whitespace and key order",
+			value:    `{"A": "test1", "B": 42, "C": {"D": true}}`,
+			other:    `{"C":{"D":true},"B":42,"A":"test1"}`,
+		},
+		{
+			testName: "array",
+			value:    `[{"A": "test1"}, {"B": 42}]`,
+			other:    `[{"A":"test1"},{"B":42}]`,
+		},
+		{
+			testName:  "array order",
+			value:     `[{"A": "test1"}, {"B": 42}]`,
+			other:     `[{"B":42},{"A":"test1"}]`,
+			wantError: true,
+		},
+		{
+			testName:  "different value",
+			value:     `{"A": "test1", "B": 42}`,
+			other:     `{"A":"test1","B":41}`,
+			wantError: true,
+		},
+		{
+			testName:  "missing key",
+			value:     `{"A": "test1", "B": 42}`,
+			other:     `{"A":"test1"}`,
+			wantError: true,
+		},
+		{
+			testName:  "invalid expected JSON",
+			value:     `{"A":`,
+			other:     `{"A":"test1"}`,
+			wantError: true,
+		},
+		{
+			testName:  "invalid JSON",
+			value:     `{"A": "test1"}`,
+			other:     `{"A":`,
+			wantError: true,
+		},
+		{
+			testName:  "not a string",
+			value:     `42`,
+			other:     42,
+			wantError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfknownvalue.JSONEquivalent(testCase.value).CheckValue(testCase.other)
+
+			if got, want := err != nil, testCase.wantError; got != want {
+				t.Errorf("CheckValue(%v) error = %v, want error: %t", testCase.other, err, want)
+			}
+		})
+	}
+}
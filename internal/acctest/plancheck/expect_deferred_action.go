@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plancheck
+
+import (
+	"context"
+	"fmt"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+var _ plancheck.PlanCheck = expectDeferredActionCheck{}
+
+type expectDeferredActionCheck struct {
+	base   Base
+	reason plancheck.DeferredReason
+	action plancheck.ResourceActionType
+}
+
+func (e expectDeferredActionCheck) CheckPlan(ctx context.Context, request plancheck.CheckPlanRequest, response *plancheck.CheckPlanResponse) {
+	if request.Plan == nil {
+		response.Error = fmt.Errorf("plan is nil")
+
+		return
+	}
+
+	var deferred *tfjson.DeferredResourceChange
+
+	for _, v := range request.Plan.DeferredChanges {
+		if v.ResourceChange != nil && v.ResourceChange.Address == e.base.ResourceAddress() {
+			deferred = v
+
+			break
+		}
+	}
+
+	if deferred == nil {
+		response.Error = fmt.Errorf("%s - Deferred change not found in plan", e.base.ResourceAddress())
+
+		return
+	}
+
+	if got, want := deferred.Reason, string(e.reason); got != want {
+		response.Error = fmt.Errorf("%s - expected deferred reason %s, got: %s", e.base.ResourceAddress(), want, got)
+
+		return
+	}
+
+	if actions := deferred.ResourceChange.Change.Actions; !isResourceAction(actions, e.action) {
+		response.Error = fmt.Errorf("%s - expected deferred action %s, got actions %v", e.base.ResourceAddress(), e.action, actions)
+
+		return
+	}
+}
+
+// ExpectDeferredAction returns a plan check that asserts that the resource's change is deferred
+// for the specified reason and that the deferred change is the specified action.
+func ExpectDeferredAction(resourceAddress string, reason plancheck.DeferredReason, action plancheck.ResourceActionType) plancheck.PlanCheck {
+	return expectDeferredActionCheck{
+		base:   NewBase(resourceAddress),
+		reason: reason,
+		action: action,
+	}
+}
+
+func isResourceAction(actions tfjson.Actions, action plancheck.ResourceActionType) bool {
+	switch action {
+	case plancheck.ResourceActionNoop:
+		return actions.NoOp()
+	case plancheck.ResourceActionCreate:
+		return actions.Create()
+	case plancheck.ResourceActionRead:
+		return actions.Read()
+	case plancheck.ResourceActionUpdate:
+		return actions.Update()
+	case plancheck.ResourceActionDestroy:
+		return actions.Delete()
+	case plancheck.ResourceActionDestroyBeforeCreate:
+		return actions.DestroyBeforeCreate()
+	case plancheck.ResourceActionCreateBeforeDestroy:
+		return actions.CreateBeforeDestroy()
+	case plancheck.ResourceActionReplace:
+		return actions.Replace()
+	default:
+		return false
+	}
+}
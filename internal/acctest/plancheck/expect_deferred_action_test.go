@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plancheck_test
+
+import (
+	"context"
+	"testing"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	tfplancheck "github.com/hashicorp/terraform-provider-aws/internal/acctest/plancheck"
+)
+
+func TestExpectDeferredAction(t *testing.T) {
+	t.Parallel()
+
+	const resourceAddress = "aws_example_thing.test"
+
+	testCases := []struct {
+		testName  string
+		plan      *tfjson.Plan
+		action    plancheck.ResourceActionType
+		wantError bool
+	}{
+		{
+			testName: "deferred create",
+			plan:     testPlanDeferredChange(resourceAddress, string(plancheck.DeferredReasonProviderConfigUnknown), tfjson.Actions{tfjson.ActionCreate}),
+			action:   plancheck.ResourceActionCreate,
+		},
+		{
+			testName: "deferred replace",
+			plan:     testPlanDeferredChange(resourceAddress, string(plancheck.DeferredReasonProviderConfigUnknown), tfjson.Actions{tfjson.ActionDelete, tfjson.ActionCreate}),
+			action:   plancheck.ResourceActionReplace,
+		},
+		{
+			testName:  "wrong reason",
+			plan:      testPlanDeferredChange(resourceAddress, string(plancheck.DeferredReasonResourceConfigUnknown), tfjson.Actions{tfjson.ActionCreate}),
+			action:    plancheck.ResourceActionCreate,
+			wantError: true,
+		},
+		{
+			testName:  "wrong action",
+			plan:      testPlanDeferredChange(resourceAddress, string(plancheck.DeferredReasonProviderConfigUnknown), tfjson.Actions{tfjson.ActionUpdate}),
+			action:    plancheck.ResourceActionCreate,
+			wantError: true,
+		},
+		{
+			testName:  "other resource deferred",
+			plan:      testPlanDeferredChange("aws_example_thing.other", string(plancheck.DeferredReasonProviderConfigUnknown), tfjson.Actions{tfjson.ActionCreate}),
+			action:    plancheck.ResourceActionCreate,
+			wantError: true,
+		},
+		{
+			testName:  "not deferred",
+			plan:      testPlanResourceChange(resourceAddress, tfjson.Actions{tfjson.ActionCreate}),
+			action:    plancheck.ResourceActionCreate,
+			wantError: true,
+		},
+		{
+			testName:  "nil plan",
+			action:    plancheck.ResourceActionCreate,
+			wantError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			request := plancheck.CheckPlanRequest{
+				Plan: testCase.plan,
+			}
+			var response plancheck.CheckPlanResponse
+
+			tfplancheck.ExpectDeferredAction(resourceAddress, plancheck.DeferredReasonProviderConfigUnknown, testCase.action).CheckPlan(context.Background(), request, &response)
+
+			if got, want := response.Error != nil, testCase.wantError; got != want {
+				t.Errorf("CheckPlan error = %v, want error: %t", response.Error, want)
+			}
+		})
+	}
+}
+
+func testPlanDeferredChange(resourceAddress, reason string, actions tfjson.Actions) *tfjson.Plan {
+	return &tfjson.Plan{
+		DeferredChanges: []*tfjson.DeferredResourceChange{
+			{
+				Reason: reason,
+				ResourceChange: &tfjson.ResourceChange{
+					Address: resourceAddress,
+					Change: &tfjson.Change{
+						Actions: actions,
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plancheck
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+var _ plancheck.PlanCheck = expectNoReplacementCheck{}
+
+type expectNoReplacementCheck struct {
+	base Base
+}
+
+func (e expectNoReplacementCheck) CheckPlan(ctx context.Context, request plancheck.CheckPlanRequest, response *plancheck.CheckPlanResponse) {
+	resource, ok := e.base.ResourceFromState(request, response)
+	if !ok {
+		return
+	}
+
+	if resource.Change.Actions.Replace() {
+		response.Error = fmt.Errorf("%s - expected no replacement, got actions %v, replace paths: %v", resource.Address, resource.Change.Actions, resource.Change.ReplacePaths)
+
+		return
+	}
+}
+
+// ExpectNoReplacement returns a plan check that asserts that the resource is not planned to be destroyed and re-created.
+// Unlike plancheck.ExpectResourceAction, any other action, including no-op, passes the check.
+func ExpectNoReplacement(resourceAddress string) plancheck.PlanCheck {
+	return expectNoReplacementCheck{
+		base: NewBase(resourceAddress),
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plancheck_test
+
+import (
+	"context"
+	"testing"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	tfplancheck "github.com/hashicorp/terraform-provider-aws/internal/acctest/plancheck"
+)
+
+func TestExpectNoReplacement(t *testing.T) {
+	t.Parallel()
+
+	const resourceAddress = "aws_example_thing.test"
+
+	testCases := []struct {
+		testName  string
+		plan      *tfjson.Plan
+		wantError bool
+	}{
+		{
+			testName: "no-op",
+			plan:     testPlanResourceChange(resourceAddress, tfjson.Actions{tfjson.ActionNoop}),
+		},
+		{
+			testName: "create",
+			plan:     testPlanResourceChange(resourceAddress, tfjson.Actions{tfjson.ActionCreate}),
+		},
+		{
+			testName: "update",
+			plan:     testPlanResourceChange(resourceAddress, tfjson.Actions{tfjson.ActionUpdate}),
+		},
+		{
+			testName: "destroy",
+			plan:     testPlanResourceChange(resourceAddress, tfjson.Actions{tfjson.ActionDelete}),
+		},
+		{
+			testName:  "destroy before create",
+			plan:      testPlanResourceChange(resourceAddress, tfjson.Actions{tfjson.ActionDelete, tfjson.ActionCreate}),
+			wantError: true,
+		},
+		{
+			testName:  "create before destroy",
+			plan:      testPlanResourceChange(resourceAddress, tfjson.Actions{tfjson.ActionCreate, tfjson.ActionDelete}),
+			wantError: true,
+		},
+		{
+			testName:  "resource not found",
+			plan:      testPlanResourceChange("aws_example_thing.other", tfjson.Actions{tfjson.ActionUpdate}),
+			wantError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			request := plancheck.CheckPlanRequest{
+				Plan: testCase.plan,
+			}
+			var response plancheck.CheckPlanResponse
+
+			tfplancheck.ExpectNoReplacement(resourceAddress).CheckPlan(context.Background(), request, &response)
+
+			if got, want := response.Error != nil, testCase.wantError; got != want {
+				t.Errorf("CheckPlan error = %v, want error: %t", response.Error, want)
+			}
+		})
+	}
+}
+
+func testPlanResourceChange(resourceAddress string, actions tfjson.Actions) *tfjson.Plan {
+	return &tfjson.Plan{
+		ResourceChanges: []*tfjson.ResourceChange{
+			{
+				Address: resourceAddress,
+				Change: &tfjson.Change{
+					Actions: actions,
+				},
+			},
+		},
+	}
+}
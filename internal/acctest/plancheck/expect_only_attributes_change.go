@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plancheck
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var _ plancheck.PlanCheck = expectOnlyAttributesChangeCheck{}
+
+type expectOnlyAttributesChangeCheck struct {
+	base           Base
+	attributePaths []tfjsonpath.Path
+	updateOnly     bool
+}
+
+func (e expectOnlyAttributesChangeCheck) CheckPlan(ctx context.Context, request plancheck.CheckPlanRequest, response *plancheck.CheckPlanResponse) {
+	resource, ok := e.base.ResourceFromState(request, response)
+	if !ok {
+		return
+	}
+
+	if e.updateOnly && !resource.Change.Actions.Update() {
+		response.Error = fmt.Errorf("%s - expected update, got actions %v", resource.Address, resource.Change.Actions)
+
+		return
+	}
+
+	var unexpected []string
+	for _, path := range changedAttributePaths(resource.Change) {
+		if !slices.ContainsFunc(e.attributePaths, func(p tfjsonpath.Path) bool {
+			return isPathOrDescendant(path, p.String())
+		}) {
+			unexpected = append(unexpected, path)
+		}
+	}
+
+	if len(unexpected) > 0 {
+		response.Error = fmt.Errorf("%s - unexpected changes to attributes at paths: %s", resource.Address, strings.Join(unexpected, ", "))
+
+		return
+	}
+}
+
+// ExpectOnlyAttributesChange returns a plan check that asserts that the planned changes to the resource
+// are limited to the specified attributes and their nested attributes.
+// An attribute whose planned value is unknown is considered to be changed.
+func ExpectOnlyAttributesChange(resourceAddress string, attributePaths ...tfjsonpath.Path) plancheck.PlanCheck {
+	return expectOnlyAttributesChangeCheck{
+		base:           NewBase(resourceAddress),
+		attributePaths: attributePaths,
+	}
+}
+
+// ExpectTagsOnlyUpdate returns a plan check that asserts that the resource is updated in-place
+// and that only its tags are changed.
+func ExpectTagsOnlyUpdate(resourceAddress string) plancheck.PlanCheck {
+	return expectOnlyAttributesChangeCheck{
+		base:           NewBase(resourceAddress),
+		attributePaths: []tfjsonpath.Path{tfjsonpath.New(names.AttrTags), tfjsonpath.New(names.AttrTagsAll)},
+		updateOnly:     true,
+	}
+}
+
+// changedAttributePaths returns the paths, in tfjsonpath.Path string format, of the values changed by a planned change.
+func changedAttributePaths(change *tfjson.Change) []string {
+	var paths []string
+
+	if change == nil {
+		return paths
+	}
+
+	// A resource being created has no prior value and one being destroyed has no planned value.
+	before, after := change.Before, change.After
+	if before == nil {
+		before = map[string]any{}
+	}
+	if after == nil {
+		after = map[string]any{}
+	}
+
+	walkChanges(nil, before, after, change.AfterUnknown, func(path []string) {
+		paths = append(paths, strings.Join(path, "."))
+	})
+
+	slices.Sort(paths)
+
+	return paths
+}
+
+func walkChanges(path []string, before, after, afterUnknown any, f func([]string)) {
+	if v, ok := afterUnknown.(bool); ok && v {
+		f(path)
+
+		return
+	}
+
+	switch after := after.(type) {
+	case map[string]any:
+		before, ok := before.(map[string]any)
+		if !ok {
+			f(path)
+
+			return
+		}
+		afterUnknown, _ := afterUnknown.(map[string]any)
+
+		keys := make(map[string]struct{})
+		for k := range before {
+			keys[k] = struct{}{}
+		}
+		for k := range after {
+			keys[k] = struct{}{}
+		}
+		for k := range afterUnknown {
+			keys[k] = struct{}{}
+		}
+
+		for k := range keys {
+			walkChanges(append(slices.Clip(path), k), before[k], after[k], afterUnknown[k], f)
+		}
+
+	case []any:
+		before, ok := before.([]any)
+		if !ok || len(before) != len(after) {
+			f(path)
+
+			return
+		}
+		afterUnknown, _ := afterUnknown.([]any)
+
+		for i := range after {
+			var elemUnknown any
+			if i < len(afterUnknown) {
+				elemUnknown = afterUnknown[i]
+			}
+			walkChanges(append(slices.Clip(path), fmt.Sprint(i)), before[i], after[i], elemUnknown, f)
+		}
+
+	default:
+		if !reflect.DeepEqual(before, after) {
+			f(path)
+		}
+	}
+}
+
+// isPathOrDescendant returns whether path is the same as, or nested within, ancestor.
+func isPathOrDescendant(path, ancestor string) bool {
+	return path == ancestor || strings.HasPrefix(path, ancestor+".")
+}
@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plancheck_test
+
+import (
+	"context"
+	"testing"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	tfplancheck "github.com/hashicorp/terraform-provider-aws/internal/acctest/plancheck"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestExpectOnlyAttributesChange(t *testing.T) {
+	t.Parallel()
+
+	const resourceAddress = "aws_example_thing.test"
+
+	testCases := []struct {
+		testName  string
+		check     plancheck.PlanCheck
+		change    *tfjson.Change
+		wantError bool
+	}{
+		{
+			testName: "no change",
+			check:    tfplancheck.ExpectOnlyAttributesChange(resourceAddress),
+			change: &tfjson.Change{
+				Actions: tfjson.Actions{tfjson.ActionNoop},
+				Before:  map[string]any{names.AttrName: "test"},
+				After:   map[string]any{names.AttrName: "test"},
+			},
+		},
+		{
+			testName: "expected change",
+			check:    tfplancheck.ExpectOnlyAttributesChange(resourceAddress, tfjsonpath.New(names.AttrDescription)),
+			change: &tfjson.Change{
+				Actions: tfjson.Actions{tfjson.ActionUpdate},
+				Before:  map[string]any{names.AttrName: "test", names.AttrDescription: "old"},
+				After:   map[string]any{names.AttrName: "test", names.AttrDescription: "new"},
+			},
+		},
+		{
+			testName: "unexpected change",
+			check:    tfplancheck.ExpectOnlyAttributesChange(resourceAddress, tfjsonpath.New(names.AttrDescription)),
+			change: &tfjson.Change{
+				Actions: tfjson.Actions{tfjson.ActionUpdate},
+				Before:  map[string]any{names.AttrName: "old", names.AttrDescription: "old"},
+				After:   map[string]any{names.AttrName: "new", names.AttrDescription: "new"},
+			},
+			wantError: true,
+		},
+		{
+			testName: "nested change",
+			check:    tfplancheck.ExpectOnlyAttributesChange(resourceAddress, tfjsonpath.New("setting").AtSliceIndex(0).AtMapKey(names.AttrValue)),
+			change: &tfjson.Change{
+				Actions: tfjson.Actions{tfjson.ActionUpdate},
+				Before:  map[string]any{"setting": []any{map[string]any{names.AttrName: "a", names.AttrValue: "1"}}},
+				After:   map[string]any{"setting": []any{map[string]any{names.AttrName: "a", names.AttrValue: "2"}}},
+			},
+		},
+		{
+			testName: "unknown value",
+			check:    tfplancheck.ExpectOnlyAttributesChange(resourceAddress, tfjsonpath.New(names.AttrDescription)),
+			change: &tfjson.Change{
+				Actions:      tfjson.Actions{tfjson.ActionUpdate},
+				Before:       map[string]any{names.AttrDescription: "old", "version": "1"},
+				After:        map[string]any{names.AttrDescription: "new"},
+				AfterUnknown: map[string]any{"version": true},
+			},
+			wantError: true,
+		},
+		{
+			testName: "tags only",
+			check:    tfplancheck.ExpectTagsOnlyUpdate(resourceAddress),
+			change: &tfjson.Change{
+				Actions: tfjson.Actions{tfjson.ActionUpdate},
+				Before:  map[string]any{names.AttrName: "test", names.AttrTags: map[string]any{"key1": "value1"}, names.AttrTagsAll: map[string]any{"key1": "value1"}},
+				After:   map[string]any{names.AttrName: "test", names.AttrTags: map[string]any{"key1": "value2"}, names.AttrTagsAll: map[string]any{"key1": "value2"}},
+			},
+		},
+		{
+			testName: "tags only replace",
+			check:    tfplancheck.ExpectTagsOnlyUpdate(resourceAddress),
+			change: &tfjson.Change{
+				Actions: tfjson.Actions{tfjson.ActionDelete, tfjson.ActionCreate},
+				Before:  map[string]any{names.AttrTags: map[string]any{"key1": "value1"}},
+				After:   map[string]any{names.AttrTags: map[string]any{"key1": "value2"}},
+			},
+			wantError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			request := plancheck.CheckPlanRequest{
+				Plan: &tfjson.Plan{
+					ResourceChanges: []*tfjson.ResourceChange{
+						{
+							Address: resourceAddress,
+							Change:  testCase.change,
+						},
+					},
+				},
+			}
+			var response plancheck.CheckPlanResponse
+
+			testCase.check.CheckPlan(context.Background(), request, &response)
+
+			if got, want := response.Error != nil, testCase.wantError; got != want {
+				t.Errorf("CheckPlan error = %v, want error: %t", response.Error, want)
+			}
+		})
+	}
+}
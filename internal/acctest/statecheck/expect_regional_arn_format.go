@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package statecheck
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	tfknownvalue "github.com/hashicorp/terraform-provider-aws/internal/acctest/knownvalue"
+)
+
+var _ statecheck.StateCheck = expectRegionalARNFormatCheck{}
+
+var arnFormatAttributeRegexp = regexache.MustCompile(`\{([^{}]+)\}`)
+
+type expectRegionalARNFormatCheck struct {
+	base          Base
+	attributePath tfjsonpath.Path
+	arnService    string
+	arnFormat     string
+}
+
+func (e expectRegionalARNFormatCheck) CheckState(ctx context.Context, request statecheck.CheckStateRequest, response *statecheck.CheckStateResponse) {
+	resource, ok := e.base.ResourceFromState(request, response)
+	if !ok {
+		return
+	}
+
+	value, err := tfjsonpath.Traverse(resource.AttributeValues, e.attributePath)
+	if err != nil {
+		response.Error = err
+
+		return
+	}
+
+	var errs []error
+	arnResource := arnFormatAttributeRegexp.ReplaceAllStringFunc(e.arnFormat, func(s string) string {
+		name := arnFormatAttributeRegexp.FindStringSubmatch(s)[1]
+		v, ok := resource.AttributeValues[name].(string)
+		if !ok {
+			errs = append(errs, fmt.Errorf("attribute %q referenced in ARN format %q is not a string, got: %T", name, e.arnFormat, resource.AttributeValues[name]))
+		}
+		return v
+	})
+	if len(errs) > 0 {
+		response.Error = errs[0]
+
+		return
+	}
+
+	if err := tfknownvalue.RegionalARNExact(e.arnService, arnResource).CheckValue(value); err != nil {
+		response.Error = fmt.Errorf("checking value for attribute at path: %s.%s, err: %s", resource.Address, e.attributePath.String(), err)
+
+		return
+	}
+}
+
+// ExpectRegionalARNFormat returns a state check that asserts that the value at attributePath is the ARN
+// of a resource in the acceptance test account and Region, for the specified service.
+// The ARN's resource part is arnFormat with each "{attribute}" reference replaced by the value of the
+// resource's top-level string attribute, for example "log-group:{name}".
+func ExpectRegionalARNFormat(resourceAddress string, attributePath tfjsonpath.Path, arnService, arnFormat string) statecheck.StateCheck {
+	return expectRegionalARNFormatCheck{
+		base:          NewBase(resourceAddress),
+		attributePath: attributePath,
+		arnService:    arnService,
+		arnFormat:     arnFormat,
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package statecheck_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfstatecheck "github.com/hashicorp/terraform-provider-aws/internal/acctest/statecheck"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestExpectRegionalARNFormat(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	const resourceAddress = "aws_example_thing.test"
+	regionalARN := func(resource string) string {
+		return arn.ARN{
+			AccountID: acctest.AccountID(ctx),
+			Partition: acctest.Partition(),
+			Region:    acctest.Region(),
+			Service:   "logs",
+			Resource:  resource,
+		}.String()
+	}
+
+	testCases := []struct {
+		testName        string
+		arnFormat       string
+		attributeValues map[string]any
+		wantError       bool
+	}{
+		{
+			testName:  "no attribute references",
+			arnFormat: "log-group:test",
+			attributeValues: map[string]any{
+				names.AttrARN: regionalARN("log-group:test"),
+			},
+		},
+		{
+			testName:  "attribute reference",
+			arnFormat: "log-group:{name}",
+			attributeValues: map[string]any{
+				names.AttrARN:  regionalARN("log-group:test"),
+				names.AttrName: "test",
+			},
+		},
+		{
+			testName:  "multiple attribute references",
+			arnFormat: "log-group:{name}:log-stream:{stream}",
+			attributeValues: map[string]any{
+				names.AttrARN:  regionalARN("log-group:test:log-stream:s1"),
+				names.AttrName: "test",
+				"stream":       "s1",
+			},
+		},
+		{
+			testName:  "mismatch",
+			arnFormat: "log-group:{name}",
+			attributeValues: map[string]any{
+				names.AttrARN:  regionalARN("log-group:other"),
+				names.AttrName: "test",
+			},
+			wantError: true,
+		},
+		{
+			testName:  "other service",
+			arnFormat: "log-group:{name}",
+			attributeValues: map[string]any{
+				names.AttrARN: arn.ARN{
+					AccountID: acctest.AccountID(ctx),
+					Partition: acctest.Partition(),
+					Region:    acctest.Region(),
+					Service:   "sns",
+					Resource:  "log-group:test",
+				}.String(),
+				names.AttrName: "test",
+			},
+			wantError: true,
+		},
+		{
+			testName:  "referenced attribute not set",
+			arnFormat: "log-group:{name}",
+			attributeValues: map[string]any{
+				names.AttrARN: regionalARN("log-group:"),
+			},
+			wantError: true,
+		},
+		{
+			testName:  "referenced attribute not a string",
+			arnFormat: "log-group:{name}",
+			attributeValues: map[string]any{
+				names.AttrARN:  regionalARN("log-group:"),
+				names.AttrName: 42,
+			},
+			wantError: true,
+		},
+		{
+			testName:  "attribute not set",
+			arnFormat: "log-group:{name}",
+			attributeValues: map[string]any{
+				names.AttrName: "test",
+			},
+			wantError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			request := statecheck.CheckStateRequest{
+				State: &tfjson.State{
+					Values: &tfjson.StateValues{
+						RootModule: &tfjson.StateModule{
+							Resources: []*tfjson.StateResource{
+								{
+									Address:         resourceAddress,
+									AttributeValues: testCase.attributeValues,
+								},
+							},
+						},
+					},
+				},
+			}
+			var response statecheck.CheckStateResponse
+
+			tfstatecheck.ExpectRegionalARNFormat(resourceAddress, tfjsonpath.New(names.AttrARN), "logs", testCase.arnFormat).CheckState(ctx, request, &response)
+
+			if got, want := response.Error != nil, testCase.wantError; got != want {
+				t.Errorf("CheckState error = %v, want error: %t", response.Error, want)
+			}
+		})
+	}
+}
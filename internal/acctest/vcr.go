@@ -4,25 +4,23 @@
 package acctest
 
 import (
-	"bytes"
 	"context"
 	"crypto/tls"
-	"encoding/json"
-	"encoding/xml"
+	"errors"
 	"fmt"
-	"io"
+	"io/fs"
 	"math/rand"
 	"net/http"
 	"os"
 	"path/filepath"
-	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"testing"
 
 	cleanhttp "github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -39,12 +37,19 @@ const (
 	envVarVCRPath = "VCR_PATH"
 )
 
+// randomnessSource is a test's source of random values and the seed it was created from.
 type randomnessSource struct {
 	seed   int64
 	source rand.Source
 }
 
-type metaMap map[string]*conns.AWSClient
+// providerKey identifies a provider instance configured by a test.
+type providerKey struct {
+	testName     string
+	providerName string
+}
+
+type metaMap map[providerKey]*conns.AWSClient
 
 func (m metaMap) Lock() {
 	conns.GlobalMutexKV.Lock(m.key())
@@ -58,6 +63,20 @@ func (m metaMap) key() string {
 	return "vcr-metas"
 }
 
+type recorderMap map[string]*recorder.Recorder
+
+func (m recorderMap) Lock() {
+	conns.GlobalMutexKV.Lock(m.key())
+}
+
+func (m recorderMap) Unlock() {
+	conns.GlobalMutexKV.Unlock(m.key())
+}
+
+func (m recorderMap) key() string {
+	return "vcr-recorders"
+}
+
 type randomnessSourceMap map[string]*randomnessSource
 
 func (m randomnessSourceMap) Lock() {
@@ -73,8 +92,9 @@ func (m randomnessSourceMap) key() string {
 }
 
 var (
-	providerMetas     = metaMap(make(map[string]*conns.AWSClient, 0))
+	providerMetas     = metaMap(make(map[providerKey]*conns.AWSClient, 0))
 	randomnessSources = randomnessSourceMap(make(map[string]*randomnessSource, 0))
+	recorders         = recorderMap(make(map[string]*recorder.Recorder, 0))
 )
 
 // ProviderMeta returns the current provider's state (AKA "meta" or "conns.AWSClient").
@@ -82,7 +102,7 @@ func ProviderMeta(_ context.Context, t *testing.T) *conns.AWSClient {
 	t.Helper()
 
 	providerMetas.Lock()
-	meta, ok := providerMetas[t.Name()]
+	meta, ok := providerMetas[providerKey{testName: t.Name(), providerName: ProviderName}]
 	defer providerMetas.Unlock()
 
 	if !ok {
@@ -96,6 +116,18 @@ func isVCREnabled() bool {
 	return os.Getenv(envVarVCRMode) != "" && os.Getenv(envVarVCRPath) != ""
 }
 
+// isVCRReplaying returns whether acceptance tests are being replayed from recorded cassettes.
+// No AWS API calls are made outside of recorded interactions.
+func isVCRReplaying() bool {
+	if !isVCREnabled() {
+		return false
+	}
+
+	mode, err := vcrMode()
+
+	return err == nil && mode == recorder.ModeReplayOnly
+}
+
 func vcrMode() (recorder.Mode, error) {
 	switch v := os.Getenv(envVarVCRMode); v {
 	case "RECORDING":
@@ -107,10 +139,48 @@ func vcrMode() (recorder.Mode, error) {
 	}
 }
 
+// vcrTestCase returns a copy of the test case with all AWS provider factories ready for use with VCR.
+// Protocol version 5 and 6 factories are supported.
+// In REPLAYING mode, tests without a recorded cassette and tests using external providers are skipped.
+func vcrTestCase(ctx context.Context, t *testing.T, c resource.TestCase) resource.TestCase {
+	t.Helper()
+
+	if isVCRReplaying() {
+		if _, err := os.Stat(cassette.New(vcrCassetteName(t.Name())).File); err != nil {
+			t.Skipf("skipping test in %s mode: no cassette found for %s", os.Getenv(envVarVCRMode), t.Name())
+		}
+
+		if len(c.ExternalProviders) > 0 || slices.ContainsFunc(c.Steps, func(step resource.TestStep) bool {
+			return len(step.ExternalProviders) > 0
+		}) {
+			t.Skipf("skipping test in %s mode: external providers cannot be replayed", os.Getenv(envVarVCRMode))
+		}
+	}
+
+	c.ProtoV5ProviderFactories = vcrEnabledProtoV5ProviderFactories(ctx, t, c.ProtoV5ProviderFactories)
+	c.ProtoV6ProviderFactories = vcrEnabledProtoV6ProviderFactories(ctx, t, c.ProtoV6ProviderFactories)
+
+	c.Steps = slices.Clone(c.Steps)
+	for i, step := range c.Steps {
+		if step.ProtoV5ProviderFactories != nil {
+			c.Steps[i].ProtoV5ProviderFactories = vcrEnabledProtoV5ProviderFactories(ctx, t, step.ProtoV5ProviderFactories)
+		}
+		if step.ProtoV6ProviderFactories != nil {
+			c.Steps[i].ProtoV6ProviderFactories = vcrEnabledProtoV6ProviderFactories(ctx, t, step.ProtoV6ProviderFactories)
+		}
+	}
+
+	return c
+}
+
 // vcrEnabledProtoV5ProviderFactories returns ProtoV5ProviderFactories ready for use with VCR.
 func vcrEnabledProtoV5ProviderFactories(ctx context.Context, t *testing.T, input map[string]func() (tfprotov5.ProviderServer, error)) map[string]func() (tfprotov5.ProviderServer, error) {
 	t.Helper()
 
+	if input == nil {
+		return nil
+	}
+
 	output := make(map[string]func() (tfprotov5.ProviderServer, error), len(input))
 
 	for name := range input {
@@ -121,7 +191,40 @@ func vcrEnabledProtoV5ProviderFactories(ctx context.Context, t *testing.T, input
 				return nil, err
 			}
 
-			primary.ConfigureContextFunc = vcrProviderConfigureContextFunc(primary, primary.ConfigureContextFunc, t.Name())
+			primary.ConfigureContextFunc = vcrProviderConfigureContextFunc(primary, primary.ConfigureContextFunc, providerKey{testName: t.Name(), providerName: name})
+
+			return providerServerFactory(), nil
+		}
+	}
+
+	return output
+}
+
+// vcrEnabledProtoV6ProviderFactories returns ProtoV6ProviderFactories ready for use with VCR.
+// Factories for providers other than the AWS provider, e.g. the echo provider, make no AWS API calls and are returned unchanged.
+func vcrEnabledProtoV6ProviderFactories(ctx context.Context, t *testing.T, input map[string]func() (tfprotov6.ProviderServer, error)) map[string]func() (tfprotov6.ProviderServer, error) {
+	t.Helper()
+
+	if input == nil {
+		return nil
+	}
+
+	output := make(map[string]func() (tfprotov6.ProviderServer, error), len(input))
+
+	for name, factory := range input {
+		if !isAWSProviderName(name) {
+			output[name] = factory
+			continue
+		}
+
+		output[name] = func() (tfprotov6.ProviderServer, error) {
+			providerServerFactory, primary, err := provider.ProtoV6ProviderServerFactory(ctx)
+
+			if err != nil {
+				return nil, err
+			}
+
+			primary.ConfigureContextFunc = vcrProviderConfigureContextFunc(primary, primary.ConfigureContextFunc, providerKey{testName: t.Name(), providerName: name})
 
 			return providerServerFactory(), nil
 		}
@@ -132,133 +235,31 @@ func vcrEnabledProtoV5ProviderFactories(ctx context.Context, t *testing.T, input
 
 // vcrProviderConfigureContextFunc returns a provider configuration function returning cached provider instance state.
 // This is necessary as ConfigureContextFunc is called multiple times for a given test, each time creating a new HTTP client.
-// VCR requires a single HTTP client to handle all interactions.
-func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContextFunc schema.ConfigureContextFunc, testName string) schema.ConfigureContextFunc {
+// VCR requires a single recorder to handle all of a test's interactions.
+func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContextFunc schema.ConfigureContextFunc, key providerKey) schema.ConfigureContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData) (any, diag.Diagnostics) {
 		var diags diag.Diagnostics
 
 		providerMetas.Lock()
-		meta, ok := providerMetas[testName]
+		meta, ok := providerMetas[key]
 		defer providerMetas.Unlock()
 
 		if ok {
 			return meta, nil
 		}
 
-		vcrMode, err := vcrMode()
-
-		if err != nil {
-			return nil, sdkdiag.AppendFromErr(diags, err)
-		}
-
-		// Cribbed from aws-sdk-go-base.
-		httpClient := cleanhttp.DefaultPooledClient()
-		transport := httpClient.Transport.(*http.Transport)
-		transport.MaxIdleConnsPerHost = 10
-		tlsConfig := transport.TLSClientConfig
-		if tlsConfig == nil {
-			tlsConfig = &tls.Config{}
-			transport.TLSClientConfig = tlsConfig
-		}
-		tlsConfig.MinVersion = tls.VersionTLS12
-
-		path := filepath.Join(os.Getenv(envVarVCRPath), vcrFileName(testName))
-
-		// Create a VCR recorder around a default HTTP client.
-		r, err := recorder.NewWithOptions(&recorder.Options{
-			CassetteName:  path,
-			Mode:          vcrMode,
-			RealTransport: httpClient.Transport,
-		})
+		r, err := vcrRecorder(ctx, key.testName)
 
 		if err != nil {
 			return nil, sdkdiag.AppendFromErr(diags, err)
 		}
 
-		// Remove sensitive HTTP headers.
-		r.AddHook(func(i *cassette.Interaction) error {
-			delete(i.Request.Headers, "Authorization")
-			delete(i.Request.Headers, "X-Amz-Security-Token")
-
-			return nil
-		}, recorder.AfterCaptureHook)
-
-		// Defines how VCR will match requests to responses.
-		r.SetMatcher(func(r *http.Request, i cassette.Request) bool {
-			// Default matcher compares method and URL only.
-			if !cassette.DefaultMatcher(r, i) {
-				return false
-			}
-
-			if r.Body == nil {
-				return true
-			}
-
-			var b bytes.Buffer
-			if _, err := b.ReadFrom(r.Body); err != nil {
-				tflog.Debug(ctx, "Failed to read request body from cassette", map[string]any{
-					"error": err,
-				})
-				return false
-			}
-
-			r.Body = io.NopCloser(&b)
-			body := b.String()
-			// If body matches identically, we are done.
-			if body == i.Body {
-				return true
-			}
-
-			// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
-			switch contentType := r.Header.Get("Content-Type"); contentType {
-			case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
-				// JSON might be the same, but reordered. Try parsing and comparing.
-				var requestJson, cassetteJson any
-
-				if err := json.Unmarshal([]byte(body), &requestJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request JSON", map[string]any{
-						"error": err,
-					})
-					return false
-				}
-
-				if err := json.Unmarshal([]byte(i.Body), &cassetteJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette JSON", map[string]any{
-						"error": err,
-					})
-					return false
-				}
-
-				return reflect.DeepEqual(requestJson, cassetteJson)
-
-			case "application/xml":
-				// XML might be the same, but reordered. Try parsing and comparing.
-				var requestXml, cassetteXml any
-
-				if err := xml.Unmarshal([]byte(body), &requestXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]any{
-						"error": err,
-					})
-					return false
-				}
-
-				if err := xml.Unmarshal([]byte(i.Body), &cassetteXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]any{
-						"error": err,
-					})
-					return false
-				}
-
-				return reflect.DeepEqual(requestXml, cassetteXml)
-			}
-
-			return false
-		})
-
 		// Use the wrapped HTTP Client for AWS APIs.
 		// As the HTTP client is used in the provider's ConfigureContextFunc
 		// we must do this setup before calling the ConfigureContextFunc.
-		httpClient.Transport = r
+		httpClient := &http.Client{
+			Transport: &vcrTransport{recorder: r},
+		}
 		if v, ok := provider.Meta().(*conns.AWSClient); ok {
 			meta = v
 		} else {
@@ -273,26 +274,105 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 			meta = v.(*conns.AWSClient)
 		}
 
-		// Don't retry requests if a recorded interaction isn't found.
-		// TODO Need to loop through all API clients to do this.
-		// TODO Use []*client.Client?
-		// TODO AWS SDK for Go v2 API clients.
-		// meta.LogsConn(ctx).Handlers.AfterRetry.PushFront(func(r *request.Request) {
-		// 	// We have to use 'Contains' rather than 'errors.Is' because 'awserr.Error' doesn't implement 'Unwrap'.
-		// 	if errs.Contains(r.Error, cassette.ErrInteractionNotFound.Error()) {
-		// 		r.Retryable = aws.Bool(false)
-		// 	}
-		// })
-
-		providerMetas[testName] = meta
+		providerMetas[key] = meta
 
 		return meta, diags
 	}
 }
 
-// vcrRandomnessSource returns a rand.Source for VCR testing.
-// In RECORDING mode, generates a new seed and saves it to a file, using the seed for the source.
-// In REPLAYING mode, reads a seed from a file and creates a source from it.
+// vcrRecorder returns the VCR recorder for the specified test, creating it if necessary.
+// All of a test's provider instances share a single recorder and cassette.
+func vcrRecorder(ctx context.Context, testName string) (*recorder.Recorder, error) {
+	recorders.Lock()
+	r, ok := recorders[testName]
+	defer recorders.Unlock()
+
+	if ok {
+		return r, nil
+	}
+
+	vcrMode, err := vcrMode()
+
+	if err != nil {
+		return nil, err
+	}
+
+	r, err = recorder.NewWithOptions(&recorder.Options{
+		CassetteName:       vcrCassetteName(testName),
+		Mode:               vcrMode,
+		RealTransport:      vcrRealTransport(),
+		SkipRequestLatency: true,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Remove account IDs and credentials from recorded interactions.
+	r.AddHook(newVCRScrubber(func() string {
+		return AccountID(ctx)
+	}).scrub, recorder.BeforeSaveHook)
+
+	// Defines how VCR will match requests to responses.
+	r.SetMatcher(vcrRequestMatcher(ctx))
+
+	recorders[testName] = r
+
+	return r, nil
+}
+
+// vcrRealTransport returns the HTTP transport used to make AWS API calls in RECORDING mode.
+func vcrRealTransport() http.RoundTripper {
+	// Cribbed from aws-sdk-go-base.
+	httpClient := cleanhttp.DefaultPooledClient()
+	transport := httpClient.Transport.(*http.Transport)
+	transport.MaxIdleConnsPerHost = 10
+	tlsConfig := transport.TLSClientConfig
+	if tlsConfig == nil {
+		tlsConfig = &tls.Config{}
+		transport.TLSClientConfig = tlsConfig
+	}
+	tlsConfig.MinVersion = tls.VersionTLS12
+
+	return transport
+}
+
+// vcrTransport is an http.RoundTripper that serves requests from a VCR recorder.
+type vcrTransport struct {
+	recorder *recorder.Recorder
+}
+
+func (t *vcrTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	resp, err := t.recorder.RoundTrip(r)
+
+	if errors.Is(err, cassette.ErrInteractionNotFound) {
+		return nil, &vcrInteractionNotFoundError{err: err}
+	}
+
+	return resp, err
+}
+
+// vcrInteractionNotFoundError is returned when no recorded interaction matches a request.
+type vcrInteractionNotFoundError struct {
+	err error
+}
+
+func (e *vcrInteractionNotFoundError) Error() string {
+	return e.err.Error()
+}
+
+func (e *vcrInteractionNotFoundError) Unwrap() error {
+	return e.err
+}
+
+// CanceledError prevents the AWS SDK for Go v2 from retrying requests if a recorded interaction isn't found.
+func (e *vcrInteractionNotFoundError) CanceledError() bool {
+	return true
+}
+
+// vcrRandomnessSource returns the test's randomness source for VCR testing.
+// In RECORDING mode, a new random seed is generated and is saved next to the cassette when the recorder is closed.
+// In REPLAYING mode, the seed saved when the test was recorded is used, so the same values are generated.
 func vcrRandomnessSource(t *testing.T) (*randomnessSource, error) {
 	t.Helper()
 
@@ -312,28 +392,22 @@ func vcrRandomnessSource(t *testing.T) (*randomnessSource, error) {
 		return nil, err
 	}
 
+	var seed int64
 	switch vcrMode {
 	case recorder.ModeRecordOnce:
-		seed := rand.Int63()
-		s = &randomnessSource{
-			seed:   seed,
-			source: rand.NewSource(seed),
-		}
+		seed = rand.Int63()
 	case recorder.ModeReplayOnly:
-		seed, err := readSeedFromFile(vcrSeedFile(os.Getenv(envVarVCRPath), testName))
+		seed, err = readSeedFromFile(vcrSeedFile(testName))
 
 		if err != nil {
-			return nil, fmt.Errorf("no cassette found on disk for %s, please replay this testcase in recording mode - %w", testName, err)
+			return nil, fmt.Errorf("reading randomness seed for %s, record the test again: %w", testName, err)
 		}
-
-		s = &randomnessSource{
-			seed:   seed,
-			source: rand.NewSource(seed),
-		}
-	default:
-		t.FailNow()
 	}
 
+	s = &randomnessSource{
+		seed:   seed,
+		source: rand.NewSource(seed),
+	}
 	randomnessSources[testName] = s
 
 	return s, nil
@@ -343,47 +417,31 @@ func vcrFileName(name string) string {
 	return strings.ReplaceAll(name, "/", "_")
 }
 
-func vcrSeedFile(path, name string) string {
-	return filepath.Join(path, fmt.Sprintf("%s.seed", vcrFileName(name)))
+func vcrCassetteName(name string) string {
+	return filepath.Join(os.Getenv(envVarVCRPath), vcrFileName(name))
 }
 
-func readSeedFromFile(fileName string) (int64, error) {
-	// Max number of digits for int64 is 19.
-	data := make([]byte, 19)
-	f, err := os.Open(fileName)
-
-	if err != nil {
-		return 0, err
-	}
-
-	defer f.Close()
+// vcrSeedFile returns the name of the file that the test's randomness seed is saved in, next to its cassette.
+func vcrSeedFile(name string) string {
+	return vcrCassetteName(name) + ".seed"
+}
 
-	_, err = f.Read(data)
+func readSeedFromFile(fileName string) (int64, error) {
+	b, err := os.ReadFile(fileName)
 
 	if err != nil {
 		return 0, err
 	}
 
-	// Remove NULL characters from seed.
-	return strconv.ParseInt(string(bytes.Trim(data, "\x00")), 10, 64)
+	return strconv.ParseInt(strings.TrimSpace(string(b)), 10, 64)
 }
 
 func writeSeedToFile(seed int64, fileName string) error {
-	f, err := os.Create(fileName)
-
-	if err != nil {
-		return err
-	}
-
-	defer f.Close()
-
-	_, err = f.WriteString(strconv.FormatInt(seed, 10))
-
-	return err
+	return os.WriteFile(fileName, []byte(strconv.FormatInt(seed, 10)), 0644)
 }
 
 // closeVCRRecorder closes the VCR recorder, saving the cassette and randomness seed.
-func closeVCRRecorder(ctx context.Context, t *testing.T) {
+func closeVCRRecorder(_ context.Context, t *testing.T) {
 	t.Helper()
 
 	// Don't close the recorder if we're running because of a panic.
@@ -392,32 +450,26 @@ func closeVCRRecorder(ctx context.Context, t *testing.T) {
 	}
 
 	testName := t.Name()
-	providerMetas.Lock()
-	meta, ok := providerMetas[testName]
-	defer providerMetas.Unlock()
 
-	if ok {
-		if !t.Failed() {
-			if v, ok := meta.HTTPClient(ctx).Transport.(*recorder.Recorder); ok {
-				t.Log("stopping VCR recorder")
-				if err := v.Stop(); err != nil {
-					t.Error(err)
-				}
-			}
-		}
+	stopVCRRecorder(t, testName)
 
-		delete(providerMetas, testName)
+	providerMetas.Lock()
+	for key := range providerMetas {
+		if key.testName == testName {
+			delete(providerMetas, key)
+		}
 	}
+	providerMetas.Unlock()
 
-	// Save the randomness seed.
+	// Save the randomness seed with the cassette.
 	randomnessSources.Lock()
 	s, ok := randomnessSources[testName]
 	defer randomnessSources.Unlock()
 
 	if ok {
-		if !t.Failed() {
+		if mode, err := vcrMode(); err == nil && mode == recorder.ModeRecordOnce && !t.Failed() {
 			t.Log("persisting randomness seed")
-			if err := writeSeedToFile(s.seed, vcrSeedFile(os.Getenv(envVarVCRPath), t.Name())); err != nil {
+			if err := writeSeedToFile(s.seed, vcrSeedFile(testName)); err != nil {
 				t.Error(err)
 			}
 		}
@@ -426,12 +478,34 @@ func closeVCRRecorder(ctx context.Context, t *testing.T) {
 	}
 }
 
+// stopVCRRecorder stops the test's VCR recorder, saving the cassette if the test succeeded.
+func stopVCRRecorder(t *testing.T, testName string) {
+	t.Helper()
+
+	recorders.Lock()
+	r, ok := recorders[testName]
+	defer recorders.Unlock()
+
+	if !ok {
+		return
+	}
+
+	if !t.Failed() {
+		t.Log("stopping VCR recorder")
+		if err := r.Stop(); err != nil {
+			t.Error(err)
+		}
+	}
+
+	delete(recorders, testName)
+}
+
 // ParallelTest wraps resource.ParallelTest, initializing VCR if enabled.
 func ParallelTest(ctx context.Context, t *testing.T, c resource.TestCase) {
 	t.Helper()
 
 	if isVCREnabled() {
-		c.ProtoV5ProviderFactories = vcrEnabledProtoV5ProviderFactories(ctx, t, c.ProtoV5ProviderFactories)
+		c = vcrTestCase(ctx, t, c)
 		defer closeVCRRecorder(ctx, t)
 	}
 
@@ -443,7 +517,7 @@ func Test(ctx context.Context, t *testing.T, c resource.TestCase) {
 	t.Helper()
 
 	if isVCREnabled() {
-		c.ProtoV5ProviderFactories = vcrEnabledProtoV5ProviderFactories(ctx, t, c.ProtoV5ProviderFactories)
+		c = vcrTestCase(ctx, t, c)
 		defer closeVCRRecorder(ctx, t)
 	}
 
@@ -460,6 +534,10 @@ func RandInt(t *testing.T) int {
 
 	s, err := vcrRandomnessSource(t)
 
+	if isVCRReplaying() && errors.Is(err, fs.ErrNotExist) {
+		t.Skipf("skipping test in %s mode: %s", os.Getenv(envVarVCRMode), err)
+	}
+
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -0,0 +1,339 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+var (
+	// vcrIgnoredHeaders are HTTP request headers whose values differ between otherwise identical requests.
+	// This includes AWS Signature Version 4 headers and AWS SDK invocation and retry metadata.
+	vcrIgnoredHeaders = []string{
+		"Amz-Sdk-Invocation-Id",
+		"Amz-Sdk-Request",
+		"Authorization",
+		"Content-Length",
+		"User-Agent",
+		"X-Amz-Content-Sha256",
+		"X-Amz-Date",
+		"X-Amz-Security-Token",
+		"X-Amz-User-Agent",
+	}
+
+	// vcrIdempotencyTokenNames are the names of request parameters containing generated idempotency tokens.
+	// Names are compared case-insensitively.
+	vcrIdempotencyTokenNames = []string{
+		"CallerReference",
+		"ClientRequestToken",
+		"ClientToken",
+		"IdempotencyToken",
+	}
+)
+
+// vcrRequestMatcher returns a VCR matcher that matches requests to recorded interactions.
+// Method, URL and AWS headers must match.
+// Query strings and JSON, XML and form-encoded bodies are compared structurally,
+// ignoring parameter and list element ordering and idempotency tokens.
+func vcrRequestMatcher(ctx context.Context) cassette.MatcherFunc {
+	return func(r *http.Request, i cassette.Request) bool {
+		if r.Method != i.Method {
+			return false
+		}
+
+		if !vcrURLsMatch(r.URL, i.URL) {
+			return false
+		}
+
+		if !vcrHeadersMatch(r.Header, i.Headers) {
+			return false
+		}
+
+		if r.Body == nil || r.Body == http.NoBody {
+			return i.Body == ""
+		}
+
+		var b bytes.Buffer
+		if _, err := b.ReadFrom(r.Body); err != nil {
+			tflog.Debug(ctx, "Failed to read request body", map[string]any{
+				"error": err,
+			})
+			return false
+		}
+		r.Body = io.NopCloser(&b)
+
+		return vcrBodiesMatch(ctx, r.Header.Get("Content-Type"), b.String(), i.Body)
+	}
+}
+
+func vcrURLsMatch(u *url.URL, s string) bool {
+	v, err := url.Parse(s)
+	if err != nil {
+		return false
+	}
+
+	if u.Scheme != v.Scheme || u.Host != v.Host || u.EscapedPath() != v.EscapedPath() {
+		return false
+	}
+
+	return reflect.DeepEqual(vcrNormalizeValues(u.Query()), vcrNormalizeValues(v.Query()))
+}
+
+func vcrHeadersMatch(a, b http.Header) bool {
+	normalize := func(h http.Header) map[string][]string {
+		m := make(map[string][]string)
+		for k, v := range h {
+			k = http.CanonicalHeaderKey(k)
+			if !strings.HasPrefix(k, "X-Amz-") || slices.Contains(vcrIgnoredHeaders, k) {
+				continue
+			}
+			m[k] = v
+		}
+		return m
+	}
+
+	return reflect.DeepEqual(normalize(a), normalize(b))
+}
+
+func vcrBodiesMatch(ctx context.Context, contentType, a, b string) bool {
+	if a == b {
+		return true
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	var x, y any
+	switch {
+	case mediaType == "application/json" || strings.HasPrefix(mediaType, "application/x-amz-json-"):
+		x, err = vcrNormalizeJSON(a)
+		if err == nil {
+			y, err = vcrNormalizeJSON(b)
+		}
+	case mediaType == "application/x-www-form-urlencoded":
+		x, err = vcrNormalizeForm(a)
+		if err == nil {
+			y, err = vcrNormalizeForm(b)
+		}
+	case mediaType == "application/xml" || mediaType == "text/xml":
+		x, err = vcrNormalizeXML(a)
+		if err == nil {
+			y, err = vcrNormalizeXML(b)
+		}
+	default:
+		return false
+	}
+
+	if err != nil {
+		tflog.Debug(ctx, "Failed to normalize request body", map[string]any{
+			"content_type": mediaType,
+			"error":        err,
+		})
+		return false
+	}
+
+	return reflect.DeepEqual(x, y)
+}
+
+func vcrIsIdempotencyToken(name string) bool {
+	return slices.ContainsFunc(vcrIdempotencyTokenNames, func(v string) bool {
+		return strings.EqualFold(v, name)
+	})
+}
+
+// vcrNormalizeValues removes idempotency tokens from query string or form values and sorts repeated values.
+func vcrNormalizeValues(values url.Values) url.Values {
+	v := make(url.Values, len(values))
+
+	for k, s := range values {
+		if vcrIsIdempotencyToken(k) {
+			continue
+		}
+		s = slices.Clone(s)
+		slices.Sort(s)
+		v[k] = s
+	}
+
+	return v
+}
+
+// vcrNormalizeJSON decodes a JSON document, removing idempotency tokens and sorting arrays.
+func vcrNormalizeJSON(s string) (any, error) {
+	var v any
+
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		return nil, err
+	}
+
+	return vcrNormalizeTree(v), nil
+}
+
+// vcrNormalizeForm decodes an AWS Query protocol request body.
+// Indexed parameters (e.g. "Tags.member.1.Key") are decoded into lists so that element ordering can be ignored.
+func vcrNormalizeForm(s string) (any, error) {
+	values, err := url.ParseQuery(s)
+	if err != nil {
+		return nil, err
+	}
+
+	tree := make(map[string]any)
+
+	for k, v := range vcrNormalizeValues(values) {
+		node := tree
+		parts := strings.Split(k, ".")
+		for _, part := range parts[:len(parts)-1] {
+			child, ok := node[part].(map[string]any)
+			if !ok {
+				child = make(map[string]any)
+				node[part] = child
+			}
+			node = child
+		}
+		node[parts[len(parts)-1]] = strings.Join(v, ",")
+	}
+
+	return vcrNormalizeTree(vcrFormLists(tree)), nil
+}
+
+// vcrFormLists converts maps whose keys are all list indices into lists.
+func vcrFormLists(v any) any {
+	m, ok := v.(map[string]any)
+	if !ok {
+		return v
+	}
+
+	isList := len(m) > 0
+	for k, v := range m {
+		m[k] = vcrFormLists(v)
+		if _, err := strconv.Atoi(k); err != nil {
+			isList = false
+		}
+	}
+
+	if !isList {
+		return m
+	}
+
+	l := make([]any, 0, len(m))
+	for _, v := range m {
+		l = append(l, v)
+	}
+
+	return l
+}
+
+type vcrXMLElement struct {
+	Name     string
+	Attrs    map[string]string
+	Text     string
+	Children []any
+}
+
+// vcrNormalizeXML decodes an XML document, ignoring whitespace, idempotency tokens and child element ordering.
+func vcrNormalizeXML(s string) (any, error) {
+	decoder := xml.NewDecoder(strings.NewReader(s))
+	root := &vcrXMLElement{}
+	stack := []*vcrXMLElement{root}
+
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		parent := stack[len(stack)-1]
+		switch token := token.(type) {
+		case xml.StartElement:
+			e := &vcrXMLElement{
+				Name:  token.Name.Local,
+				Attrs: make(map[string]string),
+			}
+			for _, v := range token.Attr {
+				e.Attrs[v.Name.Local] = v.Value
+			}
+			stack = append(stack, e)
+			if !vcrIsIdempotencyToken(e.Name) {
+				parent.Children = append(parent.Children, e)
+			}
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			parent.Text += strings.TrimSpace(string(token))
+		}
+	}
+
+	return vcrNormalizeTree(vcrXMLTree(root)), nil
+}
+
+func vcrXMLTree(e *vcrXMLElement) any {
+	children := make([]any, 0, len(e.Children))
+	for _, v := range e.Children {
+		children = append(children, vcrXMLTree(v.(*vcrXMLElement)))
+	}
+
+	return map[string]any{
+		"name":     e.Name,
+		"attrs":    e.Attrs,
+		"text":     e.Text,
+		"children": children,
+	}
+}
+
+// vcrNormalizeTree removes idempotency tokens from objects and sorts arrays by their JSON representation.
+func vcrNormalizeTree(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		m := make(map[string]any, len(v))
+		for k, v := range v {
+			if vcrIsIdempotencyToken(k) {
+				continue
+			}
+			m[k] = vcrNormalizeTree(v)
+		}
+		return m
+	case map[string]string:
+		return v
+	case []any:
+		type element struct {
+			key   string
+			value any
+		}
+		elements := make([]element, len(v))
+		for i, v := range v {
+			v = vcrNormalizeTree(v)
+			b, _ := json.Marshal(v)
+			elements[i] = element{key: string(b), value: v}
+		}
+		slices.SortFunc(elements, func(a, b element) int {
+			return strings.Compare(a.key, b.key)
+		})
+		l := make([]any, len(elements))
+		for i, e := range elements {
+			l[i] = e.value
+		}
+		return l
+	default:
+		return v
+	}
+}
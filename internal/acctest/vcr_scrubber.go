@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+const (
+	// vcrAccountID is the account ID that replaces the primary AWS account ID in recorded interactions.
+	vcrAccountID = "123456789012"
+
+	vcrRedacted = "REDACTED"
+)
+
+var (
+	// vcrSensitiveHeaders are HTTP request headers that are removed from recorded interactions.
+	vcrSensitiveHeaders = []string{
+		"Authorization",
+		"X-Amz-Security-Token",
+	}
+
+	// vcrAccountIDRegexp matches account IDs in ARNs.
+	vcrAccountIDRegexp = regexp.MustCompile(`arn:[\w-]+:[\w-]*:[\w-]*:(\d{12}):`)
+
+	// vcrCredentialsRegexps match credential values in XML and JSON response bodies.
+	vcrCredentialsRegexps = []*regexp.Regexp{
+		regexp.MustCompile(`(<(?:AccessKeyId|SecretAccessKey|SessionToken)>)[^<]*(</)`),
+		regexp.MustCompile(`(?i)("(?:accessKeyId|secretAccessKey|sessionToken)"\s*:\s*")[^"]*(")`),
+	}
+)
+
+// vcrScrubber removes account IDs and credentials from recorded interactions before they are saved.
+// The primary account ID is replaced by vcrAccountID and any other account IDs found in ARNs
+// are replaced by consecutive placeholder account IDs in the order in which they are first seen.
+type vcrScrubber struct {
+	accountIDs       map[string]string
+	lock             sync.Mutex
+	otherAccounts    int
+	primaryAccountID func() string
+}
+
+func newVCRScrubber(primaryAccountID func() string) *vcrScrubber {
+	return &vcrScrubber{
+		primaryAccountID: primaryAccountID,
+	}
+}
+
+// scrub is a go-vcr recorder hook.
+func (s *vcrScrubber) scrub(i *cassette.Interaction) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.accountIDs == nil {
+		s.accountIDs = make(map[string]string)
+		if v := s.primaryAccountID(); v != "" {
+			s.accountIDs[v] = vcrAccountID
+		}
+	}
+
+	for _, k := range vcrSensitiveHeaders {
+		delete(i.Request.Headers, k)
+	}
+
+	for _, v := range []string{i.Request.URL, i.Request.Body, i.Response.Body} {
+		for _, match := range vcrAccountIDRegexp.FindAllStringSubmatch(v, -1) {
+			s.placeholderAccountID(match[1])
+		}
+	}
+
+	replacer := s.replacer()
+
+	i.Request.URL = replacer.Replace(i.Request.URL)
+	i.Request.Host = replacer.Replace(i.Request.Host)
+	i.Request.Body = s.scrubCredentials(replacer.Replace(i.Request.Body))
+	scrubHeaders(i.Request.Form, replacer)
+	scrubHeaders(i.Request.Headers, replacer)
+	i.Response.Body = s.scrubCredentials(replacer.Replace(i.Response.Body))
+	scrubHeaders(i.Response.Headers, replacer)
+
+	return nil
+}
+
+func (s *vcrScrubber) placeholderAccountID(accountID string) string {
+	if v, ok := s.accountIDs[accountID]; ok {
+		return v
+	}
+
+	// Placeholders for other accounts follow the primary account's placeholder.
+	s.otherAccounts++
+	n, _ := strconv.Atoi(vcrAccountID)
+	v := strconv.Itoa(n + s.otherAccounts)
+	s.accountIDs[accountID] = v
+
+	return v
+}
+
+func (s *vcrScrubber) replacer() *strings.Replacer {
+	var oldnew []string
+
+	for k, v := range s.accountIDs {
+		if k != v {
+			oldnew = append(oldnew, k, v)
+		}
+	}
+
+	return strings.NewReplacer(oldnew...)
+}
+
+func (s *vcrScrubber) scrubCredentials(body string) string {
+	for _, re := range vcrCredentialsRegexps {
+		body = re.ReplaceAllString(body, "${1}"+vcrRedacted+"${2}")
+	}
+
+	return body
+}
+
+// scrubHeaders replaces account IDs in HTTP header or form values.
+func scrubHeaders(h map[string][]string, replacer *strings.Replacer) {
+	for _, v := range h {
+		for i := range v {
+			v[i] = replacer.Replace(v[i])
+		}
+	}
+}
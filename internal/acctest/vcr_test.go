@@ -4,9 +4,18 @@
 package acctest_test
 
 import (
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
 )
 
 func TestRandInt(t *testing.T) {
@@ -52,3 +61,94 @@ func TestRandomWithPrefix(t *testing.T) {
 		t.Errorf("REPLAYING: %s, RECORDING: %s", rep2, rec2)
 	}
 }
+
+func TestRandInt_seedSavedWithCassette(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	dir := t.TempDir()
+	t.Setenv("VCR_PATH", dir)
+
+	t.Setenv("VCR_MODE", "REPLAYING")
+	t.Run("no seed", func(t *testing.T) {
+		acctest.RandInt(t)
+		t.Error("expected test to be skipped")
+	})
+
+	t.Setenv("VCR_MODE", "RECORDING")
+	rec := acctest.RandInt(t)
+	acctest.CloseVCRRecorder(ctx, t)
+
+	b, err := os.ReadFile(filepath.Join(dir, t.Name()+".seed"))
+	if err != nil {
+		t.Fatalf("reading seed file: %s", err)
+	}
+	if _, err := strconv.ParseInt(string(b), 10, 64); err != nil {
+		t.Errorf("parsing seed %q: %s", string(b), err)
+	}
+
+	t.Setenv("VCR_MODE", "REPLAYING")
+	rep := acctest.RandInt(t)
+	acctest.CloseVCRRecorder(ctx, t)
+
+	if rep != rec {
+		t.Errorf("REPLAYING: %d, RECORDING: %d", rep, rec)
+	}
+}
+
+// TestVCRReplay replays an STS GetCallerIdentity call from the cassette in testdata/vcr.
+func TestVCRReplay(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	t.Setenv("VCR_PATH", "testdata/vcr")
+	t.Setenv("VCR_MODE", "REPLAYING")
+
+	r, err := acctest.VCRRecorder(ctx, t.Name())
+	if err != nil {
+		t.Fatalf("creating recorder: %s", err)
+	}
+	t.Cleanup(func() {
+		acctest.CloseVCRRecorder(ctx, t)
+	})
+
+	var requests int
+	transport := acctest.NewVCRTransport(r)
+	client := sts.NewFromConfig(aws.Config{
+		Region:      "us-west-2", //lintignore:AWSAT003
+		Credentials: credentials.NewStaticCredentialsProvider("vcr", "vcr", ""),
+		HTTPClient: &http.Client{
+			Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+				requests++
+				return transport.RoundTrip(r)
+			}),
+		},
+	})
+
+	output, err := client.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		t.Fatalf("GetCallerIdentity: %s", err)
+	}
+
+	if got, want := aws.ToString(output.Account), "123456789012"; got != want {
+		t.Errorf("Account = %q, want %q", got, want)
+	}
+	if got, want := aws.ToString(output.Arn), "arn:aws:iam::123456789012:user/vcr"; got != want { //lintignore:AWSAT005
+		t.Errorf("Arn = %q, want %q", got, want)
+	}
+
+	// The cassette's only interaction has been replayed.
+	// The unmatched request fails without being retried.
+	requests = 0
+	_, err = client.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if !errors.Is(err, cassette.ErrInteractionNotFound) {
+		t.Errorf("unmatched request: got %v, want %v", err, cassette.ErrInteractionNotFound)
+	}
+	if got, want := requests, 1; got != want {
+		t.Errorf("unmatched request attempts = %d, want %d", got, want)
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
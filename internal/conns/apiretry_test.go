@@ -4,16 +4,22 @@
 package conns
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/retry"
 	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/credentials"
 	appconfigtypes "github.com/aws/aws-sdk-go-v2/service/appconfig/types"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	logstypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
 	smithy "github.com/aws/smithy-go"
 	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fakeaws"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 )
 
@@ -93,3 +99,74 @@ func TestAddIsErrorRetryables(t *testing.T) {
 		})
 	}
 }
+
+func TestAddIsErrorRetryables_client(t *testing.T) {
+	t.Parallel()
+
+	f := func(err error) aws.Ternary {
+		if errs.IsAErrorMessageContains[*logstypes.OperationAbortedException](err, "try again") {
+			return aws.TrueTernary
+		}
+		return aws.UnknownTernary
+	}
+	operationAborted := fakeaws.Error(http.StatusBadRequest, "OperationAbortedException", "A conflicting operation is currently in progress against this resource. Please try again.")
+	testCases := []struct {
+		name          string
+		handler       fakeaws.Handler
+		expectedCalls int
+		expectError   bool
+	}{
+		{
+			name:          "retryable",
+			handler:       fakeaws.Sequence(operationAborted, operationAborted, fakeaws.EmptyResponse()),
+			expectedCalls: 3,
+		},
+		{
+			name:          "non-retryable",
+			handler:       fakeaws.Error(http.StatusBadRequest, "InvalidParameterException", "1 validation error detected"),
+			expectedCalls: 1,
+			expectError:   true,
+		},
+		{
+			name:          "throttled",
+			handler:       fakeaws.Throttled(2, fakeaws.EmptyResponse()),
+			expectedCalls: 3,
+		},
+		{
+			name:          "max attempts",
+			handler:       operationAborted,
+			expectedCalls: retry.DefaultMaxAttempts,
+			expectError:   true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			s := fakeaws.New()
+			s.On("CloudWatch Logs", "DeleteLogGroup", testCase.handler)
+
+			conn := cloudwatchlogs.New(cloudwatchlogs.Options{
+				Credentials: credentials.NewStaticCredentialsProvider("AKIAFAKEAWS", "secret", ""),
+				HTTPClient:  s.HTTPClient(),
+				Region:      fakeaws.Region,
+				Retryer: AddIsErrorRetryables(retry.NewStandard(func(o *retry.StandardOptions) {
+					o.Backoff = retry.BackoffDelayerFunc(func(int, error) (time.Duration, error) {
+						return 0, nil
+					})
+				}), retry.IsErrorRetryableFunc(f)),
+			})
+
+			_, err := conn.DeleteLogGroup(ctx, &cloudwatchlogs.DeleteLogGroupInput{LogGroupName: aws.String("test")})
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("DeleteLogGroup error = %v, expected error: %t", err, want)
+			}
+			if got, want := len(s.Calls()), testCase.expectedCalls; got != want {
+				t.Errorf("calls = %d, want %d", got, want)
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"maps"
 	"net/http"
 	"os"
+	"slices"
 	"strings"
 	"sync"
 
@@ -18,6 +19,7 @@ import (
 	apigatewayv2_types "github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/smithy-go/middleware"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 	baselogging "github.com/hashicorp/aws-sdk-go-base/v2/logging"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -28,23 +30,29 @@ import (
 )
 
 type AWSClient struct {
-	accountID                 string
-	awsConfig                 *aws.Config
-	clients                   map[string]any
-	defaultTagsConfig         *tftags.DefaultConfig
-	endpoints                 map[string]string // From provider configuration.
-	httpClient                *http.Client
-	ignoreTagsConfig          *tftags.IgnoreConfig
-	lock                      sync.Mutex
-	logger                    baselogging.Logger
-	partition                 endpoints.Partition
-	region                    string
-	servicePackages           map[string]ServicePackage
-	session                   *session_sdkv1.Session
-	s3ExpressClient           *s3.Client
-	s3UsePathStyle            bool   // From provider configuration.
-	s3USEast1RegionalEndpoint string // From provider configuration.
-	stsRegion                 string // From provider configuration.
+	accountID                  string
+	apiLimiters                map[string]*apiLimiter // From provider configuration.
+	apiTelemetry               *apiTelemetry          // From provider configuration.
+	awsConfig                  *aws.Config
+	clients                    map[string]any
+	defaultTagsConfig          *tftags.DefaultConfig
+	driftDiagnostics           bool              // From provider configuration.
+	driftDiagnosticsCloudTrail bool              // From provider configuration.
+	endpoints                  map[string]string // From provider configuration.
+	httpClient                 *http.Client
+	ignoreTagsConfig           *tftags.IgnoreConfig
+	lock                       sync.Mutex
+	logger                     baselogging.Logger
+	partition                  endpoints.Partition
+	region                     string
+	servicePackages            map[string]ServicePackage
+	session                    *session_sdkv1.Session
+	s3ExpressClients           map[string]*s3.Client
+	s3UsePathStyle             bool                 // From provider configuration.
+	s3USEast1RegionalEndpoint  string               // From provider configuration.
+	stsRegion                  string               // From provider configuration.
+	tagBatcher                 *TagBatcher          // From provider configuration.
+	tagPolicyConfig            *tftags.PolicyConfig // From provider configuration.
 }
 
 func (c *AWSClient) SetServicePackages(_ context.Context, servicePackages map[string]ServicePackage) {
@@ -79,6 +87,17 @@ func (c *AWSClient) IgnoreTagsConfig(context.Context) *tftags.IgnoreConfig {
 	return c.ignoreTagsConfig
 }
 
+// TagBatcher returns the batcher used to update resource tags using the Resource Groups Tagging API.
+// Returns nil if batch tagging is not enabled.
+func (c *AWSClient) TagBatcher(context.Context) *TagBatcher {
+	return c.tagBatcher
+}
+
+// TagPolicyConfig returns the tag policy enforced on resource tags at plan time.
+func (c *AWSClient) TagPolicyConfig(context.Context) *tftags.PolicyConfig {
+	return c.tagPolicyConfig
+}
+
 func (c *AWSClient) AwsConfig(context.Context) aws.Config { // nosemgrep:ci.aws-in-func-name
 	return c.awsConfig.Copy()
 }
@@ -103,7 +122,14 @@ func (c *AWSClient) Partition(context.Context) string {
 }
 
 // Region returns the ID of the configured AWS Region.
-func (c *AWSClient) Region(context.Context) string {
+// Any per-resource Region override in Context takes precedence over the provider-configured Region.
+func (c *AWSClient) Region(ctx context.Context) string {
+	if inContext, ok := FromContext(ctx); ok {
+		if v := inContext.OverrideRegion(); v != "" {
+			return v
+		}
+	}
+
 	return c.region
 }
 
@@ -171,18 +197,24 @@ func (c *AWSClient) S3ExpressClient(ctx context.Context) *s3.Client {
 	c.lock.Lock() // OK since a non-default client is created.
 	defer c.lock.Unlock()
 
-	if c.s3ExpressClient == nil {
+	region := c.Region(ctx)
+	if c.s3ExpressClients == nil {
+		c.s3ExpressClients = make(map[string]*s3.Client)
+	}
+	s3ExpressClient, ok := c.s3ExpressClients[region]
+	if !ok {
 		if s3Client.Options().Region == endpoints.AwsGlobalRegionID {
 			// No global endpoint for S3 Express.
-			c.s3ExpressClient = errs.Must(client[*s3.Client](ctx, c, names.S3, map[string]any{
+			s3ExpressClient = errs.Must(client[*s3.Client](ctx, c, names.S3, map[string]any{
 				"s3_us_east_1_regional_endpoint": "regional",
 			}))
 		} else {
-			c.s3ExpressClient = s3Client
+			s3ExpressClient = s3Client
 		}
+		c.s3ExpressClients[region] = s3ExpressClient
 	}
 
-	return c.s3ExpressClient
+	return s3ExpressClient
 }
 
 // S3UsePathStyle returns the s3_force_path_style provider configuration value.
@@ -190,6 +222,16 @@ func (c *AWSClient) S3UsePathStyle(context.Context) bool {
 	return c.s3UsePathStyle
 }
 
+// DriftDiagnostics returns whether resource attributes changed outside of Terraform are reported when refreshing.
+func (c *AWSClient) DriftDiagnostics(context.Context) bool {
+	return c.driftDiagnostics
+}
+
+// DriftDiagnosticsCloudTrail returns whether drift diagnostics include CloudTrail events that could have caused the change.
+func (c *AWSClient) DriftDiagnosticsCloudTrail(context.Context) bool {
+	return c.driftDiagnosticsCloudTrail
+}
+
 // SetHTTPClient sets the http.Client used for AWS API calls.
 // To have effect it must be called before the AWS SDK v1 Session is created.
 func (c *AWSClient) SetHTTPClient(_ context.Context, httpClient *http.Client) {
@@ -208,6 +250,16 @@ func (c *AWSClient) RegisterLogger(ctx context.Context) context.Context {
 	return baselogging.RegisterLogger(ctx, c.logger)
 }
 
+// Close is called at provider shutdown.
+// Any API call telemetry file is closed.
+func (c *AWSClient) Close(ctx context.Context) error {
+	if c.apiTelemetry == nil {
+		return nil
+	}
+
+	return c.apiTelemetry.close(ctx)
+}
+
 // APIGatewayInvokeURL returns the Amazon API Gateway (REST APIs) invoke URL for the configured AWS Region.
 // See https://docs.aws.amazon.com/apigateway/latest/developerguide/how-to-call-api.html.
 func (c *AWSClient) APIGatewayInvokeURL(ctx context.Context, restAPIID, stageName string) string {
@@ -267,12 +319,7 @@ func (c *AWSClient) GlobalAcceleratorHostedZoneID(context.Context) string {
 
 // DNSSuffix returns the domain suffix for the configured AWS partition.
 func (c *AWSClient) DNSSuffix(context.Context) string {
-	dnsSuffix := c.partition.DNSSuffix()
-	if dnsSuffix == "" {
-		dnsSuffix = "amazonaws.com"
-	}
-
-	return dnsSuffix
+	return partitionDNSSuffix(c.partition)
 }
 
 // ReverseDNSPrefix returns the reverse DNS prefix for the configured AWS partition.
@@ -282,7 +329,26 @@ func (c *AWSClient) ReverseDNSPrefix(ctx context.Context) string {
 
 // EC2RegionalPrivateDNSSuffix returns the EC2 private DNS suffix for the configured AWS Region.
 func (c *AWSClient) EC2RegionalPrivateDNSSuffix(ctx context.Context) string {
-	region := c.Region(ctx)
+	return EC2RegionalPrivateDNSSuffix(c.Region(ctx))
+}
+
+// EC2RegionalPublicDNSSuffix returns the EC2 public DNS suffix for the configured AWS Region.
+func (c *AWSClient) EC2RegionalPublicDNSSuffix(ctx context.Context) string {
+	return ec2RegionalPublicDNSSuffix(c.Region(ctx))
+}
+
+// EC2PrivateDNSNameForIP returns a EC2 private DNS name in the configured AWS Region.
+func (c *AWSClient) EC2PrivateDNSNameForIP(ctx context.Context, ip string) string {
+	return EC2PrivateDNSNameForIP(c.Region(ctx), ip)
+}
+
+// EC2PublicDNSNameForIP returns a EC2 public DNS name in the configured AWS Region.
+func (c *AWSClient) EC2PublicDNSNameForIP(ctx context.Context, ip string) string {
+	return EC2PublicDNSNameForIP(c.partition, c.Region(ctx), ip)
+}
+
+// EC2RegionalPrivateDNSSuffix returns the EC2 private DNS suffix for the specified AWS Region.
+func EC2RegionalPrivateDNSSuffix(region string) string {
 	if region == endpoints.UsEast1RegionID {
 		return "ec2.internal"
 	}
@@ -290,9 +356,7 @@ func (c *AWSClient) EC2RegionalPrivateDNSSuffix(ctx context.Context) string {
 	return fmt.Sprintf("%s.compute.internal", region)
 }
 
-// EC2RegionalPublicDNSSuffix returns the EC2 public DNS suffix for the configured AWS Region.
-func (c *AWSClient) EC2RegionalPublicDNSSuffix(ctx context.Context) string {
-	region := c.Region(ctx)
+func ec2RegionalPublicDNSSuffix(region string) string {
 	if region == endpoints.UsEast1RegionID {
 		return "compute-1"
 	}
@@ -300,14 +364,24 @@ func (c *AWSClient) EC2RegionalPublicDNSSuffix(ctx context.Context) string {
 	return fmt.Sprintf("%s.compute", region)
 }
 
-// EC2PrivateDNSNameForIP returns a EC2 private DNS name in the configured AWS Region.
-func (c *AWSClient) EC2PrivateDNSNameForIP(ctx context.Context, ip string) string {
-	return fmt.Sprintf("ip-%s.%s", convertIPToDashIP(ip), c.EC2RegionalPrivateDNSSuffix(ctx))
+// EC2PrivateDNSNameForIP returns a EC2 private DNS name in the specified AWS Region.
+// It does not depend on a configured provider, so it can be used by provider-defined functions.
+func EC2PrivateDNSNameForIP(region, ip string) string {
+	return fmt.Sprintf("ip-%s.%s", convertIPToDashIP(ip), EC2RegionalPrivateDNSSuffix(region))
 }
 
-// EC2PublicDNSNameForIP returns a EC2 public DNS name in the configured AWS Region.
-func (c *AWSClient) EC2PublicDNSNameForIP(ctx context.Context, ip string) string {
-	return c.PartitionHostname(ctx, fmt.Sprintf("ec2-%s.%s", convertIPToDashIP(ip), c.EC2RegionalPublicDNSSuffix(ctx)))
+// EC2PublicDNSNameForIP returns a EC2 public DNS name in the specified AWS partition and Region.
+func EC2PublicDNSNameForIP(partition endpoints.Partition, region, ip string) string {
+	return fmt.Sprintf("ec2-%s.%s.%s", convertIPToDashIP(ip), ec2RegionalPublicDNSSuffix(region), partitionDNSSuffix(partition))
+}
+
+func partitionDNSSuffix(partition endpoints.Partition) string {
+	dnsSuffix := partition.DNSSuffix()
+	if dnsSuffix == "" {
+		dnsSuffix = "amazonaws.com"
+	}
+
+	return dnsSuffix
 }
 
 func convertIPToDashIP(ip string) string {
@@ -316,8 +390,30 @@ func convertIPToDashIP(ip string) string {
 
 // apiClientConfig returns the AWS API client configuration parameters for the specified service.
 func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName string) map[string]any {
+	awsConfig := c.awsConfig
+	if region := c.Region(ctx); region != awsConfig.Region {
+		// Per-resource Region override.
+		cfg := awsConfig.Copy()
+		cfg.Region = region
+		awsConfig = &cfg
+	}
+	var apiOptions []func(*middleware.Stack) error
+	if c.apiTelemetry != nil {
+		// API call telemetry.
+		apiOptions = append(apiOptions, c.apiTelemetry.addToStack(servicePackageName))
+	}
+	if l, ok := c.apiLimiters[servicePackageName]; ok {
+		// Per-service API limits.
+		apiOptions = append(apiOptions, l.addToStack)
+	}
+	if len(apiOptions) > 0 {
+		cfg := awsConfig.Copy()
+		cfg.APIOptions = append(slices.Clone(cfg.APIOptions), apiOptions...)
+		awsConfig = &cfg
+	}
+
 	m := map[string]any{
-		"aws_sdkv2_config": c.awsConfig,
+		"aws_sdkv2_config": awsConfig,
 		"endpoint":         c.endpoints[servicePackageName],
 		"partition":        c.Partition(ctx),
 	}
@@ -338,18 +434,24 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 }
 
 // client returns the AWS SDK for Go v2 API client for the specified service.
-// The default service client (`extra` is empty) is cached. In this case the AWSClient lock is held.
+// The default service client (`extra` is empty) for each Region is cached. In this case the AWSClient lock is held.
 // This function is not a method on `AWSClient` as methods can't be parameterized (https://go.googlesource.com/proposal/+/refs/heads/master/design/43651-type-parameters.md#no-parameterized-methods).
 func client[T any](ctx context.Context, c *AWSClient, servicePackageName string, extra map[string]any) (T, error) {
 	ctx = tflog.SetField(ctx, "tf_aws.service_package", servicePackageName)
 
+	region := c.Region(ctx)
+	if region != c.region {
+		ctx = tflog.SetField(ctx, "tf_aws.region", region)
+	}
+
 	isDefault := len(extra) == 0
+	key := clientCacheKey(servicePackageName, region)
 	// Default service client is cached.
 	if isDefault {
 		c.lock.Lock()
 		defer c.lock.Unlock() // Runs at function exit, NOT block.
 
-		if raw, ok := c.clients[servicePackageName]; ok {
+		if raw, ok := c.clients[key]; ok {
 			if client, ok := raw.(T); ok {
 				return client, nil
 			} else {
@@ -373,6 +475,10 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 		return zero, fmt.Errorf("no AWS SDK v2 API client factory: %s", servicePackageName)
 	}
 
+	if _, ok := c.apiLimiters[servicePackageName]; ok {
+		tflog.Debug(ctx, "Limiting AWS API calls")
+	}
+
 	config := c.apiClientConfig(ctx, servicePackageName)
 	maps.Copy(config, extra) // Extras overwrite per-service defaults.
 	client, err := v.NewClient(ctx, config)
@@ -384,8 +490,13 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 	// All customization for AWS SDK for Go v2 API clients must be done during construction.
 
 	if isDefault {
-		c.clients[servicePackageName] = client
+		c.clients[key] = client
 	}
 
 	return client, nil
 }
+
+// clientCacheKey returns the key used to cache the default AWS SDK for Go v2 API client for the specified service and Region.
+func clientCacheKey(servicePackageName, region string) string {
+	return servicePackageName + "/" + region
+}
@@ -99,6 +99,66 @@ func TestAWSClientRegionalHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-n
 	}
 }
 
+func TestAWSClientRegionOverride(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	testCases := []struct {
+		Name           string
+		AWSClient      *AWSClient
+		Context        context.Context
+		ExpectedRegion string
+		ExpectedARN    string
+	}{
+		{
+			Name: "no override",
+			AWSClient: &AWSClient{
+				accountID: "123456789012",
+				partition: standardPartition,
+				region:    "us-west-2", //lintignore:AWSAT003
+			},
+			Context:        NewResourceContext(context.TODO(), "test", "Test", "aws_test", ""),
+			ExpectedRegion: "us-west-2",                                    //lintignore:AWSAT003
+			ExpectedARN:    "arn:aws:test:us-west-2:123456789012:thing/id", //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			Name: "override",
+			AWSClient: &AWSClient{
+				accountID: "123456789012",
+				partition: standardPartition,
+				region:    "us-west-2", //lintignore:AWSAT003
+			},
+			Context:        NewResourceContext(context.TODO(), "test", "Test", "aws_test", "eu-west-1"), //lintignore:AWSAT003
+			ExpectedRegion: "eu-west-1",                                                                 //lintignore:AWSAT003
+			ExpectedARN:    "arn:aws:test:eu-west-1:123456789012:thing/id",                              //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			Name: "no resource context",
+			AWSClient: &AWSClient{
+				accountID: "123456789012",
+				partition: standardPartition,
+				region:    "us-west-2", //lintignore:AWSAT003
+			},
+			Context:        context.TODO(),
+			ExpectedRegion: "us-west-2",                                    //lintignore:AWSAT003
+			ExpectedARN:    "arn:aws:test:us-west-2:123456789012:thing/id", //lintignore:AWSAT003,AWSAT005
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.AWSClient.Region(testCase.Context), testCase.ExpectedRegion; got != want {
+				t.Errorf("Region: got %s, expected %s", got, want)
+			}
+
+			if got, want := testCase.AWSClient.RegionalARN(testCase.Context, "test", "thing/id"), testCase.ExpectedARN; got != want {
+				t.Errorf("RegionalARN: got %s, expected %s", got, want)
+			}
+		})
+	}
+}
+
 func TestAWSClientEC2PrivateDNSNameForIP(t *testing.T) { // nosemgrep:ci.aws-in-func-name
 	t.Parallel()
 
@@ -142,6 +202,48 @@ func TestAWSClientEC2PrivateDNSNameForIP(t *testing.T) { // nosemgrep:ci.aws-in-
 	}
 }
 
+func TestEC2PrivateDNSNameForIP(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	testCases := []struct {
+		Name     string
+		Region   string
+		IP       string
+		Expected string
+	}{
+		{
+			Name:     "us-west-2",
+			Region:   "us-west-2", //lintignore:AWSAT003
+			IP:       "10.20.30.40",
+			Expected: "ip-10-20-30-40.us-west-2.compute.internal", //lintignore:AWSAT003
+		},
+		{
+			Name:     "us-east-1",
+			Region:   "us-east-1", //lintignore:AWSAT003
+			IP:       "10.20.30.40",
+			Expected: "ip-10-20-30-40.ec2.internal",
+		},
+		{
+			Name:     "cn-north-1",
+			Region:   "cn-north-1", //lintignore:AWSAT003
+			IP:       "10.20.30.40",
+			Expected: "ip-10-20-30-40.cn-north-1.compute.internal", //lintignore:AWSAT003
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			got := EC2PrivateDNSNameForIP(testCase.Region, testCase.IP)
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
 func TestAWSClientEC2PublicDNSNameForIP(t *testing.T) { // nosemgrep:ci.aws-in-func-name
 	t.Parallel()
 
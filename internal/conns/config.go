@@ -26,13 +26,29 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/version"
 )
 
+const (
+	// BatchTaggingEnvVar is the environment variable used to enable batch tagging if not set in provider configuration.
+	BatchTaggingEnvVar = "TF_AWS_BATCH_TAGGING"
+
+	// DriftDiagnosticsEnvVar is the environment variable used to enable drift diagnostics if not set in provider configuration.
+	DriftDiagnosticsEnvVar = "TF_AWS_DRIFT_DIAGNOSTICS"
+
+	// DriftDiagnosticsCloudTrailEnvVar is the environment variable used to enable CloudTrail event lookup in drift diagnostics if not set in provider configuration.
+	DriftDiagnosticsCloudTrailEnvVar = "TF_AWS_DRIFT_DIAGNOSTICS_CLOUDTRAIL"
+)
+
 type Config struct {
 	AccessKey                      string
+	APILimits                      map[string]APILimit
+	APITelemetry                   *APITelemetryConfig
 	AllowedAccountIds              []string
 	AssumeRole                     []awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
+	BatchTagging                   bool
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
+	DriftDiagnostics               bool
+	DriftDiagnosticsCloudTrail     bool
 	EC2MetadataServiceEnableState  imds.ClientEnableState
 	EC2MetadataServiceEndpoint     string
 	EC2MetadataServiceEndpointMode string
@@ -42,6 +58,7 @@ type Config struct {
 	HTTPSProxy                     *string
 	IgnoreTagsConfig               *tftags.IgnoreConfig
 	Insecure                       bool
+	LocalEndpoint                  string
 	MaxRetries                     int
 	NoProxy                        string
 	Profile                        string
@@ -57,6 +74,7 @@ type Config struct {
 	SkipRequestingAccountId        bool
 	STSRegion                      string
 	SuppressDebugLog               bool
+	TagPolicyConfig                *tftags.PolicyConfig
 	TerraformVersion               string
 	Token                          string
 	TokenBucketRateLimiterCapacity int
@@ -70,6 +88,13 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 
 	ctx, logger := logging.NewTfLogger(ctx)
 
+	if c.LocalEndpoint != "" {
+		tflog.Info(ctx, "Using local AWS API emulator", map[string]any{
+			"tf_aws.local_endpoint": c.LocalEndpoint,
+		})
+		c.applyLocalEndpoint()
+	}
+
 	const (
 		maxBackoff = 300 * time.Second // AWS SDK for Go v1 DefaultRetryerMaxRetryDelay: https://github.com/aws/aws-sdk-go/blob/9f6e3bb9f523aef97fa1cd5c5f8ba8ecf212e44e/aws/client/default_retryer.go#L48-L49.
 	)
@@ -190,6 +215,10 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		})
 	}
 
+	if c.LocalEndpoint != "" {
+		accountID, partitionID = LocalEndpointAccountID, LocalEndpointPartitionID
+	}
+
 	if accountID == "" && !awsbaseConfig.SkipRequestingAccountId {
 		diags = append(diags, errs.NewWarningDiagnostic(
 			"AWS account ID not found for provider",
@@ -208,7 +237,21 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 
 	client.accountID = accountID
+	client.apiLimiters = make(map[string]*apiLimiter, len(c.APILimits))
+	for servicePackageName, limit := range c.APILimits {
+		client.apiLimiters[servicePackageName] = newAPILimiter(servicePackageName, limit)
+	}
+	if c.APITelemetry != nil && c.APITelemetry.File != "" {
+		client.apiTelemetry = newAPITelemetry(*c.APITelemetry)
+	}
+	if c.BatchTagging {
+		client.tagBatcher = newTagBatcher(func(ctx context.Context) tagResourcesAPIClient {
+			return client.ResourceGroupsTaggingAPIClient(ctx)
+		})
+	}
 	client.defaultTagsConfig = c.DefaultTagsConfig
+	client.driftDiagnostics = c.DriftDiagnostics
+	client.driftDiagnosticsCloudTrail = c.DriftDiagnosticsCloudTrail
 	client.ignoreTagsConfig = c.IgnoreTagsConfig
 	client.region = c.Region
 	client.SetHTTPClient(ctx, session.Config.HTTPClient) // Must be called while client.Session is nil.
@@ -222,6 +265,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
 	client.stsRegion = c.STSRegion
+	client.tagPolicyConfig = c.TagPolicyConfig
 
 	return client, diags
 }
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type proxyCase struct {
@@ -498,3 +499,56 @@ func TestProxyConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestLocalEndpointConfig(t *testing.T) { //nolint:paralleltest // uses t.Setenv
+	const localEndpoint = "http://localhost.localstack.cloud:4566"
+
+	ctx := context.Background()
+
+	t.Setenv(conns.LocalEndpointEnvVar, localEndpoint)
+	t.Setenv("AWS_ENDPOINT_URL_SNS", "http://sns.endpoint.test/")
+
+	config := map[string]any{
+		"endpoints": []any{
+			map[string]any{
+				"sqs": "http://sqs.endpoint.test/",
+			},
+		},
+		"region": "local-region-1",
+	}
+
+	p, err := provider.New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	diags := p.Configure(ctx, terraformsdk.NewResourceConfigRaw(config))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	meta := p.Meta().(*conns.AWSClient)
+
+	endpoints := meta.Endpoints(ctx)
+	for servicePackageName, want := range map[string]string{
+		names.Logs: localEndpoint,
+		names.S3:   localEndpoint,
+		names.SNS:  "", // Resolved by the AWS SDK from the service's environment variable.
+		names.SQS:  "http://sqs.endpoint.test/",
+		names.STS:  localEndpoint,
+	} {
+		if got := endpoints[servicePackageName]; got != want {
+			t.Errorf("endpoint for %s = %q, want %q", servicePackageName, got, want)
+		}
+	}
+
+	if got, want := meta.AccountID(ctx), conns.LocalEndpointAccountID; got != want {
+		t.Errorf("AccountID = %q, want %q", got, want)
+	}
+	if got, want := meta.Partition(ctx), conns.LocalEndpointPartitionID; got != want {
+		t.Errorf("Partition = %q, want %q", got, want)
+	}
+	if !meta.S3UsePathStyle(ctx) {
+		t.Error("S3UsePathStyle = false, want true")
+	}
+}
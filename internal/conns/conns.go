@@ -38,8 +38,11 @@ var (
 type InContext struct {
 	isDataSource        bool   // Data source?
 	isEphemeralResource bool   // Ephemeral resource?
+	overrideRegion      string // Any currently in effect per-resource Region override.
+	resourceID          string // The resource's ID, if known.
 	resourceName        string // Friendly resource name, e.g. "Subnet"
 	servicePackageName  string // Canonical name defined as a constant in names package
+	typeName            string // Terraform type name, e.g. "aws_subnet"
 }
 
 // IsDataSource returns true if the resource is a data source.
@@ -52,6 +55,16 @@ func (c *InContext) IsEphemeralResource() bool {
 	return c.isEphemeralResource
 }
 
+// OverrideRegion returns any currently in effect per-resource Region override.
+func (c *InContext) OverrideRegion() string {
+	return c.overrideRegion
+}
+
+// ResourceID returns the ID of the resource instance, if known.
+func (c *InContext) ResourceID() string {
+	return c.resourceID
+}
+
 // ResourceName returns the friendly resource name, e.g. "Subnet".
 func (c *InContext) ResourceName() string {
 	return c.resourceName
@@ -62,35 +75,60 @@ func (c *InContext) ServicePackageName() string {
 	return c.servicePackageName
 }
 
-func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+// TypeName returns the Terraform type name, e.g. "aws_subnet".
+func (c *InContext) TypeName() string {
+	return c.typeName
+}
+
+func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName, typeName, overrideRegion string) context.Context {
 	v := InContext{
 		isDataSource:       true,
+		overrideRegion:     overrideRegion,
 		resourceName:       resourceName,
 		servicePackageName: servicePackageName,
+		typeName:           typeName,
 	}
 
 	return context.WithValue(ctx, contextKey, &v)
 }
 
-func NewEphemeralResourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+func NewEphemeralResourceContext(ctx context.Context, servicePackageName, resourceName, typeName, overrideRegion string) context.Context {
 	v := InContext{
 		isEphemeralResource: true,
+		overrideRegion:      overrideRegion,
 		resourceName:        resourceName,
 		servicePackageName:  servicePackageName,
+		typeName:            typeName,
 	}
 
 	return context.WithValue(ctx, contextKey, &v)
 }
 
-func NewResourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+func NewResourceContext(ctx context.Context, servicePackageName, resourceName, typeName, overrideRegion string) context.Context {
 	v := InContext{
+		overrideRegion:     overrideRegion,
 		resourceName:       resourceName,
 		servicePackageName: servicePackageName,
+		typeName:           typeName,
 	}
 
 	return context.WithValue(ctx, contextKey, &v)
 }
 
+// WithResourceID returns a copy of Context in which the resource information includes the resource's ID.
+// Context is returned unchanged if it has no resource information.
+func WithResourceID(ctx context.Context, id string) context.Context {
+	v, ok := FromContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	w := *v
+	w.resourceID = id
+
+	return context.WithValue(ctx, contextKey, &w)
+}
+
 func FromContext(ctx context.Context) (*InContext, bool) {
 	v, ok := ctx.Value(contextKey).(*InContext)
 	return v, ok
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"math"
+	"sync/atomic"
+	"time"
+
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
+)
+
+// APILimit represents per-service limits on AWS API calls.
+// A zero value means no limit.
+type APILimit struct {
+	MaxConcurrentRequests int
+	RequestsPerSecond     float64
+}
+
+// apiLimiter limits the rate and concurrency of a service's AWS API calls.
+// Each attempt, including retries, is limited.
+// The limiter is shared by all of the service's API clients, across Regions.
+type apiLimiter struct {
+	rateLimiter        *tfsync.RateLimiter
+	semaphore          *tfsync.Semaphore
+	servicePackageName string
+	waitCount          atomic.Int64
+	waitDuration       atomic.Int64 // Nanoseconds.
+}
+
+func newAPILimiter(servicePackageName string, limit APILimit) *apiLimiter {
+	return &apiLimiter{
+		// Allow bursts of up to one second's worth of requests.
+		rateLimiter:        tfsync.NewRateLimiter(limit.RequestsPerSecond, int(math.Ceil(limit.RequestsPerSecond))),
+		semaphore:          tfsync.NewSemaphore(limit.MaxConcurrentRequests),
+		servicePackageName: servicePackageName,
+	}
+}
+
+// ID returns the middleware identifier.
+func (l *apiLimiter) ID() string {
+	return "TerraformAWSProviderAPILimiter"
+}
+
+// HandleFinalize waits for the limiter before each attempt.
+func (l *apiLimiter) HandleFinalize(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+	start := time.Now()
+
+	if err := l.rateLimiter.Wait(ctx); err != nil {
+		return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+	}
+	if err := l.semaphore.Acquire(ctx); err != nil {
+		return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+	}
+	defer l.semaphore.Release()
+
+	if wait := time.Since(start); wait >= time.Millisecond {
+		l.logWait(ctx, wait)
+	}
+
+	return next.HandleFinalize(ctx, in)
+}
+
+func (l *apiLimiter) logWait(ctx context.Context, wait time.Duration) {
+	count := l.waitCount.Add(1)
+	total := time.Duration(l.waitDuration.Add(int64(wait)))
+
+	tflog.Debug(ctx, "Waited for API limiter", map[string]any{
+		"tf_aws.api_limiter.service_package":     l.servicePackageName,
+		"tf_aws.api_limiter.wait_duration_ms":    wait.Milliseconds(),
+		"tf_aws.api_limiter.total_waits":         count,
+		"tf_aws.api_limiter.total_wait_duration": total.String(),
+	})
+}
+
+// addToStack adds the limiter to the API client's middleware stack.
+// The limiter runs after the retry middleware, and so applies to each attempt.
+func (l *apiLimiter) addToStack(stack *middleware.Stack) error {
+	return stack.Finalize.Add(l, middleware.After)
+}
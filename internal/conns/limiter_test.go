@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAPILimiterConcurrency(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	l := newAPILimiter(names.IAM, APILimit{MaxConcurrentRequests: 2})
+
+	var current, peak atomic.Int64
+	next := middleware.FinalizeHandlerFunc(func(ctx context.Context, in middleware.FinalizeInput) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		n := current.Add(1)
+		for {
+			if v := peak.Load(); n <= v || peak.CompareAndSwap(v, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		current.Add(-1)
+
+		return middleware.FinalizeOutput{}, middleware.Metadata{}, nil
+	})
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, _, err := l.HandleFinalize(ctx, middleware.FinalizeInput{}, next); err != nil {
+				t.Errorf("HandleFinalize: %s", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got, want := peak.Load(), int64(2); got > want {
+		t.Errorf("peak concurrency = %d, want at most %d", got, want)
+	}
+	if got := l.waitCount.Load(); got == 0 {
+		t.Errorf("expected waits to be counted")
+	}
+}
+
+func TestAWSClientAPIClientConfigAPILimits(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := context.Background()
+	awsConfig := aws.Config{Region: "us-west-2"} //lintignore:AWSAT003
+	c := &AWSClient{
+		awsConfig: &awsConfig,
+		apiLimiters: map[string]*apiLimiter{
+			names.IAM: newAPILimiter(names.IAM, APILimit{RequestsPerSecond: 5}),
+		},
+	}
+
+	if got := c.apiClientConfig(ctx, names.IAM)["aws_sdkv2_config"].(*aws.Config); len(got.APIOptions) != 1 {
+		t.Errorf("%s: expected limiter APIOptions, got %d", names.IAM, len(got.APIOptions))
+	}
+	if got := c.apiClientConfig(ctx, names.EC2)["aws_sdkv2_config"].(*aws.Config); len(got.APIOptions) != 0 {
+		t.Errorf("%s: expected no APIOptions, got %d", names.EC2, len(got.APIOptions))
+	}
+	if len(awsConfig.APIOptions) != 0 {
+		t.Errorf("provider AWS configuration modified")
+	}
+}
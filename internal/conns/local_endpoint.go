@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"os"
+
+	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// LocalEndpointEnvVar is the environment variable containing the base URL of a local AWS API emulator, such as LocalStack or moto.
+	LocalEndpointEnvVar = "TF_AWS_LOCAL_ENDPOINT"
+
+	// LocalEndpointAccountID is the AWS account ID used with a local AWS API emulator.
+	LocalEndpointAccountID = "000000000000"
+
+	// LocalEndpointPartitionID is the AWS partition used with a local AWS API emulator.
+	LocalEndpointPartitionID = endpoints.AwsPartitionID
+
+	// Local AWS API emulators accept any credentials.
+	localEndpointAccessKey = "test"
+	localEndpointSecretKey = "test"
+)
+
+// applyLocalEndpoint configures the provider to use a local AWS API emulator.
+// The emulator's base URL is treated like the AWS SDKs' global AWS_ENDPOINT_URL environment variable:
+// it is used for every service unless the service's endpoint is configured in the provider or in its
+// AWS_ENDPOINT_URL_<SERVICE> environment variable, and takes precedence over endpoints in the shared config file.
+// S3 uses path-style addressing, static credentials are used if none are configured,
+// and no credential, account ID or Region validation is done.
+func (c *Config) applyLocalEndpoint() {
+	if c.Endpoints == nil {
+		c.Endpoints = make(map[string]string)
+	}
+	for _, servicePackageName := range names.ProviderPackages() {
+		if c.Endpoints[servicePackageName] != "" {
+			continue
+		}
+
+		// The AWS SDK resolves the service's endpoint from the environment.
+		if v, err := names.AWSServiceEnvVar(servicePackageName); err == nil && os.Getenv(v) != "" {
+			continue
+		}
+
+		c.Endpoints[servicePackageName] = c.LocalEndpoint
+	}
+
+	if c.AccessKey == "" && c.SecretKey == "" && c.Profile == "" {
+		c.AccessKey = localEndpointAccessKey
+		c.SecretKey = localEndpointSecretKey
+	}
+
+	c.EC2MetadataServiceEnableState = imds.ClientDisabled
+	c.S3UsePathStyle = true
+	c.SkipCredsValidation = true
+	c.SkipRegionValidation = true
+	c.SkipRequestingAccountId = true
+}
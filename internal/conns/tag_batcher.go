@@ -0,0 +1,281 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+const (
+	// tagBatchMaxARNs is the maximum number of resource ARNs in a TagResources or UntagResources call.
+	tagBatchMaxARNs = 20
+	// tagBatchMaxTags is the maximum number of tags or tag keys in a TagResources or UntagResources call.
+	tagBatchMaxTags = 50
+)
+
+type tagResourcesAPIClient interface {
+	TagResources(context.Context, *resourcegroupstaggingapi.TagResourcesInput, ...func(*resourcegroupstaggingapi.Options)) (*resourcegroupstaggingapi.TagResourcesOutput, error)
+	UntagResources(context.Context, *resourcegroupstaggingapi.UntagResourcesInput, ...func(*resourcegroupstaggingapi.Options)) (*resourcegroupstaggingapi.UntagResourcesOutput, error)
+}
+
+// TagBatcher applies resource tag changes, keyed by ARN, in batches
+// using the Resource Groups Tagging API's TagResources and UntagResources operations.
+// Changes are never delayed: a change is sent immediately unless a call for the same Region is in flight,
+// in which case it is queued and sent, together with all other queued changes, once that call completes.
+// Batches therefore only form when many resources' tags are updated concurrently.
+// Callers block until their resource's changes have been applied and receive that resource's error, if any.
+type TagBatcher struct {
+	client func(context.Context) tagResourcesAPIClient
+	mu     sync.Mutex
+	queues map[tagBatchKey]*tagQueue
+}
+
+func newTagBatcher(client func(context.Context) tagResourcesAPIClient) *TagBatcher {
+	return &TagBatcher{
+		client: client,
+		queues: make(map[tagBatchKey]*tagQueue),
+	}
+}
+
+type tagBatchKey struct {
+	region string
+	untag  bool
+}
+
+type tagQueue struct {
+	inFlight bool
+	pending  *tagBatch
+}
+
+type tagBatch struct {
+	changes map[string]tftags.KeyValueTags // Tags to add, or tag keys to remove, by resource ARN.
+	ctx     context.Context
+	done    chan struct{}
+	errs    map[string]error
+}
+
+// tagBatchGroup is a set of resources sharing the same tag changes.
+type tagBatchGroup struct {
+	arns []string
+	tags tftags.KeyValueTags
+}
+
+// UpdateTags removes and then updates the specified resource's tags.
+func (b *TagBatcher) UpdateTags(ctx context.Context, resourceARN string, removedTags, updatedTags tftags.KeyValueTags) error {
+	v, err := arn.Parse(resourceARN)
+	if err != nil {
+		return err
+	}
+
+	if len(removedTags) > 0 {
+		if err := b.enqueue(ctx, tagBatchKey{region: v.Region, untag: true}, resourceARN, removedTags); err != nil {
+			return fmt.Errorf("untagging resource (%s): %w", resourceARN, err)
+		}
+	}
+
+	if len(updatedTags) > 0 {
+		if err := b.enqueue(ctx, tagBatchKey{region: v.Region}, resourceARN, updatedTags); err != nil {
+			return fmt.Errorf("tagging resource (%s): %w", resourceARN, err)
+		}
+	}
+
+	return nil
+}
+
+// enqueue adds the resource's changes to the pending batch and waits for the batch to be sent.
+func (b *TagBatcher) enqueue(ctx context.Context, key tagBatchKey, resourceARN string, tags tftags.KeyValueTags) error {
+	b.mu.Lock()
+	queue, ok := b.queues[key]
+	if !ok {
+		queue = &tagQueue{}
+		b.queues[key] = queue
+	}
+	batch := queue.pending
+	if batch == nil {
+		batch = &tagBatch{
+			changes: make(map[string]tftags.KeyValueTags),
+			// The batch may outlive the first caller's operation.
+			ctx:  context.WithoutCancel(ctx),
+			done: make(chan struct{}),
+			errs: make(map[string]error),
+		}
+		queue.pending = batch
+	}
+	batch.changes[resourceARN] = batch.changes[resourceARN].Merge(tags)
+	start := !queue.inFlight
+	if start {
+		queue.inFlight = true
+		queue.pending = nil
+	}
+	b.mu.Unlock()
+
+	if start {
+		go b.run(key, queue, batch)
+	}
+
+	select {
+	case <-batch.done:
+		return batch.errs[resourceARN]
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// run sends the batch and then any batches queued while it was in flight.
+func (b *TagBatcher) run(key tagBatchKey, queue *tagQueue, batch *tagBatch) {
+	for batch != nil {
+		b.flush(key, batch)
+
+		b.mu.Lock()
+		batch = queue.pending
+		queue.pending = nil
+		if batch == nil {
+			queue.inFlight = false
+		}
+		b.mu.Unlock()
+	}
+}
+
+// flush sends a batch's changes.
+func (b *TagBatcher) flush(key tagBatchKey, batch *tagBatch) {
+	defer close(batch.done)
+
+	ctx := batch.ctx
+	conn := b.client(ctx)
+	optFn := func(o *resourcegroupstaggingapi.Options) {
+		o.Region = key.region
+	}
+	groups := groupTagChanges(batch.changes, key.untag)
+
+	tflog.Debug(ctx, "Flushing tag batch", map[string]any{
+		"tf_aws.tag_batch.groups":        len(groups),
+		"tf_aws.tag_batch.region":        key.region,
+		"tf_aws.tag_batch.resource_arns": len(batch.changes),
+		"tf_aws.tag_batch.untag":         key.untag,
+	})
+
+	for _, group := range groups {
+		for arns := range slices.Chunk(group.arns, tagBatchMaxARNs) {
+			for _, chunk := range group.tags.Chunks(tagBatchMaxTags) {
+				var failedResources map[string]awstypes.FailureInfo
+				var err error
+
+				if key.untag {
+					input := resourcegroupstaggingapi.UntagResourcesInput{
+						ResourceARNList: arns,
+						TagKeys:         chunk.Keys(),
+					}
+					var output *resourcegroupstaggingapi.UntagResourcesOutput
+					output, err = conn.UntagResources(ctx, &input, optFn)
+					if output != nil {
+						failedResources = output.FailedResourcesMap
+					}
+				} else {
+					input := resourcegroupstaggingapi.TagResourcesInput{
+						ResourceARNList: arns,
+						Tags:            chunk.Map(),
+					}
+					var output *resourcegroupstaggingapi.TagResourcesOutput
+					output, err = conn.TagResources(ctx, &input, optFn)
+					if output != nil {
+						failedResources = output.FailedResourcesMap
+					}
+				}
+
+				for _, resourceARN := range arns {
+					if batch.errs[resourceARN] != nil {
+						continue
+					}
+
+					if err != nil {
+						batch.errs[resourceARN] = err
+					} else if v, ok := failedResources[resourceARN]; ok {
+						batch.errs[resourceARN] = failureInfoError(v)
+					}
+				}
+			}
+		}
+	}
+}
+
+// groupTagChanges groups resources by the individual tags (or tag keys, if untagging) they share,
+// so that a tag common to many resources is sent once for all of them even if their other tags differ.
+// Unless that needs fewer API calls than sending each resource's changes separately, each resource gets its own group.
+func groupTagChanges(changes map[string]tftags.KeyValueTags, untag bool) []tagBatchGroup {
+	// Resource ARNs by tag.
+	arnsByTag := make(map[string][]string)
+	for _, resourceARN := range slices.Sorted(maps.Keys(changes)) {
+		for k, v := range changes[resourceARN].Map() {
+			id := k
+			if !untag {
+				id += "\x00" + v
+			}
+			arnsByTag[id] = append(arnsByTag[id], resourceARN)
+		}
+	}
+
+	// Tags by set of resource ARNs.
+	groupsByARNs := make(map[string]*tagBatchGroup)
+	for id, arns := range arnsByTag {
+		arnsID := strings.Join(arns, "\x00")
+		group, ok := groupsByARNs[arnsID]
+		if !ok {
+			group = &tagBatchGroup{
+				arns: arns,
+				tags: make(tftags.KeyValueTags),
+			}
+			groupsByARNs[arnsID] = group
+		}
+		k, _, _ := strings.Cut(id, "\x00")
+		group.tags[k] = changes[arns[0]][k]
+	}
+
+	var groups, resourceGroups []tagBatchGroup
+	for _, arnsID := range slices.Sorted(maps.Keys(groupsByARNs)) {
+		groups = append(groups, *groupsByARNs[arnsID])
+	}
+	for _, resourceARN := range slices.Sorted(maps.Keys(changes)) {
+		resourceGroups = append(resourceGroups, tagBatchGroup{
+			arns: []string{resourceARN},
+			tags: changes[resourceARN],
+		})
+	}
+
+	if tagBatchCalls(groups) < tagBatchCalls(resourceGroups) {
+		return groups
+	}
+
+	return resourceGroups
+}
+
+// tagBatchCalls returns the number of API calls needed to send the groups' changes.
+func tagBatchCalls(groups []tagBatchGroup) int {
+	var n int
+	for _, group := range groups {
+		n += ((len(group.arns) + tagBatchMaxARNs - 1) / tagBatchMaxARNs) * ((len(group.tags) + tagBatchMaxTags - 1) / tagBatchMaxTags)
+	}
+
+	return n
+}
+
+func failureInfoError(apiObject awstypes.FailureInfo) error {
+	if v := aws.ToString(apiObject.ErrorMessage); v != "" {
+		return fmt.Errorf("%s: %s", apiObject.ErrorCode, v)
+	}
+
+	return errors.New(string(apiObject.ErrorCode))
+}
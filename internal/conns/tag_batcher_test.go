@@ -0,0 +1,356 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+type mockTagResourcesAPIClient struct {
+	failed    map[string]awstypes.FailureInfo
+	mu        sync.Mutex
+	regions   []string
+	release   chan struct{} // If set, calls block until it is closed.
+	started   chan struct{} // If set, signalled when a call starts.
+	tagARNs   [][]string
+	tags      []map[string]string
+	untagARNs [][]string
+}
+
+func (m *mockTagResourcesAPIClient) wait() {
+	if m.started != nil {
+		select {
+		case m.started <- struct{}{}:
+		default:
+		}
+	}
+	if m.release != nil {
+		<-m.release
+	}
+}
+
+func (m *mockTagResourcesAPIClient) TagResources(_ context.Context, input *resourcegroupstaggingapi.TagResourcesInput, optFns ...func(*resourcegroupstaggingapi.Options)) (*resourcegroupstaggingapi.TagResourcesOutput, error) {
+	m.wait()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.tagARNs = append(m.tagARNs, input.ResourceARNList)
+	m.tags = append(m.tags, input.Tags)
+	m.regions = append(m.regions, region(optFns))
+
+	return &resourcegroupstaggingapi.TagResourcesOutput{FailedResourcesMap: m.failed}, nil
+}
+
+func (m *mockTagResourcesAPIClient) UntagResources(_ context.Context, input *resourcegroupstaggingapi.UntagResourcesInput, optFns ...func(*resourcegroupstaggingapi.Options)) (*resourcegroupstaggingapi.UntagResourcesOutput, error) {
+	m.wait()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.untagARNs = append(m.untagARNs, input.ResourceARNList)
+	m.regions = append(m.regions, region(optFns))
+
+	return &resourcegroupstaggingapi.UntagResourcesOutput{}, nil
+}
+
+func region(optFns []func(*resourcegroupstaggingapi.Options)) string {
+	var options resourcegroupstaggingapi.Options
+	for _, optFn := range optFns {
+		optFn(&options)
+	}
+	return options.Region
+}
+
+// waitForPending waits until n resources' changes are queued behind an in-flight call.
+func waitForPending(t *testing.T, b *TagBatcher, key tagBatchKey, n int) {
+	t.Helper()
+
+	for range 5000 {
+		b.mu.Lock()
+		var got int
+		if queue, ok := b.queues[key]; ok && queue.pending != nil {
+			got = len(queue.pending.changes)
+		}
+		b.mu.Unlock()
+
+		if got == n {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	t.Fatalf("timed out waiting for %d queued resources", n)
+}
+
+func updateTagsConcurrently(ctx context.Context, b *TagBatcher, arns []string, removedTags, updatedTags tftags.KeyValueTags) map[string]error {
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	errs := make(map[string]error)
+
+	for _, arn := range arns {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			err := b.UpdateTags(ctx, arn, removedTags, updatedTags)
+
+			mu.Lock()
+			defer mu.Unlock()
+			errs[arn] = err
+		}()
+	}
+	wg.Wait()
+
+	return errs
+}
+
+func TestTagBatcherUpdateTagsNoDelay(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := &mockTagResourcesAPIClient{}
+	b := newTagBatcher(func(context.Context) tagResourcesAPIClient { return client })
+
+	arn := "arn:aws:sns:us-west-2:123456789012:topic1" //lintignore:AWSAT003,AWSAT005
+	// With nothing in flight, the change is sent immediately.
+	if err := b.UpdateTags(ctx, arn, tftags.New(ctx, map[string]string{"old": ""}), tftags.New(ctx, map[string]string{"key1": "value1"})); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(client.untagARNs), 1; got != want {
+		t.Errorf("UntagResources calls = %d, want %d", got, want)
+	}
+	if got, want := len(client.tagARNs), 1; got != want {
+		t.Errorf("TagResources calls = %d, want %d", got, want)
+	}
+	for _, v := range client.regions {
+		if got, want := v, "us-west-2"; got != want { //lintignore:AWSAT003
+			t.Errorf("Region = %q, want %q", got, want)
+		}
+	}
+}
+
+func TestTagBatcherUpdateTags(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := &mockTagResourcesAPIClient{
+		failed: map[string]awstypes.FailureInfo{
+			"arn:aws:sns:us-west-2:123456789012:topic2": { //lintignore:AWSAT003,AWSAT005
+				ErrorCode:    awstypes.ErrorCodeInvalidParameterException,
+				ErrorMessage: aws.String("invalid tag"),
+			},
+		},
+		release: make(chan struct{}),
+		started: make(chan struct{}, 1),
+	}
+	b := newTagBatcher(func(context.Context) tagResourcesAPIClient { return client })
+	updatedTags := tftags.New(ctx, map[string]string{"key1": "value1"})
+
+	// Hold a call in flight so that the following changes are queued.
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- b.UpdateTags(ctx, "arn:aws:sns:us-west-2:123456789012:topic0", nil, updatedTags) //lintignore:AWSAT003,AWSAT005
+	}()
+	<-client.started
+
+	arns := []string{
+		"arn:aws:sns:us-west-2:123456789012:topic1", //lintignore:AWSAT003,AWSAT005
+		"arn:aws:sns:us-west-2:123456789012:topic2", //lintignore:AWSAT003,AWSAT005
+		"arn:aws:sns:us-west-2:123456789012:topic3", //lintignore:AWSAT003,AWSAT005
+	}
+	var errs map[string]error
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		errs = updateTagsConcurrently(ctx, b, arns, nil, updatedTags)
+	}()
+	waitForPending(t, b, tagBatchKey{region: "us-west-2"}, len(arns)) //lintignore:AWSAT003
+	close(client.release)
+	<-done
+
+	if err := <-errCh; err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+	for _, arn := range arns {
+		err := errs[arn]
+		if strings.HasSuffix(arn, "topic2") {
+			if err == nil || !strings.Contains(err.Error(), "invalid tag") {
+				t.Errorf("%s: expected error, got %v", arn, err)
+			}
+		} else if err != nil {
+			t.Errorf("%s: unexpected error: %s", arn, err)
+		}
+	}
+
+	if got, want := len(client.tagARNs), 2; got != want {
+		t.Fatalf("TagResources calls = %d, want %d", got, want)
+	}
+	if got, want := len(client.tagARNs[1]), len(arns); got != want {
+		t.Errorf("TagResources ARNs = %d, want %d", got, want)
+	}
+}
+
+func TestTagBatcherMaxARNs(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := &mockTagResourcesAPIClient{
+		release: make(chan struct{}),
+		started: make(chan struct{}, 1),
+	}
+	b := newTagBatcher(func(context.Context) tagResourcesAPIClient { return client })
+	updatedTags := tftags.New(ctx, map[string]string{"key1": "value1"})
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- b.UpdateTags(ctx, "arn:aws:sns:us-west-2:123456789012:topic", nil, updatedTags) //lintignore:AWSAT003,AWSAT005
+	}()
+	<-client.started
+
+	var arns []string
+	for i := range tagBatchMaxARNs + 1 {
+		arns = append(arns, fmt.Sprintf("arn:aws:sns:us-west-2:123456789012:topic%d", i)) //lintignore:AWSAT003,AWSAT005
+	}
+	var errs map[string]error
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		errs = updateTagsConcurrently(ctx, b, arns, nil, updatedTags)
+	}()
+	waitForPending(t, b, tagBatchKey{region: "us-west-2"}, len(arns)) //lintignore:AWSAT003
+	close(client.release)
+	<-done
+
+	if err := <-errCh; err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+	for arn, err := range errs {
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", arn, err)
+		}
+	}
+
+	if got, want := len(client.tagARNs), 3; got != want {
+		t.Fatalf("TagResources calls = %d, want %d", got, want)
+	}
+	if got, want := len(client.tagARNs[1]), tagBatchMaxARNs; got != want {
+		t.Errorf("TagResources ARNs = %d, want %d", got, want)
+	}
+	if got, want := len(client.tagARNs[2]), 1; got != want {
+		t.Errorf("TagResources ARNs = %d, want %d", got, want)
+	}
+}
+
+func TestGroupTagChanges(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := map[string]struct {
+		changes  map[string]map[string]string
+		untag    bool
+		expected []tagBatchGroup
+	}{
+		"single resource": {
+			changes: map[string]map[string]string{
+				"arn1": {"k1": "v1", "k2": "v2"},
+			},
+			expected: []tagBatchGroup{
+				{arns: []string{"arn1"}, tags: tftags.New(ctx, map[string]string{"k1": "v1", "k2": "v2"})},
+			},
+		},
+		"identical changes": {
+			changes: map[string]map[string]string{
+				"arn1": {"k1": "v1"},
+				"arn2": {"k1": "v1"},
+			},
+			expected: []tagBatchGroup{
+				{arns: []string{"arn1", "arn2"}, tags: tftags.New(ctx, map[string]string{"k1": "v1"})},
+			},
+		},
+		"shared tags": {
+			changes: map[string]map[string]string{
+				"arn1": {"Env": "prod", "Owner": "team"},
+				"arn2": {"Env": "prod", "Owner": "team"},
+				"arn3": {"Env": "prod"},
+			},
+			expected: []tagBatchGroup{
+				{arns: []string{"arn1", "arn2"}, tags: tftags.New(ctx, map[string]string{"Owner": "team"})},
+				{arns: []string{"arn1", "arn2", "arn3"}, tags: tftags.New(ctx, map[string]string{"Env": "prod"})},
+			},
+		},
+		"shared and distinct tags": {
+			changes: map[string]map[string]string{
+				"arn1": {"Env": "prod", "Name": "one"},
+				"arn2": {"Env": "prod", "Name": "two"},
+				"arn3": {"Env": "prod", "Name": "three"},
+			},
+			expected: []tagBatchGroup{
+				{arns: []string{"arn1"}, tags: tftags.New(ctx, map[string]string{"Env": "prod", "Name": "one"})},
+				{arns: []string{"arn2"}, tags: tftags.New(ctx, map[string]string{"Env": "prod", "Name": "two"})},
+				{arns: []string{"arn3"}, tags: tftags.New(ctx, map[string]string{"Env": "prod", "Name": "three"})},
+			},
+		},
+		"untag ignores values": {
+			changes: map[string]map[string]string{
+				"arn1": {"k1": "v1"},
+				"arn2": {"k1": "v2"},
+			},
+			untag: true,
+			expected: []tagBatchGroup{
+				{arns: []string{"arn1", "arn2"}, tags: tftags.New(ctx, map[string]string{"k1": "v1"})},
+			},
+		},
+		"more calls than resources": {
+			changes: map[string]map[string]string{
+				"arn1": {"k1": "v", "k2": "v", "k4": "v"},
+				"arn2": {"k1": "v", "k3": "v", "k4": "v"},
+				"arn3": {"k2": "v", "k3": "v", "k4": "v"},
+			},
+			expected: []tagBatchGroup{
+				{arns: []string{"arn1"}, tags: tftags.New(ctx, map[string]string{"k1": "v", "k2": "v", "k4": "v"})},
+				{arns: []string{"arn2"}, tags: tftags.New(ctx, map[string]string{"k1": "v", "k3": "v", "k4": "v"})},
+				{arns: []string{"arn3"}, tags: tftags.New(ctx, map[string]string{"k2": "v", "k3": "v", "k4": "v"})},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			changes := make(map[string]tftags.KeyValueTags)
+			for k, v := range testCase.changes {
+				changes[k] = tftags.New(ctx, v)
+			}
+
+			got := groupTagChanges(changes, testCase.untag)
+
+			if got, want := len(got), len(testCase.expected); got != want {
+				t.Fatalf("groups = %d, want %d", got, want)
+			}
+			for i, want := range testCase.expected {
+				if !slices.Equal(got[i].arns, want.arns) {
+					t.Errorf("group %d ARNs = %v, want %v", i, got[i].arns, want.arns)
+				}
+				if !maps.Equal(got[i].tags.Map(), want.tags.Map()) {
+					t.Errorf("group %d tags = %v, want %v", i, got[i].tags.Map(), want.tags.Map())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,407 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	smithy "github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+const (
+	// APITelemetryFormatJSONLines writes one JSON object per AWS API call.
+	APITelemetryFormatJSONLines = "json_lines"
+	// APITelemetryFormatOTLPJSON writes an OpenTelemetry (OTLP/JSON) trace export request containing one span per AWS API call.
+	APITelemetryFormatOTLPJSON = "otlp_json"
+)
+
+const (
+	// APITelemetryFileEnvVar is the environment variable used to enable API call telemetry if not set in provider configuration.
+	APITelemetryFileEnvVar = "TF_AWS_API_TELEMETRY_FILE"
+	// APITelemetryFormatEnvVar is the environment variable used to set the API call telemetry format if not set in provider configuration.
+	APITelemetryFormatEnvVar = "TF_AWS_API_TELEMETRY_FORMAT"
+)
+
+// APITelemetryFormat_Values returns all valid API telemetry formats.
+func APITelemetryFormat_Values() []string {
+	return []string{
+		APITelemetryFormatJSONLines,
+		APITelemetryFormatOTLPJSON,
+	}
+}
+
+// APITelemetryConfig represents the API call telemetry configuration.
+type APITelemetryConfig struct {
+	File   string
+	Format string
+}
+
+// apiCall represents a single AWS API call, including all of its attempts.
+type apiCall struct {
+	Attempts           int       `json:"attempts"`
+	Duration           float64   `json:"duration_ms"`
+	Error              string    `json:"error,omitempty"`
+	Operation          string    `json:"operation"`
+	Region             string    `json:"region,omitempty"`
+	RequestID          string    `json:"request_id,omitempty"`
+	ResourceID         string    `json:"resource_id,omitempty"`
+	ResourceKind       string    `json:"resource_kind,omitempty"`
+	ResourceName       string    `json:"resource_name,omitempty"`
+	ResourceType       string    `json:"resource_type,omitempty"`
+	Retries            int       `json:"retries"`
+	ServiceID          string    `json:"service_id"`
+	ServicePackageName string    `json:"service_package"`
+	StartTime          time.Time `json:"start_time"`
+	Throttles          int       `json:"throttles"`
+
+	endTime time.Time
+}
+
+// apiTelemetry records AWS API calls, appending each call to a file as soon as it completes.
+// All of the provider's AWS SDK for Go v2 API clients share a single recorder.
+type apiTelemetry struct {
+	calls   int
+	config  APITelemetryConfig
+	err     error // The first error writing the file. No further calls are recorded.
+	file    *os.File
+	lock    sync.Mutex
+	now     func() time.Time
+	traceID string
+}
+
+func newAPITelemetry(config APITelemetryConfig) *apiTelemetry {
+	if config.Format == "" {
+		config.Format = APITelemetryFormatJSONLines
+	}
+
+	return &apiTelemetry{
+		config: config,
+		now:    time.Now,
+	}
+}
+
+// apiTelemetryMiddleware records the AWS API calls made by a single service's API clients.
+type apiTelemetryMiddleware struct {
+	servicePackageName string
+	telemetry          *apiTelemetry
+}
+
+// ID returns the middleware identifier.
+func (m *apiTelemetryMiddleware) ID() string {
+	return "TerraformAWSProviderAPITelemetry"
+}
+
+// HandleInitialize records the call once all attempts have completed.
+func (m *apiTelemetryMiddleware) HandleInitialize(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+	start := m.telemetry.now()
+
+	out, metadata, err := next.HandleInitialize(ctx, in)
+
+	end := m.telemetry.now()
+	call := apiCall{
+		Duration:           float64(end.Sub(start).Microseconds()) / 1000,
+		Operation:          awsmiddleware.GetOperationName(ctx),
+		Region:             awsmiddleware.GetRegion(ctx),
+		ServiceID:          awsmiddleware.GetServiceID(ctx),
+		ServicePackageName: m.servicePackageName,
+		StartTime:          start,
+		endTime:            end,
+	}
+
+	if v, ok := FromContext(ctx); ok {
+		call.ResourceID = v.ResourceID()
+		call.ResourceName = v.ResourceName()
+		call.ResourceType = v.TypeName()
+		switch {
+		case v.IsDataSource():
+			call.ResourceKind = "data_source"
+		case v.IsEphemeralResource():
+			call.ResourceKind = "ephemeral_resource"
+		default:
+			call.ResourceKind = "resource"
+		}
+	}
+
+	if v, ok := retry.GetAttemptResults(metadata); ok {
+		call.Attempts = len(v.Results)
+		call.Retries = max(call.Attempts-1, 0)
+		for _, v := range v.Results {
+			if v.Err != nil && retry.IsErrorThrottles(retry.DefaultThrottles).IsErrorThrottle(v.Err) == aws.TrueTernary {
+				call.Throttles++
+			}
+		}
+	}
+
+	if v, ok := awsmiddleware.GetRequestIDMetadata(metadata); ok {
+		call.RequestID = v
+	}
+
+	if err != nil {
+		if apiErr, ok := errs.As[smithy.APIError](err); ok {
+			call.Error = apiErr.ErrorCode()
+		} else {
+			call.Error = err.Error()
+		}
+	}
+
+	m.telemetry.record(ctx, call)
+
+	return out, metadata, err
+}
+
+// addToStack adds the telemetry recorder to an API client's middleware stack.
+// The recorder runs in the Initialize step, and so measures the call including all retries.
+func (t *apiTelemetry) addToStack(servicePackageName string) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		return stack.Initialize.Add(&apiTelemetryMiddleware{
+			servicePackageName: servicePackageName,
+			telemetry:          t,
+		}, middleware.After)
+	}
+}
+
+// record appends the call to the telemetry file.
+// Telemetry never fails an API call: the first error writing the file is logged and recording stops.
+func (t *apiTelemetry) record(ctx context.Context, call apiCall) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.err != nil {
+		return
+	}
+
+	if err := t.writeCall(call); err != nil {
+		t.err = err
+		tflog.Warn(ctx, "Recording API telemetry", map[string]any{
+			"tf_aws.api_telemetry.file": t.config.File,
+			"error":                     err.Error(),
+		})
+	}
+}
+
+func (t *apiTelemetry) writeCall(call apiCall) error {
+	var data []byte
+	var err error
+
+	switch t.config.Format {
+	case APITelemetryFormatJSONLines:
+		data, err = encodeAPICallsJSONLines([]apiCall{call})
+	case APITelemetryFormatOTLPJSON:
+		if t.traceID == "" {
+			if t.traceID, err = otlpID(16); err != nil {
+				return err
+			}
+		}
+		data, err = encodeAPICallsOTLPJSON(t.traceID, []apiCall{call})
+	default:
+		err = fmt.Errorf("unsupported API telemetry format: %q", t.config.Format)
+	}
+	if err != nil {
+		return err
+	}
+
+	if t.file == nil {
+		// Each provider process appends to the same file.
+		f, err := os.OpenFile(t.config.File, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+		if err != nil {
+			return fmt.Errorf("opening API telemetry file: %w", err)
+		}
+		t.file = f
+	}
+
+	// Each call is a single write so that lines from concurrent provider processes are not interleaved.
+	if _, err := t.file.Write(data); err != nil {
+		return fmt.Errorf("writing API telemetry file: %w", err)
+	}
+	t.calls++
+
+	return nil
+}
+
+// close closes the telemetry file, returning any error that stopped calls being recorded.
+func (t *apiTelemetry) close(ctx context.Context) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	err := t.err
+
+	if t.file != nil {
+		if closeErr := t.file.Close(); closeErr != nil && err == nil {
+			err = fmt.Errorf("closing API telemetry file: %w", closeErr)
+		}
+		t.file = nil
+
+		tflog.Debug(ctx, "Wrote API telemetry", map[string]any{
+			"tf_aws.api_telemetry.file":   t.config.File,
+			"tf_aws.api_telemetry.format": t.config.Format,
+			"tf_aws.api_telemetry.calls":  t.calls,
+		})
+	}
+
+	return err
+}
+
+func encodeAPICallsJSONLines(calls []apiCall) ([]byte, error) {
+	var data []byte
+
+	for _, call := range calls {
+		b, err := json.Marshal(call)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, b...)
+		data = append(data, '\n')
+	}
+
+	return data, nil
+}
+
+// OTLP/JSON trace export request.
+// See https://opentelemetry.io/docs/specs/otlp/#json-protobuf-encoding.
+type (
+	otlpTraces struct {
+		ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+	}
+	otlpResourceSpans struct {
+		Resource   otlpResource     `json:"resource"`
+		ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+	}
+	otlpResource struct {
+		Attributes []otlpKeyValue `json:"attributes"`
+	}
+	otlpScopeSpans struct {
+		Scope otlpScope  `json:"scope"`
+		Spans []otlpSpan `json:"spans"`
+	}
+	otlpScope struct {
+		Name string `json:"name"`
+	}
+	otlpSpan struct {
+		TraceID           string         `json:"traceId"`
+		SpanID            string         `json:"spanId"`
+		Name              string         `json:"name"`
+		Kind              int            `json:"kind"`
+		StartTimeUnixNano string         `json:"startTimeUnixNano"`
+		EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+		Attributes        []otlpKeyValue `json:"attributes"`
+		Status            otlpStatus     `json:"status"`
+	}
+	otlpStatus struct {
+		Code    int    `json:"code,omitempty"`
+		Message string `json:"message,omitempty"`
+	}
+	otlpKeyValue struct {
+		Key   string    `json:"key"`
+		Value otlpValue `json:"value"`
+	}
+	otlpValue struct {
+		IntValue    *string `json:"intValue,omitempty"`
+		StringValue *string `json:"stringValue,omitempty"`
+	}
+)
+
+const (
+	otlpSpanKindClient  = 3
+	otlpStatusCodeError = 2
+)
+
+func otlpString(key, value string) otlpKeyValue {
+	return otlpKeyValue{Key: key, Value: otlpValue{StringValue: &value}}
+}
+
+func otlpInt(key string, value int) otlpKeyValue {
+	v := strconv.Itoa(value)
+	return otlpKeyValue{Key: key, Value: otlpValue{IntValue: &v}}
+}
+
+func otlpID(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+// encodeAPICallsOTLPJSON encodes the calls as a single line containing an OTLP/JSON trace export request.
+// All calls made by a provider process share a trace.
+func encodeAPICallsOTLPJSON(traceID string, calls []apiCall) ([]byte, error) {
+	spans := make([]otlpSpan, 0, len(calls))
+	for _, call := range calls {
+		spanID, err := otlpID(8)
+		if err != nil {
+			return nil, err
+		}
+
+		attributes := []otlpKeyValue{
+			otlpString("rpc.system", "aws-api"),
+			otlpString("rpc.service", call.ServiceID),
+			otlpString("rpc.method", call.Operation),
+			otlpString("tf_aws.service_package", call.ServicePackageName),
+			otlpInt("tf_aws.attempts", call.Attempts),
+			otlpInt("tf_aws.retries", call.Retries),
+			otlpInt("tf_aws.throttles", call.Throttles),
+		}
+		for _, v := range []struct{ key, value string }{
+			{"aws.request_id", call.RequestID},
+			{"cloud.region", call.Region},
+			{"tf_aws.resource_id", call.ResourceID},
+			{"tf_aws.resource_kind", call.ResourceKind},
+			{"tf_aws.resource_name", call.ResourceName},
+			{"tf_aws.resource_type", call.ResourceType},
+		} {
+			if v.value != "" {
+				attributes = append(attributes, otlpString(v.key, v.value))
+			}
+		}
+
+		span := otlpSpan{
+			TraceID:           traceID,
+			SpanID:            spanID,
+			Name:              call.ServiceID + "." + call.Operation,
+			Kind:              otlpSpanKindClient,
+			StartTimeUnixNano: strconv.FormatInt(call.StartTime.UnixNano(), 10),
+			EndTimeUnixNano:   strconv.FormatInt(call.endTime.UnixNano(), 10),
+			Attributes:        attributes,
+		}
+		if call.Error != "" {
+			span.Status = otlpStatus{Code: otlpStatusCodeError, Message: call.Error}
+		}
+
+		spans = append(spans, span)
+	}
+
+	data, err := json.Marshal(otlpTraces{
+		ResourceSpans: []otlpResourceSpans{{
+			Resource: otlpResource{
+				Attributes: []otlpKeyValue{
+					otlpString("service.name", "terraform-provider-aws"),
+				},
+			},
+			ScopeSpans: []otlpScopeSpans{{
+				Scope: otlpScope{Name: "github.com/hashicorp/terraform-provider-aws/internal/conns"},
+				Spans: spans,
+			}},
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return append(data, '\n'), nil
+}
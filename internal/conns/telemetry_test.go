@@ -0,0 +1,216 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/ratelimit"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAPITelemetry(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		format string
+		check  func(*testing.T, string)
+	}{
+		"JSON lines": {
+			format: APITelemetryFormatJSONLines,
+			check: func(t *testing.T, data string) {
+				t.Helper()
+
+				var got []apiCall
+				scanner := bufio.NewScanner(strings.NewReader(data))
+				for scanner.Scan() {
+					var v apiCall
+					if err := json.Unmarshal(scanner.Bytes(), &v); err != nil {
+						t.Fatalf("decoding JSON line: %s", err)
+					}
+					got = append(got, v)
+				}
+
+				want := []apiCall{
+					{
+						Attempts:           2,
+						Operation:          "GetCallerIdentity",
+						Region:             "us-west-2", //lintignore:AWSAT003
+						RequestID:          "01234567-89ab-cdef-0123-456789abcdef",
+						ResourceID:         "123456789012",
+						ResourceKind:       "data_source",
+						ResourceName:       "Caller Identity",
+						ResourceType:       "aws_caller_identity",
+						Retries:            1,
+						ServiceID:          "STS",
+						ServicePackageName: names.STS,
+						Throttles:          1,
+					},
+				}
+				if diff := cmp.Diff(got, want, cmpopts.IgnoreFields(apiCall{}, "Duration", "StartTime"), cmpopts.IgnoreUnexported(apiCall{})); diff != "" {
+					t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+				}
+			},
+		},
+		"OTLP JSON": {
+			format: APITelemetryFormatOTLPJSON,
+			check: func(t *testing.T, data string) {
+				t.Helper()
+
+				var got otlpTraces
+				if err := json.Unmarshal([]byte(data), &got); err != nil {
+					t.Fatalf("decoding OTLP JSON: %s", err)
+				}
+
+				if n := len(got.ResourceSpans); n != 1 {
+					t.Fatalf("expected 1 resource spans, got %d", n)
+				}
+				spans := got.ResourceSpans[0].ScopeSpans[0].Spans
+				if n := len(spans); n != 1 {
+					t.Fatalf("expected 1 span, got %d", n)
+				}
+				span := spans[0]
+				if got, want := span.Name, "STS.GetCallerIdentity"; got != want {
+					t.Errorf("span name = %q, want %q", got, want)
+				}
+				if got, want := len(span.TraceID), 32; got != want {
+					t.Errorf("trace ID length = %d, want %d", got, want)
+				}
+				if got, want := len(span.SpanID), 16; got != want {
+					t.Errorf("span ID length = %d, want %d", got, want)
+				}
+
+				attributes := make(map[string]string)
+				for _, v := range span.Attributes {
+					if v.Value.StringValue != nil {
+						attributes[v.Key] = *v.Value.StringValue
+					} else if v.Value.IntValue != nil {
+						attributes[v.Key] = *v.Value.IntValue
+					}
+				}
+				for k, want := range map[string]string{
+					"rpc.system":           "aws-api",
+					"rpc.method":           "GetCallerIdentity",
+					"tf_aws.resource_id":   "123456789012",
+					"tf_aws.resource_type": "aws_caller_identity",
+					"tf_aws.retries":       "1",
+					"tf_aws.throttles":     "1",
+				} {
+					if got := attributes[k]; got != want {
+						t.Errorf("attribute %s = %q, want %q", k, got, want)
+					}
+				}
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := NewDataSourceContext(context.Background(), names.STS, "Caller Identity", "aws_caller_identity", "")
+			ctx = WithResourceID(ctx, "123456789012")
+			file := filepath.Join(t.TempDir(), "telemetry")
+			telemetry := newAPITelemetry(APITelemetryConfig{File: file, Format: testCase.format})
+
+			client := sts.NewFromConfig(testAPITelemetryAWSConfig(), func(o *sts.Options) {
+				o.APIOptions = append(o.APIOptions, telemetry.addToStack(names.STS))
+			})
+			if _, err := client.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{}); err != nil {
+				t.Fatalf("GetCallerIdentity: %s", err)
+			}
+
+			// Calls are written as they complete, not at shutdown.
+			data, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatalf("reading telemetry file: %s", err)
+			}
+
+			if err := telemetry.close(ctx); err != nil {
+				t.Fatalf("close: %s", err)
+			}
+
+			testCase.check(t, string(data))
+		})
+	}
+}
+
+func TestAPITelemetry_writeError(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	telemetry := newAPITelemetry(APITelemetryConfig{File: filepath.Join(t.TempDir(), "missing", "telemetry")})
+
+	for range 2 {
+		telemetry.record(ctx, apiCall{Operation: "GetCallerIdentity", ServiceID: "STS"})
+	}
+
+	if got, want := telemetry.calls, 0; got != want {
+		t.Errorf("calls = %d, want %d", got, want)
+	}
+	if err := telemetry.close(ctx); err == nil {
+		t.Error("close: expected error")
+	}
+}
+
+// testAPITelemetryAWSConfig returns an AWS configuration whose HTTP client throttles the first request.
+func testAPITelemetryAWSConfig() aws.Config {
+	var requests int
+
+	return aws.Config{
+		Region:      "us-west-2", //lintignore:AWSAT003
+		Credentials: credentials.NewStaticCredentialsProvider("AKID", "SECRET", ""),
+		HTTPClient: smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+			requests++
+
+			if requests == 1 {
+				return &http.Response{
+					StatusCode: http.StatusBadRequest,
+					Header:     http.Header{"X-Amzn-Requestid": []string{"76543210-89ab-cdef-0123-456789abcdef"}},
+					Body: io.NopCloser(strings.NewReader(`<ErrorResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <Error><Type>Sender</Type><Code>Throttling</Code><Message>Rate exceeded</Message></Error>
+  <RequestId>76543210-89ab-cdef-0123-456789abcdef</RequestId>
+</ErrorResponse>`)),
+				}, nil
+			}
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"X-Amzn-Requestid": []string{"01234567-89ab-cdef-0123-456789abcdef"}},
+				Body: io.NopCloser(strings.NewReader(`<GetCallerIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <GetCallerIdentityResult>
+    <Arn>arn:aws:iam::123456789012:user/Alice</Arn>
+    <UserId>AKIAI44QH8DHBEXAMPLE</UserId>
+    <Account>123456789012</Account>
+  </GetCallerIdentityResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</GetCallerIdentityResponse>`)),
+			}, nil
+		}),
+		Retryer: func() aws.Retryer {
+			return retry.NewStandard(func(o *retry.StandardOptions) {
+				o.Backoff = retry.BackoffDelayerFunc(func(int, error) (time.Duration, error) { return 0, nil })
+				o.RateLimiter = ratelimit.None
+			})
+		},
+	}
+}
@@ -16,3 +16,13 @@ func SetDefaultTagsConfig(client *AWSClient, d *tftags.DefaultConfig) {
 func SetIgnoreTagsConfig(client *AWSClient, i *tftags.IgnoreConfig) {
 	client.ignoreTagsConfig = i
 }
+
+// SetTagPolicyConfig is only intended for use in tests
+func SetTagPolicyConfig(client *AWSClient, pc *tftags.PolicyConfig) {
+	client.tagPolicyConfig = pc
+}
+
+// SetTagBatcher is only intended for use in tests
+func SetTagBatcher(client *AWSClient, b *TagBatcher) {
+	client.tagBatcher = b
+}
@@ -61,7 +61,14 @@ func (g *Graph) RemoveNode(s string) {
 
 // HasNode returns whether the specified string is in the graph.
 func (g *Graph) HasNode(s string) bool {
-	return slices.Contains(g.nodes, s)
+	_, ok := g.outgoingEdges[s]
+
+	return ok
+}
+
+// Nodes returns the nodes in the graph, in the order in which they were added.
+func (g *Graph) Nodes() []string {
+	return slices.Clone(g.nodes)
 }
 
 // AddDependency adds a dependency between two nodes.
@@ -104,7 +111,7 @@ func (g *Graph) DirectDependenciesOf(s string) ([]string, error) {
 		return nil, nonExistentNodeError(s)
 	}
 
-	return g.outgoingEdges[s], nil
+	return slices.Clone(g.outgoingEdges[s]), nil
 }
 
 // DirectDependentsOf returns the nodes that directly depend on the specified node.
@@ -114,7 +121,7 @@ func (g *Graph) DirectDependentsOf(s string) ([]string, error) {
 		return nil, nonExistentNodeError(s)
 	}
 
-	return g.incomingEdges[s], nil
+	return slices.Clone(g.incomingEdges[s]), nil
 }
 
 // DependenciesOf returns the nodes that the specified node depends on (transitively).
@@ -194,12 +201,12 @@ func depthFirstSearch(edges map[string][]string) func(s string) ([]string, error
 		processed bool
 	}
 
-	visited := make([]string, 0)
+	visited := make(map[string]struct{})
 
 	return func(s string) ([]string, error) {
 		results := make([]string, 0)
 
-		if slices.Contains(visited, s) {
+		if _, ok := visited[s]; ok {
 			return results, nil
 		}
 
@@ -217,7 +224,7 @@ func depthFirstSearch(edges map[string][]string) func(s string) ([]string, error
 
 			if !current.processed {
 				// Visit edges.
-				if slices.Contains(visited, node) {
+				if _, ok := visited[node]; ok {
 					todo.Pop()
 
 					continue
@@ -247,7 +254,7 @@ func depthFirstSearch(edges map[string][]string) func(s string) ([]string, error
 					currentPath = currentPath[:n-1]
 				}
 				delete(inCurrentPath, node)
-				visited = append(visited, node)
+				visited[node] = struct{}{}
 				results = append(results, node)
 			}
 		}
@@ -41,6 +41,10 @@ func TestDependencyGraphAddAndRemoveNodes(t *testing.T) {
 		t.Fatalf("incorrect length. Expected: %d, got: %d", expected, got)
 	}
 
+	if got, expected := g.Nodes(), []string{"a", "b"}; !reflect.DeepEqual(got, expected) {
+		t.Fatalf("incorrect nodes. Expected: %v, got: %v", expected, got)
+	}
+
 	if g.HasNode("c") {
 		t.Fatalf("expected graph not to contain c")
 	}
@@ -84,6 +84,58 @@ const (
 	AssumeRoleSessionName = "TF_AWS_ASSUME_ROLE_SESSION_NAME"
 )
 
+// Custom environment variables used to control resource sweepers
+const (
+	// Maximum number of resources swept concurrently by each sweeper.
+	// Defaults to no limit.
+	SweepConcurrency = "TF_AWS_SWEEP_CONCURRENCY"
+
+	// Only resources created after this time are swept.
+	// Either an RFC 3339 timestamp or a duration before the current time, e.g. "24h".
+	SweepCreatedAfter = "TF_AWS_SWEEP_CREATED_AFTER"
+
+	// Only resources created before this time are swept.
+	// Either an RFC 3339 timestamp or a duration before the current time, e.g. "24h".
+	SweepCreatedBefore = "TF_AWS_SWEEP_CREATED_BEFORE"
+
+	// If true, resources that would be swept are listed but not deleted.
+	SweepDryRun = "TF_AWS_SWEEP_DRY_RUN"
+
+	// Comma-separated list of name prefixes. Resources whose name has any of the prefixes are not swept.
+	SweepExcludeNamePrefixes = "TF_AWS_SWEEP_EXCLUDE_NAME_PREFIXES"
+
+	// Comma-separated list of Name=Value tags. Resources with any of the tags are not swept.
+	// A tag without a value matches any value.
+	SweepExcludeTags = "TF_AWS_SWEEP_EXCLUDE_TAGS"
+
+	// Comma-separated list of name prefixes. Only resources whose name has one of the prefixes are swept.
+	SweepIncludeNamePrefixes = "TF_AWS_SWEEP_INCLUDE_NAME_PREFIXES"
+
+	// Comma-separated list of Name=Value tags. Only resources with all of the tags are swept.
+	// A tag without a value matches any value.
+	SweepIncludeTags = "TF_AWS_SWEEP_INCLUDE_TAGS"
+
+	// Maximum number of times a failing sweeper is run in each Region.
+	// Defaults to 3.
+	SweepMaxAttempts = "TF_AWS_SWEEP_MAX_ATTEMPTS"
+
+	// Maximum number of sweepers run concurrently in each Region.
+	// Defaults to 4.
+	SweepParallelism = "TF_AWS_SWEEP_PARALLELISM"
+
+	// File to which a JSON report of deleted, skipped and failed resources is written.
+	SweepReportFile = "TF_AWS_SWEEP_REPORT_FILE"
+
+	// Duration to wait before a failed sweeper is run again, e.g. "1m".
+	// Defaults to 30 seconds.
+	SweepRetryDelay = "TF_AWS_SWEEP_RETRY_DELAY"
+
+	// Comma-separated list of IAM role ARNs, one for each account to sweep.
+	// Each role is assumed in turn, after any role set in TF_AWS_ASSUME_ROLE_ARN.
+	// Defaults to sweeping the account of the configured credentials.
+	SweepRoleARNs = "TF_AWS_SWEEP_ROLE_ARNS"
+)
+
 // GetWithDefault gets an environment variable value if non-empty or returns the default.
 func GetWithDefault(variable string, defaultValue string) string {
 	value := os.Getenv(variable)
@@ -5,9 +5,11 @@ package flex
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 )
 
@@ -60,3 +62,48 @@ func HasWriteOnlyValue(d writeOnlyAttrGetter, attr string) bool {
 
 	return d.Get(hasAttr).(bool)
 }
+
+// WriteOnlyAttrName returns the name of the write-only variant, `<attr>_wo`, of the attribute.
+func WriteOnlyAttrName(attr string) string {
+	return attr + "_wo"
+}
+
+// WriteOnlyVersionAttrName returns the name of the attribute, `<attr>_wo_version`, whose changes
+// trigger the use of the value of the attribute's write-only variant.
+func WriteOnlyVersionAttrName(attr string) string {
+	return WriteOnlyAttrName(attr) + "_version"
+}
+
+// HasWriteOnlyChange returns true if the attribute's `<attr>_wo_version` trigger has changed,
+// meaning that the value of the write-only variant, `<attr>_wo`, should be sent to the API.
+// Write-only values are never persisted so they cannot themselves produce a diff.
+func HasWriteOnlyChange(d interface{ HasChange(string) bool }, attr string) bool {
+	return d.HasChange(WriteOnlyVersionAttrName(attr))
+}
+
+// WriteOnlyStringSchema returns the schema for the write-only variant, `<attr>_wo`, of a top-level secret attribute.
+// Any validation or additional constraints are taken from s, which may be nil.
+// The write-only value is only used when the `<attr>_wo_version` trigger attribute changes.
+func WriteOnlyStringSchema(attr string, s *schema.Schema) *schema.Schema {
+	var v schema.Schema
+	if s != nil {
+		v = *s
+	}
+
+	v.Type = schema.TypeString
+	v.Optional = true
+	v.Sensitive = true
+	v.WriteOnly = true
+	v.RequiredWith = append(slices.Clone(v.RequiredWith), WriteOnlyVersionAttrName(attr))
+
+	return &v
+}
+
+// WriteOnlyVersionSchema returns the schema for the `<attr>_wo_version` trigger attribute.
+func WriteOnlyVersionSchema(attr string) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		RequiredWith: []string{WriteOnlyAttrName(attr)},
+	}
+}
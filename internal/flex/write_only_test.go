@@ -5,11 +5,14 @@ package flex_test
 
 import (
 	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type mockWriteOnlyAttrGetter struct {
@@ -137,3 +140,76 @@ func TestGetWriteOnlyStringValue(t *testing.T) {
 		})
 	}
 }
+
+type mockHasChanger map[string]bool
+
+func (m mockHasChanger) HasChange(key string) bool {
+	return m[key]
+}
+
+func TestHasWriteOnlyChange(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		changes  mockHasChanger
+		expected bool
+	}{
+		"no changes": {
+			changes: mockHasChanger{},
+		},
+		"version changed": {
+			changes: mockHasChanger{
+				"password_wo_version": true,
+			},
+			expected: true,
+		},
+		"other attribute changed": {
+			changes: mockHasChanger{
+				names.AttrPassword: true,
+				"password_wo":      true,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := flex.HasWriteOnlyChange(testCase.changes, names.AttrPassword), testCase.expected; got != want {
+				t.Errorf("HasWriteOnlyChange = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestWriteOnlyStringSchema(t *testing.T) {
+	t.Parallel()
+
+	base := &schema.Schema{
+		ConflictsWith: []string{"passwords"},
+		RequiredWith:  []string{"user"},
+	}
+	got := flex.WriteOnlyStringSchema(names.AttrPassword, base)
+
+	if !got.WriteOnly || !got.Sensitive || !got.Optional || got.Type != schema.TypeString {
+		t.Errorf("unexpected schema: %#v", got)
+	}
+	if want := []string{"passwords"}; !slices.Equal(got.ConflictsWith, want) {
+		t.Errorf("ConflictsWith = %v, want %v", got.ConflictsWith, want)
+	}
+	if want := []string{"user", "password_wo_version"}; !slices.Equal(got.RequiredWith, want) {
+		t.Errorf("RequiredWith = %v, want %v", got.RequiredWith, want)
+	}
+	if want := []string{"user"}; !slices.Equal(base.RequiredWith, want) {
+		t.Errorf("base RequiredWith modified: %v", base.RequiredWith)
+	}
+
+	if got := flex.WriteOnlyStringSchema(names.AttrPassword, nil); !slices.Equal(got.RequiredWith, []string{"password_wo_version"}) {
+		t.Errorf("RequiredWith = %v", got.RequiredWith)
+	}
+
+	got = flex.WriteOnlyVersionSchema(names.AttrPassword)
+	if got.Type != schema.TypeInt || !got.Optional || !slices.Equal(got.RequiredWith, []string{"password_wo"}) {
+		t.Errorf("unexpected schema: %#v", got)
+	}
+}
@@ -6,7 +6,6 @@ package flex
 import (
 	"context"
 	"fmt"
-	"iter"
 	"reflect"
 
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
@@ -1152,6 +1151,12 @@ func expandStruct(ctx context.Context, sourcePath path.Path, from any, targetPat
 	}
 
 	if valTo.Kind() == reflect.Interface {
+		opts := flexer.getOptions()
+		if u, ok := opts.union(valTo.Type()); ok && u.isModel(valFrom.Type()) {
+			diags.Append(expandUnion(ctx, sourcePath, valFrom, targetPath, valTo, u, flexer)...)
+			return diags
+		}
+
 		tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; incompatible types", map[string]any{
 			"from": valFrom.Type(),
 			"to":   valTo.Kind(),
@@ -1162,20 +1167,32 @@ func expandStruct(ctx context.Context, sourcePath path.Path, from any, targetPat
 	typeFrom := valFrom.Type()
 	typeTo := valTo.Type()
 
-	for fromField := range expandSourceFields(ctx, typeFrom, flexer.getOptions()) {
-		fromFieldName := fromField.Name
-		_, fromFieldOpts := autoflexTags(fromField)
+	for _, field := range expandStructPlan(typeFrom, typeTo, flexer).fields {
+		fromFieldName := field.fromFieldName
+
+		switch field.action {
+		case fieldActionIgnoredSource:
+			tflog.SubsystemTrace(ctx, subsystemName, "Skipping ignored source field", map[string]any{
+				logAttrKeySourceFieldname: fromFieldName,
+			})
+			continue
+
+		case fieldActionMapBlockKey:
+			tflog.SubsystemTrace(ctx, subsystemName, "Skipping map block key", map[string]any{
+				logAttrKeySourceFieldname: mapBlockKeyFieldName,
+			})
+			continue
 
-		toField, ok := findFieldFuzzy(ctx, fromFieldName, typeFrom, typeTo, flexer)
-		if !ok {
+		case fieldActionNoMatch:
 			// Corresponding field not found in to.
 			tflog.SubsystemDebug(ctx, subsystemName, "No corresponding field", map[string]any{
 				logAttrKeySourceFieldname: fromFieldName,
 			})
 			continue
 		}
-		toFieldName := toField.Name
-		toFieldVal := valTo.FieldByIndex(toField.Index)
+
+		toFieldName := field.toFieldName
+		toFieldVal := valTo.FieldByIndex(field.toIndex)
 		if !toFieldVal.CanSet() {
 			// Corresponding field value can't be changed.
 			tflog.SubsystemDebug(ctx, subsystemName, "Field cannot be set", map[string]any{
@@ -1190,11 +1207,7 @@ func expandStruct(ctx context.Context, sourcePath path.Path, from any, targetPat
 			logAttrKeyTargetFieldname: toFieldName,
 		})
 
-		opts := fieldOpts{
-			legacy: fromFieldOpts.Legacy(),
-		}
-
-		diags.Append(flexer.convert(ctx, sourcePath.AtName(fromFieldName), valFrom.FieldByIndex(fromField.Index), targetPath.AtName(toFieldName), toFieldVal, opts)...)
+		diags.Append(convertStructField(ctx, sourcePath, valFrom, targetPath, toFieldVal, field, flexer)...)
 		if diags.HasError() {
 			break
 		}
@@ -1203,39 +1216,6 @@ func expandStruct(ctx context.Context, sourcePath path.Path, from any, targetPat
 	return diags
 }
 
-func expandSourceFields(ctx context.Context, typ reflect.Type, opts AutoFlexOptions) iter.Seq[reflect.StructField] {
-	return func(yield func(reflect.StructField) bool) {
-		for field := range tfreflect.ExportedStructFields(typ) {
-			fieldName := field.Name
-			if opts.isIgnoredField(fieldName) {
-				tflog.SubsystemTrace(ctx, subsystemName, "Skipping ignored source field", map[string]any{
-					logAttrKeySourceFieldname: fieldName,
-				})
-				continue
-			}
-
-			fromNameOverride, _ := autoflexTags(field)
-			if fromNameOverride == "-" {
-				tflog.SubsystemTrace(ctx, subsystemName, "Skipping ignored source field", map[string]any{
-					logAttrKeySourceFieldname: fieldName,
-				})
-				continue
-			}
-
-			if fieldName == mapBlockKeyFieldName {
-				tflog.SubsystemTrace(ctx, subsystemName, "Skipping map block key", map[string]any{
-					logAttrKeySourceFieldname: mapBlockKeyFieldName,
-				})
-				continue
-			}
-
-			if !yield(field) {
-				return
-			}
-		}
-	}
-}
-
 // mapBlockKey takes a struct and extracts the value of the `key`
 func mapBlockKey(ctx context.Context, from any) (reflect.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
@@ -153,6 +153,12 @@ func autoflexTags(field reflect.StructField) (string, tagOptions) {
 	return parseTag(field.Tag.Get("autoflex"))
 }
 
+// autoflexFieldName returns the name of the corresponding field specified using `autoflex:"name=..."`.
+func autoflexFieldName(field reflect.StructField) (string, bool) {
+	name, _ := autoflexTags(field)
+	return strings.CutPrefix(name, "name=")
+}
+
 type fieldOpts struct {
 	legacy    bool
 	omitempty bool
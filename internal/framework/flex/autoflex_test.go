@@ -702,3 +702,41 @@ type tfSetOfStringEnum struct {
 type awsSliceOfStringEnum struct {
 	Field1 []testEnum
 }
+
+type tfFieldNameTag struct {
+	Field1 types.String `tfsdk:"field1" autoflex:"name=OtherField"`
+}
+
+type awsFieldNameTag struct {
+	Field1     *string
+	OtherField *string
+}
+
+type awsUnion interface {
+	isAWSUnion()
+}
+
+type awsUnionMemberString struct {
+	Value string
+}
+
+func (*awsUnionMemberString) isAWSUnion() {}
+
+type awsUnionMemberObject struct {
+	Value awsSingleStringValue
+}
+
+func (*awsUnionMemberObject) isAWSUnion() {}
+
+type awsUnionValue struct {
+	Field1 awsUnion
+}
+
+type tfUnion struct {
+	Name   types.String                                         `tfsdk:"name"`
+	Object fwtypes.ListNestedObjectValueOf[tfSingleStringField] `tfsdk:"object"`
+}
+
+type tfUnionValue struct {
+	Field1 fwtypes.ListNestedObjectValueOf[tfUnion] `tfsdk:"field1"`
+}
@@ -3,7 +3,13 @@
 
 package flex
 
-import "slices"
+import (
+	"context"
+	"reflect"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
 
 var (
 	DefaultIgnoredFieldNames = []string{
@@ -11,6 +17,10 @@ var (
 	}
 )
 
+// FieldConverterFunc converts the value of a source field, `from`, to the target field.
+// `to` is a pointer to the target field.
+type FieldConverterFunc func(ctx context.Context, from, to any) diag.Diagnostics
+
 // AutoFlexOptionsFunc is a type alias for an autoFlexer functional option.
 type AutoFlexOptionsFunc func(*AutoFlexOptions)
 
@@ -27,6 +37,24 @@ type AutoFlexOptions struct {
 	// ignoredFieldNames stores names which expanders and flatteners will
 	// not read from or write to
 	ignoredFieldNames []string
+
+	// fieldConverters stores custom converters, keyed by source struct type
+	// and field name, which expanders and flatteners use instead of the
+	// default conversion
+	fieldConverters map[fieldConverterKey]FieldConverterFunc
+
+	// unions stores the unions declared for the conversion, keyed by union
+	// interface type
+	unions map[reflect.Type]*union
+
+	// unionMembers stores the members of the unions declared for the
+	// conversion, keyed by member type
+	unionMembers map[reflect.Type]unionMember
+}
+
+type fieldConverterKey struct {
+	fieldName string
+	typ       reflect.Type
 }
 
 // WithFieldNamePrefix specifies a prefix to be accounted for when
@@ -77,6 +105,32 @@ func WithNoIgnoredFieldNames() AutoFlexOptionsFunc {
 	}
 }
 
+// WithFieldConverter specifies a custom converter for the field named s
+// of source struct type `T`
+//
+// Use this option for fields whose conversion AutoFlex does not support.
+// Fields with the same name in other struct types are converted as usual.
+func WithFieldConverter[T any](s string, f FieldConverterFunc) AutoFlexOptionsFunc {
+	typ := reflect.TypeFor[T]()
+	if typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	return func(o *AutoFlexOptions) {
+		if o.fieldConverters == nil {
+			o.fieldConverters = make(map[fieldConverterKey]FieldConverterFunc)
+		}
+		o.fieldConverters[fieldConverterKey{fieldName: s, typ: typ}] = f
+	}
+}
+
+// fieldConverter returns the custom converter for the field named s of
+// source struct type typ, if any
+func (o *AutoFlexOptions) fieldConverter(typ reflect.Type, s string) (FieldConverterFunc, bool) {
+	f, ok := o.fieldConverters[fieldConverterKey{fieldName: s, typ: typ}]
+	return f, ok
+}
+
 // isIgnoredField returns true if s is in the list of ignored field names
 func (o *AutoFlexOptions) isIgnoredField(s string) bool {
 	return slices.Contains(o.ignoredFieldNames, s)
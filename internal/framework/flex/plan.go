@@ -0,0 +1,231 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	tfreflect "github.com/hashicorp/terraform-provider-aws/internal/reflect"
+)
+
+// A structPlan is the compiled conversion plan for a pair of struct types.
+// Field matching depends only on the source and target types and the AutoFlex options,
+// so a plan is compiled once and then reused for every conversion between the types.
+type structPlan struct {
+	fields []fieldPlan
+}
+
+// fieldAction is the action taken for a source field.
+type fieldAction int
+
+const (
+	fieldActionConvert         fieldAction = iota // Convert the source field to the target field.
+	fieldActionIgnoredSource                      // Source field is ignored.
+	fieldActionMapBlockKey                        // Source field is a map block key.
+	fieldActionNoMatch                            // No corresponding target field.
+	fieldActionIgnoredTarget                      // Target field is ignored.
+	fieldActionNoFlattenTarget                    // Target field is not flattened.
+)
+
+// fieldPlan is the plan for a single source field.
+type fieldPlan struct {
+	action        fieldAction
+	fromFieldName string
+	fromIndex     []int
+	toFieldName   string
+	toIndex       []int
+	opts          fieldOpts
+}
+
+type direction int
+
+const (
+	directionExpand direction = iota
+	directionFlatten
+)
+
+// structPlanKey uniquely identifies a structPlan.
+type structPlanKey struct {
+	direction direction
+	typeFrom  reflect.Type
+	typeTo    reflect.Type
+	options   string
+}
+
+var (
+	// structPlans caches compiled plans. Keys are structPlanKey, values are *structPlan.
+	structPlans sync.Map
+)
+
+// cacheKey returns a comparable representation of the options for use in a structPlanKey.
+func (o *AutoFlexOptions) cacheKey() string {
+	return strings.Join(append([]string{o.fieldNamePrefix, o.fieldNameSuffix}, o.ignoredFieldNames...), "\x00")
+}
+
+// expandStructPlan returns the, possibly cached, plan for expanding from `typeFrom` to `typeTo`.
+func expandStructPlan(typeFrom, typeTo reflect.Type, flexer autoFlexer) *structPlan {
+	return cachedStructPlan(directionExpand, typeFrom, typeTo, flexer, compileExpandStructPlan)
+}
+
+// flattenStructPlan returns the, possibly cached, plan for flattening from `typeFrom` to `typeTo`.
+func flattenStructPlan(typeFrom, typeTo reflect.Type, flexer autoFlexer) *structPlan {
+	return cachedStructPlan(directionFlatten, typeFrom, typeTo, flexer, compileFlattenStructPlan)
+}
+
+func cachedStructPlan(direction direction, typeFrom, typeTo reflect.Type, flexer autoFlexer, compile func(reflect.Type, reflect.Type, autoFlexer) *structPlan) *structPlan {
+	opts := flexer.getOptions()
+	key := structPlanKey{
+		direction: direction,
+		typeFrom:  typeFrom,
+		typeTo:    typeTo,
+		options:   opts.cacheKey(),
+	}
+
+	if v, ok := structPlans.Load(key); ok {
+		return v.(*structPlan)
+	}
+
+	// Concurrent callers may compile the same plan; plans are deterministic so the first one stored wins.
+	v, _ := structPlans.LoadOrStore(key, compile(typeFrom, typeTo, flexer))
+
+	return v.(*structPlan)
+}
+
+func compileExpandStructPlan(typeFrom, typeTo reflect.Type, flexer autoFlexer) *structPlan {
+	ctx := context.Background()
+	opts := flexer.getOptions()
+	plan := &structPlan{}
+
+	for fromField := range tfreflect.ExportedStructFields(typeFrom) {
+		fromFieldName := fromField.Name
+		fromNameOverride, fromFieldOpts := autoflexTags(fromField)
+		field := fieldPlan{
+			fromFieldName: fromFieldName,
+			fromIndex:     fromField.Index,
+		}
+
+		switch {
+		case opts.isIgnoredField(fromFieldName), fromNameOverride == "-":
+			field.action = fieldActionIgnoredSource
+		case fromFieldName == mapBlockKeyFieldName:
+			field.action = fieldActionMapBlockKey
+		default:
+			var toField reflect.StructField
+			var ok bool
+			if name, renamed := autoflexFieldName(fromField); renamed {
+				toField, ok = typeTo.FieldByName(name)
+			} else {
+				toField, ok = findFieldFuzzy(ctx, fromFieldName, typeFrom, typeTo, flexer)
+			}
+			if !ok {
+				field.action = fieldActionNoMatch
+				break
+			}
+
+			field.action = fieldActionConvert
+			field.toFieldName = toField.Name
+			field.toIndex = toField.Index
+			field.opts = fieldOpts{
+				legacy: fromFieldOpts.Legacy(),
+			}
+		}
+
+		plan.fields = append(plan.fields, field)
+	}
+
+	return plan
+}
+
+func compileFlattenStructPlan(typeFrom, typeTo reflect.Type, flexer autoFlexer) *structPlan {
+	ctx := context.Background()
+	opts := flexer.getOptions()
+	plan := &structPlan{}
+
+	for fromField := range tfreflect.ExportedStructFields(typeFrom) {
+		fromFieldName := fromField.Name
+		field := fieldPlan{
+			fromFieldName: fromFieldName,
+			fromIndex:     fromField.Index,
+		}
+
+		if opts.isIgnoredField(fromFieldName) {
+			field.action = fieldActionIgnoredSource
+			plan.fields = append(plan.fields, field)
+			continue
+		}
+
+		toField, ok := renamedTargetField(fromFieldName, typeTo)
+		if !ok {
+			toField, ok = findFieldFuzzy(ctx, fromFieldName, typeFrom, typeTo, flexer)
+			// A renamed target field only matches the source field it names.
+			if _, renamed := autoflexFieldName(toField); ok && renamed {
+				ok = false
+			}
+		}
+		if !ok {
+			field.action = fieldActionNoMatch
+			plan.fields = append(plan.fields, field)
+			continue
+		}
+
+		field.toFieldName = toField.Name
+		field.toIndex = toField.Index
+
+		toNameOverride, toOpts := autoflexTags(toField)
+		switch {
+		case toNameOverride == "-":
+			field.action = fieldActionIgnoredTarget
+		case toOpts.NoFlatten():
+			field.action = fieldActionNoFlattenTarget
+		default:
+			field.action = fieldActionConvert
+			field.opts = fieldOpts{
+				legacy:    toOpts.Legacy(),
+				omitempty: toOpts.OmitEmpty(),
+			}
+		}
+
+		plan.fields = append(plan.fields, field)
+	}
+
+	return plan
+}
+
+// convertStructField converts the source field in `valFrom` to the target field, `toFieldVal`, as planned.
+func convertStructField(ctx context.Context, sourcePath path.Path, valFrom reflect.Value, targetPath path.Path, toFieldVal reflect.Value, field fieldPlan, flexer autoFlexer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	sourcePath, targetPath = sourcePath.AtName(field.fromFieldName), targetPath.AtName(field.toFieldName)
+	fromFieldVal := valFrom.FieldByIndex(field.fromIndex)
+
+	opts := flexer.getOptions()
+	if f, ok := opts.fieldConverter(valFrom.Type(), field.fromFieldName); ok {
+		ctx = tflog.SubsystemSetField(ctx, subsystemName, logAttrKeySourcePath, sourcePath.String())
+		ctx = tflog.SubsystemSetField(ctx, subsystemName, logAttrKeyTargetPath, targetPath.String())
+		tflog.SubsystemInfo(ctx, subsystemName, "Using field converter")
+
+		diags.Append(f(ctx, fromFieldVal.Interface(), toFieldVal.Addr().Interface())...)
+		return diags
+	}
+
+	diags.Append(flexer.convert(ctx, sourcePath, fromFieldVal, targetPath, toFieldVal, field.opts)...)
+	return diags
+}
+
+// renamedTargetField returns the field in `typeTo` renamed, using `autoflex:"name=..."`, to `fieldNameFrom`.
+func renamedTargetField(fieldNameFrom string, typeTo reflect.Type) (reflect.StructField, bool) {
+	for field := range tfreflect.ExportedStructFields(typeTo) {
+		if name, ok := autoflexFieldName(field); ok && name == fieldNameFrom {
+			return field, true
+		}
+	}
+
+	return reflect.StructField{}, false
+}
@@ -0,0 +1,203 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+func TestStructPlanCached(t *testing.T) { //nolint:paralleltest // uses the shared plan cache
+	typeFrom, typeTo := reflect.TypeFor[tfFieldNamePrefix](), reflect.TypeFor[awsFieldNamePrefix]()
+
+	noPrefix := newAutoExpander(nil)
+	withPrefix := newAutoExpander([]AutoFlexOptionsFunc{WithFieldNamePrefix("Intent")})
+
+	plan := expandStructPlan(typeFrom, typeTo, withPrefix)
+	if got, want := plan.fields[0].action, fieldActionConvert; got != want {
+		t.Fatalf("action = %v, want %v", got, want)
+	}
+	if got, want := plan.fields[0].toFieldName, "IntentName"; got != want {
+		t.Errorf("toFieldName = %q, want %q", got, want)
+	}
+
+	if got := expandStructPlan(typeFrom, typeTo, withPrefix); got != plan {
+		t.Error("expected cached plan")
+	}
+
+	// Options are part of the cache key.
+	if got, want := expandStructPlan(typeFrom, typeTo, noPrefix).fields[0].action, fieldActionNoMatch; got != want {
+		t.Errorf("action = %v, want %v", got, want)
+	}
+
+	// Direction is part of the cache key.
+	if got := flattenStructPlan(typeFrom, typeTo, newAutoFlattener([]AutoFlexOptionsFunc{WithFieldNamePrefix("Intent")})); got == plan {
+		t.Error("expected distinct flatten plan")
+	}
+}
+
+func TestFieldNameTag(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var expanded awsFieldNameTag
+	if diags := Expand(ctx, &tfFieldNameTag{Field1: types.StringValue("a")}, &expanded); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if diff := cmp.Diff(expanded, awsFieldNameTag{OtherField: aws.String("a")}); diff != "" {
+		t.Errorf("unexpected expand diff (+wanted, -got): %s", diff)
+	}
+
+	var flattened tfFieldNameTag
+	if diags := Flatten(ctx, &awsFieldNameTag{Field1: aws.String("b"), OtherField: aws.String("c")}, &flattened); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if diff := cmp.Diff(flattened, tfFieldNameTag{Field1: types.StringValue("c")}); diff != "" {
+		t.Errorf("unexpected flatten diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestFieldConverter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	converter := WithFieldConverter[tfSingleStringField]("Field1", func(ctx context.Context, from, to any) diag.Diagnostics {
+		*to.(*string) = strings.ToUpper(from.(types.String).ValueString())
+		return nil
+	})
+
+	var got awsSingleStringValue
+	if diags := Expand(ctx, &tfSingleStringField{Field1: types.StringValue("a")}, &got, converter); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if diff := cmp.Diff(got, awsSingleStringValue{Field1: "A"}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	// Fields with the same name in other struct types are converted as usual.
+	var gotNested awsNestedObjectPointer
+	source := &tfListOfNestedObject{
+		Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfSingleStringField{Field1: types.StringValue("b")}),
+	}
+	if diags := Expand(ctx, source, &gotNested, converter); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if diff := cmp.Diff(gotNested, awsNestedObjectPointer{Field1: &awsSingleStringValue{Field1: "B"}}); diff != "" {
+		t.Errorf("unexpected nested diff (+wanted, -got): %s", diff)
+	}
+}
+
+func BenchmarkExpandComplexValue(b *testing.B) {
+	ctx := context.Background()
+	source := &tfComplexValue{
+		Field1: types.StringValue("m"),
+		Field2: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfListOfNestedObject{
+			Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfSingleStringField{
+				Field1: types.StringValue("n"),
+			}),
+		}),
+		Field3: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"X": types.StringValue("x"),
+			"Y": types.StringValue("y"),
+		}),
+		Field4: fwtypes.NewSetNestedObjectValueOfValueSliceMust(ctx, []tfSingleInt64Field{
+			{Field1: types.Int64Value(100)},
+			{Field1: types.Int64Value(2000)},
+			{Field1: types.Int64Value(30000)},
+		}),
+	}
+
+	benchmarkStructPlans(b, func() {
+		if diags := Expand(ctx, source, &awsComplexValue{}); diags.HasError() {
+			b.Fatal(diags)
+		}
+	})
+}
+
+func BenchmarkFlattenComplexValue(b *testing.B) {
+	ctx := context.Background()
+	source := &awsComplexValue{
+		Field1: "m",
+		Field2: &awsNestedObjectPointer{Field1: &awsSingleStringValue{Field1: "n"}},
+		Field3: aws.StringMap(map[string]string{"X": "x", "Y": "y"}),
+		Field4: []awsSingleInt64Value{{Field1: 100}, {Field1: 2000}, {Field1: 30000}},
+	}
+
+	benchmarkStructPlans(b, func() {
+		if diags := Flatten(ctx, source, &tfComplexValue{}); diags.HasError() {
+			b.Fatal(diags)
+		}
+	})
+}
+
+func BenchmarkExpandSpecialPluralization(b *testing.B) {
+	ctx := context.Background()
+	source := &tfSpecialPluralization{
+		City:      types.ListValueMust(types.StringType, []attr.Value{types.StringValue("paris")}),
+		Coach:     types.ListValueMust(types.StringType, []attr.Value{types.StringValue("bob")}),
+		Tomato:    types.ListValueMust(types.StringType, []attr.Value{types.StringValue("brandywine")}),
+		Vertex:    types.ListValueMust(types.StringType, []attr.Value{types.StringValue("ab")}),
+		Criterion: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("votes")}),
+		Datum:     types.ListValueMust(types.StringType, []attr.Value{types.StringValue("d1282f78-fa99-5d9d-bd51-e6f0173eb74a")}),
+		Hive:      types.ListValueMust(types.StringType, []attr.Value{types.StringValue("Cegieme")}),
+	}
+
+	benchmarkStructPlans(b, func() {
+		if diags := Expand(ctx, source, &awsSpecialPluralization{}); diags.HasError() {
+			b.Fatal(diags)
+		}
+	})
+}
+
+func BenchmarkFlattenAllThePrimitiveFields(b *testing.B) {
+	ctx := context.Background()
+	source := &awsAllThePrimitiveFields{
+		Field1:  "field1",
+		Field2:  aws.String("field2"),
+		Field3:  3,
+		Field4:  aws.Int32(-4),
+		Field5:  5,
+		Field6:  aws.Int64(-6),
+		Field7:  7.7,
+		Field8:  aws.Float32(-8.8),
+		Field9:  9.99,
+		Field10: aws.Float64(-10.101),
+		Field11: true,
+		Field12: aws.Bool(false),
+	}
+
+	benchmarkStructPlans(b, func() {
+		if diags := Flatten(ctx, source, &tfAllThePrimitiveFields{}); diags.HasError() {
+			b.Fatal(diags)
+		}
+	})
+}
+
+// benchmarkStructPlans runs f with and without reuse of compiled struct plans.
+func benchmarkStructPlans(b *testing.B, f func()) {
+	b.Run("cached", func(b *testing.B) {
+		f()
+		b.ResetTimer()
+		for n := 0; n < b.N; n++ {
+			f()
+		}
+	})
+
+	b.Run("uncached", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			structPlans.Clear()
+			f()
+		}
+	})
+}
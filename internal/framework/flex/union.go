@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+// unionMemberValueFieldName is the name of the field holding a union member's value.
+const unionMemberValueFieldName = "Value"
+
+// UnionMember associates a field of a Terraform object with a member of an AWS SDK for Go v2 union.
+type UnionMember struct {
+	fieldName  string
+	memberType reflect.Type
+}
+
+// NewUnionMember returns a UnionMember for the Terraform object field named `fieldName`
+// and the union member type `T`, e.g. `*awstypes.RuleMemberExact`.
+func NewUnionMember[T any](fieldName string) UnionMember {
+	return UnionMember{
+		fieldName:  fieldName,
+		memberType: reflect.TypeFor[T](),
+	}
+}
+
+type union struct {
+	members []UnionMember
+}
+
+// isModel returns whether `typ` is a Terraform object type with a field for each of the union's members.
+func (u *union) isModel(typ reflect.Type) bool {
+	if typ.Kind() != reflect.Struct {
+		return false
+	}
+
+	for _, member := range u.members {
+		if _, ok := typ.FieldByName(member.fieldName); !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+type unionMember struct {
+	UnionMember
+	union *union
+}
+
+// WithUnion declares the members of the AWS SDK for Go v2 union interface type `T` for a single conversion.
+//
+// A Terraform object with a field for each member, whose fields are mutually exclusive nested blocks or attributes,
+// is expanded to the member corresponding to the field that is set.
+// A union value is flattened to such an object by setting the field corresponding to its member; all other fields are null.
+// Other objects, and objects implementing Expander, TypedExpander or Flattener, are converted as usual.
+func WithUnion[T any](members ...UnionMember) AutoFlexOptionsFunc {
+	typ := reflect.TypeFor[T]()
+	if typ.Kind() != reflect.Interface {
+		panic(fmt.Sprintf("union type %q is not an interface", fullTypeName(typ)))
+	}
+
+	for _, member := range members {
+		if !member.memberType.Implements(typ) {
+			panic(fmt.Sprintf("union member %q does not implement %q", fullTypeName(member.memberType), fullTypeName(typ)))
+		}
+		if member.memberType.Kind() != reflect.Pointer || member.memberType.Elem().Kind() != reflect.Struct {
+			panic(fmt.Sprintf("union member %q is not a pointer to struct", fullTypeName(member.memberType)))
+		}
+		if _, ok := member.memberType.Elem().FieldByName(unionMemberValueFieldName); !ok {
+			panic(fmt.Sprintf("union member %q has no %s field", fullTypeName(member.memberType), unionMemberValueFieldName))
+		}
+	}
+
+	u := &union{
+		members: members,
+	}
+
+	return func(o *AutoFlexOptions) {
+		if o.unions == nil {
+			o.unions = make(map[reflect.Type]*union)
+		}
+		if o.unionMembers == nil {
+			o.unionMembers = make(map[reflect.Type]unionMember)
+		}
+
+		o.unions[typ] = u
+		for _, member := range members {
+			o.unionMembers[member.memberType] = unionMember{
+				UnionMember: member,
+				union:       u,
+			}
+		}
+	}
+}
+
+// union returns the union declared for the union interface type typ, if any
+func (o *AutoFlexOptions) union(typ reflect.Type) (*union, bool) {
+	u, ok := o.unions[typ]
+	return u, ok
+}
+
+// unionMember returns the union member declared for the member type typ, if any
+func (o *AutoFlexOptions) unionMember(typ reflect.Type) (unionMember, bool) {
+	member, ok := o.unionMembers[typ]
+	return member, ok
+}
+
+// findUnionMember returns the union member declared for the member type typ,
+// if the Terraform object type typeTo is a model of its union.
+func findUnionMember(typ, typeTo reflect.Type, flexer autoFlexer) (UnionMember, bool) {
+	opts := flexer.getOptions()
+	member, ok := opts.unionMember(typ)
+	if !ok || !member.union.isModel(typeTo) {
+		return UnionMember{}, false
+	}
+
+	return member.UnionMember, true
+}
+
+// expandUnion expands the Terraform object `valFrom` to the union interface value `valTo`.
+func expandUnion(ctx context.Context, sourcePath path.Path, valFrom reflect.Value, targetPath path.Path, valTo reflect.Value, u *union, flexer autoFlexer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.SubsystemInfo(ctx, subsystemName, "Target is a union")
+
+	var set []UnionMember
+	for _, member := range u.members {
+		fieldVal := valFrom.FieldByName(member.fieldName)
+		if !fieldVal.IsValid() {
+			diags.AddError("AutoFlEx", fmt.Sprintf("union member field %q not found in %q", member.fieldName, fullTypeName(valFrom.Type())))
+			return diags
+		}
+
+		if isUnionMemberSet(ctx, fieldVal) {
+			set = append(set, member)
+		}
+	}
+
+	switch len(set) {
+	case 0:
+		tflog.SubsystemTrace(ctx, subsystemName, "No union member set")
+		return diags
+
+	case 1:
+
+	default:
+		diags.AddAttributeError(sourcePath, "Invalid union", fmt.Sprintf("only one of %q and %q can be set", set[0].fieldName, set[1].fieldName))
+		return diags
+	}
+
+	member := set[0]
+	to := reflect.New(member.memberType.Elem())
+	diags.Append(flexer.convert(ctx, sourcePath.AtName(member.fieldName), valFrom.FieldByName(member.fieldName), targetPath, to.Elem().FieldByName(unionMemberValueFieldName), fieldOpts{})...)
+	if diags.HasError() {
+		return diags
+	}
+
+	valTo.Set(to)
+
+	return diags
+}
+
+// isUnionMemberSet returns whether the Terraform value of a union member field is set.
+func isUnionMemberSet(ctx context.Context, fieldVal reflect.Value) bool {
+	v, ok := fieldVal.Interface().(attr.Value)
+	if !ok || v.IsNull() || v.IsUnknown() {
+		return false
+	}
+
+	if v, ok := v.(fwtypes.NestedObjectCollectionValue); ok {
+		objects, d := v.ToObjectSlice(ctx)
+		if d.HasError() {
+			return false
+		}
+		return reflect.ValueOf(objects).Len() > 0
+	}
+
+	return true
+}
+
+// flattenUnion flattens the union member `valFrom` to the Terraform object `valTo`.
+func flattenUnion(ctx context.Context, sourcePath path.Path, valFrom reflect.Value, targetPath path.Path, valTo reflect.Value, member UnionMember, flexer autoFlexer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.SubsystemInfo(ctx, subsystemName, "Source is a union member")
+
+	// All fields other than the member's are null.
+	diags.Append(flattenPrePopulate(ctx, valTo)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	toFieldVal := valTo.FieldByName(member.fieldName)
+	if !toFieldVal.IsValid() {
+		diags.AddError("AutoFlEx", fmt.Sprintf("union member field %q not found in %q", member.fieldName, fullTypeName(valTo.Type())))
+		return diags
+	}
+
+	diags.Append(flexer.convert(ctx, sourcePath, valFrom.FieldByName(unionMemberValueFieldName), targetPath.AtName(member.fieldName), toFieldVal, fieldOpts{})...)
+
+	return diags
+}
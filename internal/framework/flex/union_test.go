@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+func withTestUnion() AutoFlexOptionsFunc {
+	return WithUnion[awsUnion](
+		NewUnionMember[*awsUnionMemberString]("Name"),
+		NewUnionMember[*awsUnionMemberObject]("Object"),
+	)
+}
+
+func TestExpandUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		source      *tfUnionValue
+		want        *awsUnionValue
+		expectError bool
+	}{
+		"string member": {
+			source: &tfUnionValue{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnion{
+					Name:   types.StringValue("a"),
+					Object: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+				}),
+			},
+			want: &awsUnionValue{
+				Field1: &awsUnionMemberString{Value: "a"},
+			},
+		},
+		"object member": {
+			source: &tfUnionValue{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnion{
+					Name: types.StringNull(),
+					Object: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfSingleStringField{
+						Field1: types.StringValue("b"),
+					}),
+				}),
+			},
+			want: &awsUnionValue{
+				Field1: &awsUnionMemberObject{Value: awsSingleStringValue{Field1: "b"}},
+			},
+		},
+		"no member": {
+			source: &tfUnionValue{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnion{
+					Name:   types.StringNull(),
+					Object: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+				}),
+			},
+			want: &awsUnionValue{},
+		},
+		"multiple members": {
+			source: &tfUnionValue{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnion{
+					Name: types.StringValue("a"),
+					Object: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfSingleStringField{
+						Field1: types.StringValue("b"),
+					}),
+				}),
+			},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got awsUnionValue
+			diags := Expand(ctx, testCase.source, &got, withTestUnion())
+
+			if got, want := diags.HasError(), testCase.expectError; got != want {
+				t.Fatalf("HasError = %t, want %t: %v", got, want, diags)
+			}
+			if testCase.expectError {
+				return
+			}
+
+			if diff := cmp.Diff(&got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		source *awsUnionValue
+		want   *tfUnionValue
+	}{
+		"string member": {
+			source: &awsUnionValue{
+				Field1: &awsUnionMemberString{Value: "a"},
+			},
+			want: &tfUnionValue{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnion{
+					Name:   types.StringValue("a"),
+					Object: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+				}),
+			},
+		},
+		"object member": {
+			source: &awsUnionValue{
+				Field1: &awsUnionMemberObject{Value: awsSingleStringValue{Field1: "b"}},
+			},
+			want: &tfUnionValue{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnion{
+					Name: types.StringNull(),
+					Object: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfSingleStringField{
+						Field1: types.StringValue("b"),
+					}),
+				}),
+			},
+		},
+		"nil": {
+			source: &awsUnionValue{},
+			want: &tfUnionValue{
+				Field1: fwtypes.NewListNestedObjectValueOfNull[tfUnion](ctx),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got tfUnionValue
+			if diags := Flatten(ctx, testCase.source, &got, withTestUnion()); diags.HasError() {
+				t.Fatal(diags)
+			}
+
+			if diff := cmp.Diff(&got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenUnionNotModel(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// A union member is only flattened as a union to a model of the union.
+	var got tfSingleStringField
+	if diags := Flatten(ctx, &awsUnionMemberString{Value: "a"}, &got, withTestUnion()); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if diff := cmp.Diff(got, tfSingleStringField{}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestUnionNotDeclared(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Unions are only converted if declared for the conversion.
+	var got tfUnionValue
+	if diags := Flatten(ctx, &awsUnionValue{Field1: &awsUnionMemberString{Value: "a"}}, &got); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if diff := cmp.Diff(got, tfUnionValue{Field1: fwtypes.NewListNestedObjectValueOfNull[tfUnion](ctx)}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
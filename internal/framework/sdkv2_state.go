@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// SDKv2StateUpgradeFunc is a Plugin SDK V2 state upgrade function, schema.StateUpgradeFunc.
+type SDKv2StateUpgradeFunc func(context.Context, map[string]any, any) (map[string]any, error)
+
+// UpgradeStateFromSDKv2 returns a state upgrader for a resource migrated from Plugin SDK V2 to Plugin Framework.
+// The prior state is passed through the Plugin SDK V2 state upgrade functions, in order, and is then converted to the Plugin Framework schema `s`.
+// Plugin SDK V2 cannot distinguish between null and zero values, so empty strings and collections stored for optional, non-computed attributes are
+// converted to null. Otherwise, the differences between state and configuration could cause resources to be replaced.
+func UpgradeStateFromSDKv2(s schema.Schema, meta WithMeta, upgraders ...SDKv2StateUpgradeFunc) resource.StateUpgrader {
+	return resource.StateUpgrader{
+		StateUpgrader: func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+			if request.RawState == nil {
+				response.Diagnostics.AddError("Upgrading Plugin SDK V2 state", "missing raw state")
+				return
+			}
+
+			// Decode numbers as json.Number so that large integers don't lose precision as float64.
+			var state map[string]any
+			decoder := json.NewDecoder(bytes.NewReader(request.RawState.JSON))
+			decoder.UseNumber()
+			if err := decoder.Decode(&state); err != nil {
+				response.Diagnostics.AddError("Upgrading Plugin SDK V2 state", fmt.Sprintf("decoding raw state: %s", err))
+				return
+			}
+
+			for _, f := range upgraders {
+				var err error
+				state, err = f(ctx, state, meta.Meta())
+				if err != nil {
+					response.Diagnostics.AddError("Upgrading Plugin SDK V2 state", err.Error())
+					return
+				}
+			}
+
+			nullifySDKv2ZeroValues(s.Attributes, s.Blocks, state)
+
+			b, err := json.Marshal(state)
+			if err != nil {
+				response.Diagnostics.AddError("Upgrading Plugin SDK V2 state", fmt.Sprintf("encoding state: %s", err))
+				return
+			}
+
+			response.DynamicValue = &tfprotov6.DynamicValue{
+				JSON: b,
+			}
+		},
+	}
+}
+
+func nullifySDKv2ZeroValues(attributes map[string]schema.Attribute, blocks map[string]schema.Block, state map[string]any) {
+	for name, attribute := range attributes {
+		if !attribute.IsOptional() || attribute.IsComputed() {
+			continue
+		}
+
+		switch v := state[name].(type) {
+		case string:
+			if v == "" {
+				state[name] = nil
+			}
+		case []any:
+			if len(v) == 0 {
+				state[name] = nil
+			}
+		case map[string]any:
+			if len(v) == 0 {
+				state[name] = nil
+			}
+		}
+	}
+
+	// Blocks are never null in configuration, so only their nested attributes are converted.
+	for name, block := range blocks {
+		var nested schema.NestedBlockObject
+		switch block := block.(type) {
+		case schema.ListNestedBlock:
+			nested = block.NestedObject
+		case schema.SetNestedBlock:
+			nested = block.NestedObject
+		default:
+			continue
+		}
+
+		if v, ok := state[name].([]any); ok {
+			for _, v := range v {
+				if v, ok := v.(map[string]any); ok {
+					nullifySDKv2ZeroValues(nested.Attributes, nested.Blocks, v)
+				}
+			}
+		}
+	}
+}
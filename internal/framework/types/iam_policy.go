@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 var (
@@ -170,5 +171,15 @@ func (v IAMPolicy) ValidateAttribute(ctx context.Context, req xattr.ValidateAttr
 				"Path: "+req.Path.String()+"\n"+
 				"Value: "+v.ValueString(),
 		)
+		return
+	}
+
+	for _, issue := range verify.LintIAMPolicy(v.ValueString()) {
+		switch issue.Severity {
+		case verify.IAMPolicyIssueWarning:
+			resp.Diagnostics.AddAttributeWarning(req.Path, "Invalid IAM Policy Value", issue.String())
+		default:
+			resp.Diagnostics.AddAttributeError(req.Path, "Invalid IAM Policy Value", issue.String())
+		}
 	}
 }
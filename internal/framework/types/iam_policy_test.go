@@ -15,8 +15,9 @@ func TestIAMPolicyValidateAttribute(t *testing.T) {
 	t.Parallel()
 
 	type testCase struct {
-		val         fwtypes.IAMPolicy
-		expectError bool
+		val           fwtypes.IAMPolicy
+		expectError   bool
+		expectWarning bool
 	}
 	tests := map[string]testCase{
 		"unknown": {
@@ -32,6 +33,14 @@ func TestIAMPolicyValidateAttribute(t *testing.T) {
 			val:         fwtypes.IAMPolicyValue("not ok"),
 			expectError: true,
 		},
+		"invalid grammar": {
+			val:           fwtypes.IAMPolicyValue(`{"Statement": [{"Effect": "Allow", "Action": "s3:GetObject", "Resource": "*", "Condition": {"StringEqual": {"aws:username": "example"}}}]}`),
+			expectWarning: true,
+		},
+		"invalid structure": {
+			val:         fwtypes.IAMPolicyValue(`{"Statement": [{"Effect": "Allow", "Action": "s3:GetObject", "Resource": 1}]}`),
+			expectError: true,
+		},
 	}
 
 	for name, test := range tests {
@@ -47,6 +56,9 @@ func TestIAMPolicyValidateAttribute(t *testing.T) {
 			if resp.Diagnostics.HasError() != test.expectError {
 				t.Errorf("resp.Diagnostics.HasError() = %t, want = %t", resp.Diagnostics.HasError(), test.expectError)
 			}
+			if got, want := resp.Diagnostics.WarningsCount() > 0, test.expectWarning; got != want {
+				t.Errorf("resp.Diagnostics has warnings = %t, want = %t", got, want)
+			}
 		})
 	}
 }
@@ -41,6 +41,11 @@ func newListTypeOf[T attr.Value](ctx context.Context) listTypeOf[T] {
 	return listTypeOf[T]{basetypes.ListType{ElemType: newAttrTypeOf[T](ctx)}}
 }
 
+// NewListTypeOf returns the custom type for a list of T, for use as an attribute's CustomType.
+func NewListTypeOf[T attr.Value](ctx context.Context) basetypes.ListTypable {
+	return newListTypeOf[T](ctx)
+}
+
 func (t listTypeOf[T]) Equal(o attr.Type) bool {
 	other, ok := o.(listTypeOf[T])
 
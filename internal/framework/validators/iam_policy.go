@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// iamPolicyValidator validates that a string Attribute's value is a well-formed IAM policy document.
+type iamPolicyValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (validator iamPolicyValidator) Description(_ context.Context) string {
+	return "value must be a well-formed IAM policy document"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator iamPolicyValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// Validate performs the validation.
+func (validator iamPolicyValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	configValue := request.ConfigValue
+
+	if configValue.IsNull() || configValue.IsUnknown() {
+		return
+	}
+
+	for _, issue := range verify.LintIAMPolicy(configValue.ValueString()) {
+		switch issue.Severity {
+		case verify.IAMPolicyIssueWarning:
+			response.Diagnostics.AddAttributeWarning(request.Path, "Invalid IAM Policy", issue.String())
+		default:
+			response.Diagnostics.AddAttributeError(request.Path, "Invalid IAM Policy", issue.String())
+		}
+	}
+}
+
+// IAMPolicy returns a string validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which represents an IAM policy document that conforms to the IAM policy grammar.
+//
+// Values that are not valid JSON are skipped; combine with the JSON validator.
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func IAMPolicy() validator.String {
+	return iamPolicyValidator{}
+}
@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// WriteOnlyStringAttribute returns the schema for the write-only variant, `<attr>_wo`, of a top-level secret attribute.
+// The write-only value is only used when the `<attr>_wo_version` trigger attribute changes.
+func WriteOnlyStringAttribute(attr string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:  true,
+		Sensitive: true,
+		WriteOnly: true,
+		Validators: []validator.String{
+			stringvalidator.ConflictsWith(path.MatchRoot(attr)),
+			stringvalidator.AlsoRequires(path.MatchRoot(flex.WriteOnlyVersionAttrName(attr))),
+		},
+	}
+}
+
+// WriteOnlyVersionAttribute returns the schema for the `<attr>_wo_version` trigger attribute.
+func WriteOnlyVersionAttribute(attr string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Optional: true,
+		Validators: []validator.Int64{
+			int64validator.AlsoRequires(path.MatchRoot(flex.WriteOnlyAttrName(attr))),
+		},
+	}
+}
+
+// HasWriteOnlyChange returns true if the `<attr>_wo_version` trigger attribute's planned value differs from its state value,
+// meaning that the write-only value, read from configuration, should be sent to the API.
+func HasWriteOnlyChange(plan, state types.Int64) bool {
+	return !plan.Equal(state)
+}
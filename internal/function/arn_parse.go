@@ -5,6 +5,7 @@ package function
 
 import (
 	"context"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -20,6 +21,33 @@ var arnParseResultAttrTypes = map[string]attr.Type{
 	"resource":   types.StringType,
 }
 
+// ARNParts is a parsed ARN with its resource split into a resource type and ID.
+type ARNParts struct {
+	arn.ARN
+	// ResourceType is empty if the resource has no type, e.g. an S3 bucket name.
+	ResourceType string
+	ResourceID   string
+}
+
+// ParseARN parses an ARN into its constituent parts.
+// The resource type is the part of the resource before the first "/" or ":".
+func ParseARN(s string) (ARNParts, error) {
+	v, err := arn.Parse(s)
+	if err != nil {
+		return ARNParts{}, err
+	}
+
+	parts := ARNParts{
+		ARN:        v,
+		ResourceID: v.Resource,
+	}
+	if i := strings.IndexAny(v.Resource, "/:"); i > 0 {
+		parts.ResourceType, parts.ResourceID = v.Resource[:i], v.Resource[i+1:]
+	}
+
+	return parts, nil
+}
+
 var _ function.Function = arnParseFunction{}
 
 func NewARNParseFunction() function.Function {
@@ -56,7 +84,7 @@ func (f arnParseFunction) Run(ctx context.Context, req function.RunRequest, resp
 		return
 	}
 
-	parts, err := arn.Parse(arg)
+	parts, err := ParseARN(arg)
 	if err != nil {
 		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
 		return
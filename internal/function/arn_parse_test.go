@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/function"
 )
 
 func TestARNParseFunction_known(t *testing.T) {
@@ -58,3 +59,77 @@ output "test" {
 }
 `, arg)
 }
+
+func TestParseARN(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName             string
+		ARN                  string
+		ExpectedService      string
+		ExpectedResourceType string
+		ExpectedResourceID   string
+		ExpectError          bool
+	}{
+		{
+			TestName:    "invalid",
+			ARN:         "invalid",
+			ExpectError: true,
+		},
+		{
+			TestName:             "slash separator",
+			ARN:                  "arn:aws:ec2:us-west-2:123456789012:instance/i-1234567890abcdef0", //lintignore:AWSAT003,AWSAT005
+			ExpectedService:      "ec2",
+			ExpectedResourceType: "instance",
+			ExpectedResourceID:   "i-1234567890abcdef0",
+		},
+		{
+			TestName:             "colon separator",
+			ARN:                  "arn:aws:logs:us-west-2:123456789012:log-group:/aws/lambda/example", //lintignore:AWSAT003,AWSAT005
+			ExpectedService:      "logs",
+			ExpectedResourceType: "log-group",
+			ExpectedResourceID:   "/aws/lambda/example",
+		},
+		{
+			TestName:             "nested path",
+			ARN:                  "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/example/50dc6c495c0c9188", //lintignore:AWSAT003,AWSAT005
+			ExpectedService:      "elasticloadbalancing",
+			ExpectedResourceType: "loadbalancer",
+			ExpectedResourceID:   "app/example/50dc6c495c0c9188",
+		},
+		{
+			TestName:           "no resource type",
+			ARN:                "arn:aws:s3:::example", //lintignore:AWSAT005
+			ExpectedService:    "s3",
+			ExpectedResourceID: "example",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := function.ParseARN(testCase.ARN)
+
+			if testCase.ExpectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got.Service != testCase.ExpectedService {
+				t.Errorf("Service = %q, want %q", got.Service, testCase.ExpectedService)
+			}
+			if got.ResourceType != testCase.ExpectedResourceType {
+				t.Errorf("ResourceType = %q, want %q", got.ResourceType, testCase.ExpectedResourceType)
+			}
+			if got.ResourceID != testCase.ExpectedResourceID {
+				t.Errorf("ResourceID = %q, want %q", got.ResourceID, testCase.ExpectedResourceID)
+			}
+		})
+	}
+}
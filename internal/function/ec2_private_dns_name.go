@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var _ function.Function = ec2PrivateDNSNameFunction{}
+
+func NewEC2PrivateDNSNameFunction() function.Function {
+	return &ec2PrivateDNSNameFunction{}
+}
+
+type ec2PrivateDNSNameFunction struct{}
+
+func (f ec2PrivateDNSNameFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ec2_private_dns_name"
+}
+
+func (f ec2PrivateDNSNameFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "ec2_private_dns_name Function",
+		MarkdownDescription: "Returns the IP name based private DNS name that EC2 assigns to a private IPv4 address " +
+			"in the specified AWS Region.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "ip",
+				MarkdownDescription: "Private IPv4 address",
+			},
+			function.StringParameter{
+				Name:                "region",
+				MarkdownDescription: "AWS Region",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f ec2PrivateDNSNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var ip, region string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &ip, &region))
+	if resp.Error != nil {
+		return
+	}
+
+	if addr, err := netip.ParseAddr(ip); err != nil || !addr.Is4() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("%q is not a valid IPv4 address", ip)))
+	}
+	if _, errs := verify.ValidRegionName(region, names.AttrRegion); len(errs) > 0 {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, errs[0].Error()))
+	}
+	if resp.Error != nil {
+		return
+	}
+
+	// Provider-defined functions are called without a configured provider,
+	// so the Region is passed explicitly rather than taken from the provider configuration.
+	result := conns.EC2PrivateDNSNameForIP(region, ip)
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
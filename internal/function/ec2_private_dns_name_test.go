@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestEC2PrivateDNSNameFunction_valid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testEC2PrivateDNSNameFunctionConfig("10.0.1.23", "eu-west-1"), //lintignore:AWSAT003
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "ip-10-0-1-23.eu-west-1.compute.internal"), //lintignore:AWSAT003
+				),
+			},
+			{
+				Config: testEC2PrivateDNSNameFunctionConfig("10.0.1.23", "us-east-1"), //lintignore:AWSAT003
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "ip-10-0-1-23.ec2.internal"),
+				),
+			},
+		},
+	})
+}
+
+func TestEC2PrivateDNSNameFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testEC2PrivateDNSNameFunctionConfig("2001:db8::1", "eu-west-1"), //lintignore:AWSAT003
+				ExpectError: regexache.MustCompile(`not[\s\n]*a[\s\n]*valid[\s\n]*IPv4`),
+			},
+			{
+				Config:      testEC2PrivateDNSNameFunctionConfig("10.0.1.23", "invalid"),
+				ExpectError: regexache.MustCompile(`valid[\s\n]*AWS[\s\n]*Region`),
+			},
+		},
+	})
+}
+
+func testEC2PrivateDNSNameFunctionConfig(ip, region string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::ec2_private_dns_name(%[1]q, %[2]q)
+}
+`, ip, region)
+}
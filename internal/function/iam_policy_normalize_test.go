@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestIAMPolicyNormalizeFunction_valid(t *testing.T) {
+	t.Parallel()
+	arg := `{
+  "Statement": [{"Resource": "*", "Effect": "Allow", "Action": "s3:GetObject"}],
+  "Version": "2012-10-17"
+}`
+	expected := `{"Version":"2012-10-17","Statement":[{"Action":"s3:GetObject","Effect":"Allow","Resource":"*"}]}`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyNormalizeFunctionConfig(arg),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", expected),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyNormalizeFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testIAMPolicyNormalizeFunctionConfig("invalid"),
+				ExpectError: regexache.MustCompile(`invalid[\s\n]*JSON`),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyNormalizeFunction_agreesWithEquivalent(t *testing.T) {
+	t.Parallel()
+	policy1 := `{"Version":"2012-10-17","Statement":[{"Sid":"a","Effect":"Allow","Action":["s3:GetObject","s3:PutObject"],"Resource":"*"},{"Sid":"b","Effect":"Allow","Action":"sts:AssumeRole","Principal":{"AWS":"123456789012"}}]}`
+	policy2 := `{"Statement":[{"Sid":"b","Effect":"Allow","Action":["sts:AssumeRole"],"Principal":{"AWS":["arn:aws:iam::123456789012:root"]}},{"Sid":"a","Effect":"Allow","Action":["s3:PutObject","s3:GetObject"],"Resource":["*"]}],"Version":"2012-10-17"}` //lintignore:AWSAT005
+	policy3 := `{"Version":"2012-10-17","Statement":[{"Sid":"a","Effect":"Deny","Action":["s3:GetObject","s3:PutObject"],"Resource":"*"}]}`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyNormalizeFunctionConfig_agreesWithEquivalent(policy1, policy2, policy3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("equivalent", "true"),
+					resource.TestCheckOutput("normalized_equal", "true"),
+					resource.TestCheckOutput("not_equivalent", "false"),
+					resource.TestCheckOutput("not_normalized_equal", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testIAMPolicyNormalizeFunctionConfig(arg string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::iam_policy_normalize(%[1]q)
+}
+`, arg)
+}
+
+func testIAMPolicyNormalizeFunctionConfig_agreesWithEquivalent(policy1, policy2, policy3 string) string {
+	return fmt.Sprintf(`
+output "equivalent" {
+  value = provider::aws::iam_policy_equivalent(%[1]q, %[2]q)
+}
+
+output "normalized_equal" {
+  value = provider::aws::iam_policy_normalize(%[1]q) == provider::aws::iam_policy_normalize(%[2]q)
+}
+
+output "not_equivalent" {
+  value = provider::aws::iam_policy_equivalent(%[1]q, %[3]q)
+}
+
+output "not_normalized_equal" {
+  value = provider::aws::iam_policy_normalize(%[1]q) == provider::aws::iam_policy_normalize(%[3]q)
+}
+`, policy1, policy2, policy3)
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+var _ function.Function = tagsMergeFunction{}
+
+func NewTagsMergeFunction() function.Function {
+	return &tagsMergeFunction{}
+}
+
+type tagsMergeFunction struct{}
+
+func (f tagsMergeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "tags_merge"
+}
+
+func (f tagsMergeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "tags_merge Function",
+		MarkdownDescription: "Merges tag maps. Tags in later maps take precedence. AWS-reserved (`aws:`) tags and tags with " +
+			"any of the specified key prefixes are removed, in the same way as the provider's `ignore_tags` configuration.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "tags",
+				ElementType:         types.MapType{ElemType: types.StringType},
+				MarkdownDescription: "Tag maps to merge",
+			},
+			function.ListParameter{
+				Name:                "ignore_key_prefixes",
+				ElementType:         types.StringType,
+				MarkdownDescription: "Tag key prefixes to remove from the result",
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f tagsMergeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var tags []map[string]string
+	var ignoreKeyPrefixes []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &tags, &ignoreKeyPrefixes))
+	if resp.Error != nil {
+		return
+	}
+
+	result := tftags.New(ctx, nil)
+	for _, v := range tags {
+		result = result.Merge(tftags.New(ctx, v))
+	}
+	result = result.IgnoreAWS().IgnorePrefixes(tftags.New(ctx, ignoreKeyPrefixes))
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result.Map()))
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestTagsMergeFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testTagsMergeFunctionConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("count", "2"),
+					resource.TestCheckOutput("environment", "production"),
+					resource.TestCheckOutput(names.AttrName, "example"),
+				),
+			},
+		},
+	})
+}
+
+const testTagsMergeFunctionConfig_basic = `
+locals {
+  test = provider::aws::tags_merge(
+    [
+      { Environment = "staging", Name = "example", "kubernetes.io/cluster/example" = "owned" },
+      { Environment = "production", "aws:cloudformation:stack-name" = "example" },
+    ],
+    ["kubernetes.io/"],
+  )
+}
+
+output "count" {
+  value = length(local.test)
+}
+
+output "environment" {
+  value = local.test["Environment"]
+}
+
+output "name" {
+  value = local.test["Name"]
+}
+`
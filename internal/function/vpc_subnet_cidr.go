@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// VPC subnet reference:
+	// https://docs.aws.amazon.com/vpc/latest/userguide/subnet-sizing.html
+
+	// AWS reserves the first four and the last address in each subnet CIDR block.
+	subnetReservedAddressesFirst = 4
+	subnetReservedAddressesLast  = 1
+
+	subnetIPv4PrefixLengthMin = 16
+	subnetIPv4PrefixLengthMax = 28
+	subnetIPv6PrefixLengthMin = 44
+	subnetIPv6PrefixLengthMax = 64
+)
+
+var vpcSubnetCIDRResultAttrTypes = map[string]attr.Type{
+	"cidr_block":           types.StringType,
+	"network_address":      types.StringType,
+	"router_address":       types.StringType,
+	"dns_address":          types.StringType,
+	"first_usable_address": types.StringType,
+	"last_usable_address":  types.StringType,
+	"usable_address_count": types.NumberType,
+}
+
+var _ function.Function = vpcSubnetCIDRFunction{}
+
+func NewVPCSubnetCIDRFunction() function.Function {
+	return &vpcSubnetCIDRFunction{}
+}
+
+type vpcSubnetCIDRFunction struct{}
+
+func (f vpcSubnetCIDRFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "vpc_subnet_cidr"
+}
+
+func (f vpcSubnetCIDRFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "vpc_subnet_cidr Function",
+		MarkdownDescription: "Calculates a VPC subnet CIDR block within a given IP network address prefix, in the same way as " +
+			"the built-in `cidrsubnet` function. The subnet size must be valid for a VPC subnet, and the returned usable " +
+			"address range excludes the addresses AWS reserves in each subnet.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "prefix",
+				MarkdownDescription: "Network address prefix in CIDR notation, typically the VPC CIDR block",
+			},
+			function.Int64Parameter{
+				Name:                "newbits",
+				MarkdownDescription: "Number of additional bits with which to extend the prefix",
+			},
+			function.Int64Parameter{
+				Name:                "netnum",
+				MarkdownDescription: "Whole number that can be represented as a binary integer with no more than `newbits` binary digits",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: vpcSubnetCIDRResultAttrTypes,
+		},
+	}
+}
+
+func (f vpcSubnetCIDRFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var prefix string
+	var newbits, netnum int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &prefix, &newbits, &netnum))
+	if resp.Error != nil {
+		return
+	}
+
+	subnet, err := vpcSubnetCIDR(prefix, newbits, netnum)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	network := subnet.Addr()
+	last := addrAdd(network, new(big.Int).Sub(addressCount(subnet), big.NewInt(1)))
+	usable := new(big.Int).Sub(addressCount(subnet), big.NewInt(subnetReservedAddressesFirst+subnetReservedAddressesLast))
+
+	value := map[string]attr.Value{
+		"cidr_block":           types.StringValue(subnet.String()),
+		"network_address":      types.StringValue(network.String()),
+		"router_address":       types.StringValue(addrAdd(network, big.NewInt(1)).String()),
+		"dns_address":          types.StringValue(addrAdd(network, big.NewInt(2)).String()),
+		"first_usable_address": types.StringValue(addrAdd(network, big.NewInt(subnetReservedAddressesFirst)).String()),
+		"last_usable_address":  types.StringValue(addrAdd(last, big.NewInt(-subnetReservedAddressesLast)).String()),
+		"usable_address_count": types.NumberValue(new(big.Float).SetInt(usable)),
+	}
+
+	result, d := types.ObjectValue(vpcSubnetCIDRResultAttrTypes, value)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// vpcSubnetCIDR returns the netnum'th subnet of the specified prefix extended by newbits,
+// validating that the result is a valid VPC subnet size.
+func vpcSubnetCIDR(s string, newbits, netnum int64) (netip.Prefix, error) {
+	prefix, err := netip.ParsePrefix(s)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	prefix = prefix.Masked()
+
+	addr := prefix.Addr()
+	if addr.Is4In6() {
+		return netip.Prefix{}, fmt.Errorf("IPv4-mapped IPv6 prefix %q is not supported", s)
+	}
+
+	if newbits < 0 {
+		return netip.Prefix{}, fmt.Errorf("newbits must not be negative")
+	}
+	if netnum < 0 {
+		return netip.Prefix{}, fmt.Errorf("netnum must not be negative")
+	}
+
+	bits := int64(prefix.Bits()) + newbits
+	if bits > int64(addr.BitLen()) {
+		return netip.Prefix{}, fmt.Errorf("insufficient address space to extend prefix of %d by %d", prefix.Bits(), newbits)
+	}
+
+	minBits, maxBits := subnetIPv4PrefixLengthMin, subnetIPv4PrefixLengthMax
+	if addr.Is6() {
+		minBits, maxBits = subnetIPv6PrefixLengthMin, subnetIPv6PrefixLengthMax
+	}
+	if bits < int64(minBits) || bits > int64(maxBits) {
+		return netip.Prefix{}, fmt.Errorf("subnet prefix length must be between /%d and /%d, got /%d", minBits, maxBits, bits)
+	}
+
+	if limit := new(big.Int).Lsh(big.NewInt(1), uint(newbits)); big.NewInt(netnum).Cmp(limit) >= 0 {
+		return netip.Prefix{}, fmt.Errorf("prefix extension of %d does not accommodate a subnet numbered %d", newbits, netnum)
+	}
+
+	offset := new(big.Int).Lsh(big.NewInt(netnum), uint(int64(addr.BitLen())-bits))
+
+	return netip.PrefixFrom(addrAdd(addr, offset), int(bits)), nil
+}
+
+// addressCount returns the number of addresses in the specified prefix.
+func addressCount(prefix netip.Prefix) *big.Int {
+	return new(big.Int).Lsh(big.NewInt(1), uint(prefix.Addr().BitLen()-prefix.Bits()))
+}
+
+// addrAdd returns the address offset from the specified address.
+// The caller is responsible for ensuring that the result does not overflow.
+func addrAdd(addr netip.Addr, offset *big.Int) netip.Addr {
+	n := new(big.Int).SetBytes(addr.AsSlice())
+	n.Add(n, offset)
+
+	b := make([]byte, addr.BitLen()/8)
+	n.FillBytes(b)
+
+	v, _ := netip.AddrFromSlice(b)
+
+	return v
+}
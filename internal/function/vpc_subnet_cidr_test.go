@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestVPCSubnetCIDRFunction_ipv4(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testVPCSubnetCIDRFunctionConfig("10.0.0.0/16", 8, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("cidr_block", "10.0.2.0/24"),
+					resource.TestCheckOutput("router_address", "10.0.2.1"),
+					resource.TestCheckOutput("dns_address", "10.0.2.2"),
+					resource.TestCheckOutput("first_usable_address", "10.0.2.4"),
+					resource.TestCheckOutput("last_usable_address", "10.0.2.254"),
+					resource.TestCheckOutput("usable_address_count", "251"),
+				),
+			},
+		},
+	})
+}
+
+func TestVPCSubnetCIDRFunction_ipv6(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testVPCSubnetCIDRFunctionConfig("2600:1f14:abc:de00::/56", 8, 255),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("cidr_block", "2600:1f14:abc:deff::/64"),
+					resource.TestCheckOutput("router_address", "2600:1f14:abc:deff::1"),
+					resource.TestCheckOutput("first_usable_address", "2600:1f14:abc:deff::4"),
+					resource.TestCheckOutput("last_usable_address", "2600:1f14:abc:deff:ffff:ffff:ffff:fffe"),
+				),
+			},
+		},
+	})
+}
+
+func TestVPCSubnetCIDRFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testVPCSubnetCIDRFunctionConfig("10.0.0.0/16", 16, 0),
+				ExpectError: regexache.MustCompile(`subnet[\s\n]*prefix[\s\n]*length[\s\n]*must`),
+			},
+			{
+				Config:      testVPCSubnetCIDRFunctionConfig("10.0.0.0/16", 4, 16),
+				ExpectError: regexache.MustCompile(`does[\s\n]*not[\s\n]*accommodate`),
+			},
+			{
+				Config:      testVPCSubnetCIDRFunctionConfig("10.0.0.0", 8, 0),
+				ExpectError: regexache.MustCompile(`no[\s\n]*'/'`),
+			},
+		},
+	})
+}
+
+func testVPCSubnetCIDRFunctionConfig(prefix string, newbits, netnum int) string {
+	return fmt.Sprintf(`
+locals {
+  test = provider::aws::vpc_subnet_cidr(%[1]q, %[2]d, %[3]d)
+}
+
+output "cidr_block" {
+  value = local.test.cidr_block
+}
+
+output "router_address" {
+  value = local.test.router_address
+}
+
+output "dns_address" {
+  value = local.test.dns_address
+}
+
+output "first_usable_address" {
+  value = local.test.first_usable_address
+}
+
+output "last_usable_address" {
+  value = local.test.last_usable_address
+}
+
+output "usable_address_count" {
+  value = local.test.usable_address_count
+}
+`, prefix, newbits, netnum)
+}
@@ -0,0 +1,506 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/dlclark/regexp2"
+	acctestgen "github.com/hashicorp/terraform-provider-aws/internal/acctest/generate"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+	namesgen "github.com/hashicorp/terraform-provider-aws/names/generate"
+)
+
+// ServiceRecords holds the service data for a single service package.
+// A package can implement resource types for more than one service, e.g. the `ec2` package.
+type ServiceRecords struct {
+	primary    data.ServiceRecord
+	additional []data.ServiceRecord
+}
+
+// ReadServiceRecords returns the service data for the specified service package.
+func ReadServiceRecords(servicePackage string) (ServiceRecords, error) {
+	var (
+		sr    ServiceRecords
+		found bool
+	)
+
+	serviceData, err := data.ReadAllServiceData()
+	if err != nil {
+		return sr, fmt.Errorf("reading service data: %w", err)
+	}
+
+	for _, l := range serviceData {
+		// See internal/generate/namesconsts/main.go.
+		if p := l.SplitPackageRealPackage(); p != "" {
+			if p != servicePackage {
+				continue
+			}
+
+			ep := l.ProviderPackage()
+			if p == ep {
+				sr.primary = l
+				found = true
+			} else {
+				sr.additional = append(sr.additional, l)
+			}
+		} else {
+			p := l.ProviderPackage()
+
+			if p != servicePackage {
+				continue
+			}
+
+			sr.primary = l
+			found = true
+		}
+	}
+
+	if !found {
+		return sr, fmt.Errorf("service package not found: %s", servicePackage)
+	}
+
+	return sr, nil
+}
+
+func (sr ServiceRecords) ProviderNameUpper(resource string) (string, error) {
+	if len(sr.additional) == 0 {
+		return sr.primary.ProviderNameUpper(), nil
+	}
+
+	var (
+		service data.ServiceRecord
+		found   bool
+	)
+	for _, svc := range sr.additional {
+		re, err := regexp2.Compile(svc.ResourcePrefix(), 0)
+		if err != nil {
+			return "", err
+		}
+		if match, err := re.MatchString(resource); err != nil {
+			return "", err
+		} else if match {
+			service = svc
+			found = true
+		}
+	}
+
+	if !found {
+		re, err := regexp2.Compile(sr.primary.ResourcePrefix(), 0)
+		if err != nil {
+			return "", err
+		}
+		if match, err := re.MatchString(resource); err != nil {
+			return "", err
+		} else if match {
+			service = sr.primary
+			found = true
+		}
+	}
+
+	if found {
+		return service.ProviderNameUpper(), nil
+	}
+
+	return "", fmt.Errorf("No match found for resource type %q", resource)
+}
+
+func (sr ServiceRecords) PackageProviderNameUpper() string {
+	return sr.primary.ProviderNameUpper()
+}
+
+type Implementation string
+
+const (
+	ImplementationFramework Implementation = "framework"
+	ImplementationSDK       Implementation = "sdk"
+)
+
+type GoImport struct {
+	Path  string
+	Alias string
+}
+
+type CodeBlock struct {
+	Code string
+}
+
+// ResourceTestDatum holds the values shared by the generated acceptance test templates.
+// Generators embed it in their own template data.
+type ResourceTestDatum struct {
+	ProviderPackage           string
+	ResourceProviderNameUpper string
+	PackageProviderNameUpper  string
+	Name                      string
+	TypeName                  string
+	DestroyTakesT             bool
+	ExistsTypeName            string
+	ExistsTakesT              bool
+	FileName                  string
+	Generator                 string
+	NoImport                  bool
+	ImportStateID             string
+	importStateIDAttribute    string
+	ImportStateIDFunc         string
+	ImportIgnore              []string
+	Implementation            Implementation
+	Serialize                 bool
+	SerializeDelay            bool
+	SerializeParallelTests    bool
+	PreCheck                  bool
+	GoImports                 []GoImport
+	InitCodeBlocks            []CodeBlock
+	additionalTfVars          map[string]string
+	AlternateRegionProvider   bool
+	CheckDestroyNoop          bool
+	IsDataSource              bool
+}
+
+func (d ResourceTestDatum) AdditionalTfVars() map[string]string {
+	return tfmaps.ApplyToAllKeys(d.additionalTfVars, func(k string) string {
+		return acctestgen.ConstOrQuote(k)
+	})
+}
+
+// AdditionalTfVarNames returns the sorted names of the additional Terraform variables.
+func (d ResourceTestDatum) AdditionalTfVarNames() []string {
+	names := tfmaps.Keys(d.additionalTfVars)
+	slices.Sort(names)
+
+	return names
+}
+
+func (d ResourceTestDatum) HasImportStateIDAttribute() bool {
+	return d.importStateIDAttribute != ""
+}
+
+func (d ResourceTestDatum) ImportStateIDAttribute() string {
+	return namesgen.ConstOrQuote(d.importStateIDAttribute)
+}
+
+// Annotation is a single `// @Name(args)` annotation on a factory function.
+type Annotation struct {
+	Name string
+	Args Args
+}
+
+// ResourceTestFunc is an annotated factory function found by VisitResourceTests.
+type ResourceTestFunc struct {
+	PackageName  string
+	FunctionName string
+
+	// Datum is populated from the resource or data source annotation and the @Testing keys common to all generators.
+	Datum ResourceTestDatum
+
+	// Annotations contains every annotation on the function, in source order.
+	// Generators inspect it for the annotations and @Testing keys that only they use.
+	Annotations []Annotation
+
+	// GeneratorSeen is set if @Testing(generator) was specified.
+	GeneratorSeen bool
+
+	// TLSKey is set if @Testing(tlsKey=true) was specified. The TLS key and certificate initialization is added to Datum.
+	TLSKey       bool
+	TLSKeyDomain string
+}
+
+// QualifiedName returns the function's package-qualified name, for use in error messages.
+func (f *ResourceTestFunc) QualifiedName() string {
+	return fmt.Sprintf("%s.%s", f.PackageName, f.FunctionName)
+}
+
+// Annotation processing.
+var (
+	annotationRegexp = regexp.MustCompile(`^//\s*@([0-9A-Za-z]+)(\((.*)\))?\s*$`) // nosemgrep:ci.calling-regexp.MustCompile-directly
+	sdkNameRegexp    = regexp.MustCompile(`^(?i:Resource|DataSource)(\w+)$`)      // nosemgrep:ci.calling-regexp.MustCompile-directly
+)
+
+// VisitResourceTests scans the Go source files (excluding tests) in the specified service package directory
+// and calls fn for each function (not method) with annotations.
+// Errors parsing the shared annotations are returned along with any errors returned by fn.
+func VisitResourceTests(path string, fn func(*ResourceTestFunc) error) error {
+	v := &resourceTestVisitor{
+		fn: fn,
+	}
+
+	v.processDir(path)
+
+	return errors.Join(v.errs...)
+}
+
+type resourceTestVisitor struct {
+	errs []error
+	fn   func(*ResourceTestFunc) error
+
+	fileName    string
+	packageName string
+}
+
+// processDir scans a single service package directory and processes contained Go sources files.
+func (v *resourceTestVisitor) processDir(path string) {
+	fileSet := token.NewFileSet()
+	packageMap, err := parser.ParseDir(fileSet, path, func(fi os.FileInfo) bool {
+		// Skip tests.
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.ParseComments)
+
+	if err != nil {
+		v.errs = append(v.errs, fmt.Errorf("parsing (%s): %w", path, err))
+
+		return
+	}
+
+	for name, pkg := range packageMap {
+		v.packageName = name
+
+		for name, file := range pkg.Files {
+			v.fileName = name
+
+			ast.Walk(v, file)
+
+			v.fileName = ""
+		}
+
+		v.packageName = ""
+	}
+}
+
+// processFuncDecl processes a single Go function.
+// The function's comments are scanned for annotations indicating a Plugin Framework or SDK resource or data source.
+func (v *resourceTestVisitor) processFuncDecl(funcDecl *ast.FuncDecl) {
+	f := &ResourceTestFunc{
+		PackageName:  v.packageName,
+		FunctionName: funcDecl.Name.Name,
+		Datum: ResourceTestDatum{
+			FileName:         v.fileName,
+			additionalTfVars: make(map[string]string),
+		},
+	}
+	d := &f.Datum
+
+	for _, line := range funcDecl.Doc.List {
+		m := annotationRegexp.FindStringSubmatch(line.Text)
+		if len(m) == 0 {
+			continue
+		}
+
+		args := ParseArgs(m[3])
+		f.Annotations = append(f.Annotations, Annotation{
+			Name: m[1],
+			Args: args,
+		})
+
+		switch annotationName := m[1]; annotationName {
+		case "FrameworkDataSource":
+			d.IsDataSource = true
+			fallthrough
+
+		case "FrameworkResource":
+			d.Implementation = ImplementationFramework
+			if len(args.Positional) == 0 {
+				v.errs = append(v.errs, fmt.Errorf("no type name: %s", f.QualifiedName()))
+				continue
+			}
+			d.TypeName = args.Positional[0]
+
+			if attr, ok := args.Keyword["name"]; ok {
+				attr = strings.ReplaceAll(attr, " ", "")
+				d.Name = strings.ReplaceAll(attr, "-", "")
+			}
+
+		case "SDKDataSource":
+			d.IsDataSource = true
+			fallthrough
+
+		case "SDKResource":
+			d.Implementation = ImplementationSDK
+			if len(args.Positional) == 0 {
+				v.errs = append(v.errs, fmt.Errorf("no type name: %s", f.QualifiedName()))
+				continue
+			}
+			d.TypeName = args.Positional[0]
+
+			if attr, ok := args.Keyword["name"]; ok {
+				attr = strings.ReplaceAll(attr, " ", "")
+				d.Name = strings.ReplaceAll(attr, "-", "")
+			} else if d.IsDataSource {
+				m := sdkNameRegexp.FindStringSubmatch(f.FunctionName)
+				if m == nil {
+					v.errs = append(v.errs, fmt.Errorf("no name parameter set: %s", f.QualifiedName()))
+					continue
+				}
+				d.Name = m[1]
+			}
+
+		case "Testing":
+			if err := v.processTesting(f, args); err != nil {
+				v.errs = append(v.errs, err)
+				continue
+			}
+		}
+	}
+
+	if len(f.Annotations) == 0 {
+		return
+	}
+
+	if f.TLSKey {
+		tlsKeyCN := f.TLSKeyDomain
+		if len(tlsKeyCN) == 0 {
+			tlsKeyCN = "acctest.RandomDomain().String()"
+		}
+		d.InitCodeBlocks = append(d.InitCodeBlocks, CodeBlock{
+			Code: fmt.Sprintf(`privateKeyPEM := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+			certificatePEM := acctest.TLSRSAX509SelfSignedCertificatePEM(t, privateKeyPEM, %s)`, tlsKeyCN),
+		})
+		d.additionalTfVars["certificate_pem"] = "certificatePEM"
+		d.additionalTfVars["private_key_pem"] = "privateKeyPEM"
+	}
+
+	if err := v.fn(f); err != nil {
+		v.errs = append(v.errs, err)
+	}
+}
+
+// processTesting processes the @Testing keys common to all generators.
+func (v *resourceTestVisitor) processTesting(f *ResourceTestFunc, args Args) error {
+	d := &f.Datum
+
+	for _, key := range []string{
+		"altRegionProvider",
+		"checkDestroyNoop",
+		"destroyTakesT",
+		"existsTakesT",
+		"noImport",
+		"preCheck",
+		"serialize",
+		"serializeParallelTests",
+		"serializeDelay",
+		"tlsKey",
+	} {
+		attr, ok := args.Keyword[key]
+		if !ok {
+			continue
+		}
+
+		b, err := strconv.ParseBool(attr)
+		if err != nil {
+			return fmt.Errorf("invalid %s value: %q at %s. Should be boolean value.", key, attr, f.QualifiedName())
+		}
+
+		switch key {
+		case "altRegionProvider":
+			d.AlternateRegionProvider = b
+		case "checkDestroyNoop":
+			d.CheckDestroyNoop = b
+		case "destroyTakesT":
+			d.DestroyTakesT = b
+		case "existsTakesT":
+			d.ExistsTakesT = b
+		case "noImport":
+			d.NoImport = b
+		case "preCheck":
+			d.PreCheck = b
+		case "serialize":
+			d.Serialize = b
+		case "serializeParallelTests":
+			d.SerializeParallelTests = b
+		case "serializeDelay":
+			d.SerializeDelay = b
+		case "tlsKey":
+			f.TLSKey = b
+		}
+	}
+
+	if attr, ok := args.Keyword["existsType"]; ok {
+		typeName, importSpec, err := ParseIdentifierSpec(attr)
+		if err != nil {
+			return fmt.Errorf("%s: %s: %w", attr, f.QualifiedName(), err)
+		}
+		d.ExistsTypeName = typeName
+		if importSpec != nil {
+			d.GoImports = append(d.GoImports, *importSpec)
+		}
+	}
+	if attr, ok := args.Keyword["generator"]; ok {
+		if attr != "false" {
+			funcName, importSpec, err := ParseIdentifierSpec(attr)
+			if err != nil {
+				return fmt.Errorf("%s: %s: %w", attr, f.QualifiedName(), err)
+			}
+			d.Generator = funcName
+			if importSpec != nil {
+				d.GoImports = append(d.GoImports, *importSpec)
+			}
+		}
+		f.GeneratorSeen = true
+	}
+	if attr, ok := args.Keyword["importIgnore"]; ok {
+		d.ImportIgnore = strings.Split(attr, ";")
+
+		for i, val := range d.ImportIgnore {
+			d.ImportIgnore[i] = namesgen.ConstOrQuote(val)
+		}
+	}
+	if attr, ok := args.Keyword["importStateId"]; ok {
+		d.ImportStateID = attr
+	}
+	if attr, ok := args.Keyword["importStateIdAttribute"]; ok {
+		d.importStateIDAttribute = attr
+	}
+	if attr, ok := args.Keyword["importStateIdFunc"]; ok {
+		d.ImportStateIDFunc = attr
+	}
+	if attr, ok := args.Keyword["name"]; ok {
+		d.Name = strings.ReplaceAll(attr, " ", "")
+	}
+	if attr, ok := args.Keyword["tlsKeyDomain"]; ok {
+		f.TLSKeyDomain = attr
+	}
+
+	return nil
+}
+
+// Visit is called for each node visited by ast.Walk.
+func (v *resourceTestVisitor) Visit(node ast.Node) ast.Visitor {
+	// Look at functions (not methods) with comments.
+	if funcDecl, ok := node.(*ast.FuncDecl); ok && funcDecl.Recv == nil && funcDecl.Doc != nil {
+		v.processFuncDecl(funcDecl)
+	}
+
+	return v
+}
+
+// ParseIdentifierSpec parses an identifier specification of the form `name`, `importPath;name` or `importPath;alias;name`.
+func ParseIdentifierSpec(s string) (string, *GoImport, error) {
+	parts := strings.Split(s, ";")
+	switch len(parts) {
+	case 1:
+		return parts[0], nil, nil
+
+	case 2:
+		return parts[1], &GoImport{
+			Path: parts[0],
+		}, nil
+
+	case 3:
+		return parts[2], &GoImport{
+			Path:  parts[0],
+			Alias: parts[1],
+		}, nil
+
+	default:
+		return "", nil, fmt.Errorf("invalid generator value: %q", s)
+	}
+}
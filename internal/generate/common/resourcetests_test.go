@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseIdentifierSpec(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input      string
+		wantName   string
+		wantImport *GoImport
+		wantErr    bool
+	}{
+		"name only": {
+			input:    "types.LogGroup",
+			wantName: "types.LogGroup",
+		},
+		"import path": {
+			input:    "github.com/aws/aws-sdk-go-v2/service/ec2/types;types.Vpc",
+			wantName: "types.Vpc",
+			wantImport: &GoImport{
+				Path: "github.com/aws/aws-sdk-go-v2/service/ec2/types",
+			},
+		},
+		"import alias": {
+			input:    "github.com/aws/aws-sdk-go-v2/service/ec2/types;awstypes;awstypes.Vpc",
+			wantName: "awstypes.Vpc",
+			wantImport: &GoImport{
+				Path:  "github.com/aws/aws-sdk-go-v2/service/ec2/types",
+				Alias: "awstypes",
+			},
+		},
+		"too many parts": {
+			input:   "a;b;c;d",
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotName, gotImport, err := ParseIdentifierSpec(testCase.input)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("error = %v, want error %t", err, want)
+			}
+			if got, want := gotName, testCase.wantName; got != want {
+				t.Errorf("name = %q, want %q", got, want)
+			}
+			switch {
+			case gotImport == nil && testCase.wantImport == nil:
+			case gotImport == nil || testCase.wantImport == nil || *gotImport != *testCase.wantImport:
+				t.Errorf("import = %v, want %v", gotImport, testCase.wantImport)
+			}
+		})
+	}
+}
+
+func TestVisitResourceTests(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	src := `package example
+
+// @SDKResource("aws_example_thing", name="Thing")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/example/types;types.Thing", importIgnore="password", tlsKey=true, lifecycleTest=true)
+func resourceThing() {}
+
+// @FrameworkDataSource("aws_example_widget", name="Widget")
+func newWidgetDataSource() {}
+
+// Not annotated.
+func helper() {}
+`
+	if err := os.WriteFile(filepath.Join(dir, "example.go"), []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var funcs []*ResourceTestFunc
+	err := VisitResourceTests(dir, func(f *ResourceTestFunc) error {
+		funcs = append(funcs, f)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(funcs), 2; got != want {
+		t.Fatalf("length of funcs = %d, want %d", got, want)
+	}
+
+	var thing, widget *ResourceTestFunc
+	for _, f := range funcs {
+		switch f.FunctionName {
+		case "resourceThing":
+			thing = f
+		case "newWidgetDataSource":
+			widget = f
+		}
+	}
+	if thing == nil || widget == nil {
+		t.Fatalf("funcs = %v, want resourceThing and newWidgetDataSource", funcs)
+	}
+
+	d := thing.Datum
+	if got, want := d.TypeName, "aws_example_thing"; got != want {
+		t.Errorf("TypeName = %q, want %q", got, want)
+	}
+	if got, want := d.Name, "Thing"; got != want {
+		t.Errorf("Name = %q, want %q", got, want)
+	}
+	if got, want := d.Implementation, ImplementationSDK; got != want {
+		t.Errorf("Implementation = %q, want %q", got, want)
+	}
+	if got, want := d.ExistsTypeName, "types.Thing"; got != want {
+		t.Errorf("ExistsTypeName = %q, want %q", got, want)
+	}
+	if got, want := len(d.GoImports), 1; got != want {
+		t.Errorf("length of GoImports = %d, want %d", got, want)
+	}
+	if got, want := len(d.ImportIgnore), 1; got != want {
+		t.Errorf("length of ImportIgnore = %d, want %d", got, want)
+	}
+	if !thing.TLSKey {
+		t.Errorf("TLSKey = false, want true")
+	}
+	if got, want := len(d.InitCodeBlocks), 1; got != want {
+		t.Errorf("length of InitCodeBlocks = %d, want %d", got, want)
+	}
+	if got, want := d.AdditionalTfVarNames(), []string{"certificate_pem", "private_key_pem"}; len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("AdditionalTfVarNames = %v, want %v", got, want)
+	}
+	if got, want := len(thing.Annotations), 3; got != want {
+		t.Errorf("length of Annotations = %d, want %d", got, want)
+	}
+	if got, want := thing.Annotations[2].Args.Keyword["lifecycleTest"], "true"; got != want {
+		t.Errorf("lifecycleTest = %q, want %q", got, want)
+	}
+
+	if !widget.Datum.IsDataSource {
+		t.Errorf("IsDataSource = false, want true")
+	}
+	if got, want := widget.Datum.Implementation, ImplementationFramework; got != want {
+		t.Errorf("Implementation = %q, want %q", got, want)
+	}
+}
+
+func TestVisitResourceTestsInvalidTesting(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	src := `package example
+
+// @SDKResource("aws_example_thing", name="Thing")
+// @Testing(serialize=maybe)
+func resourceThing() {}
+`
+	if err := os.WriteFile(filepath.Join(dir, "example.go"), []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	err := VisitResourceTests(dir, func(f *ResourceTestFunc) error {
+		return nil
+	})
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+}
@@ -0,0 +1,250 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+)
+
+func main() {
+	failed := false
+
+	g := common.NewGenerator()
+
+	servicePackage := os.Getenv("GOPACKAGE")
+
+	g.Infof("Generating lifecycle tests for internal/service/%s", servicePackage)
+
+	svc, err := common.ReadServiceRecords(servicePackage)
+	if err != nil {
+		g.Fatalf("%s", err)
+	}
+
+	// Look for Terraform Plugin Framework and SDK resource annotations.
+	// These annotations are implemented as comments on factory functions.
+	var resources []ResourceDatum
+
+	if err := common.VisitResourceTests(".", func(f *common.ResourceTestFunc) error {
+		d, enabled, err := lifecycleResource(f)
+		if err != nil {
+			return err
+		}
+		if enabled {
+			resources = append(resources, d)
+		}
+		return nil
+	}); err != nil {
+		g.Fatalf("%s", err.Error())
+	}
+
+	for _, resource := range resources {
+		sourceName := resource.FileName
+		ext := filepath.Ext(sourceName)
+		sourceName = strings.TrimSuffix(sourceName, ext)
+		sourceName = strings.TrimSuffix(sourceName, "_")
+
+		if name, err := svc.ProviderNameUpper(resource.TypeName); err != nil {
+			g.Fatalf("determining provider service name: %s", err)
+		} else {
+			resource.ResourceProviderNameUpper = name
+		}
+		resource.PackageProviderNameUpper = svc.PackageProviderNameUpper()
+		resource.ProviderPackage = servicePackage
+
+		// Prefer a dedicated basic configuration, falling back to the tagging test configuration.
+		var configTmplFile, configTmpl string
+		for _, suffix := range []string{"basic", "tags"} {
+			f := path.Join("testdata", "tmpl", fmt.Sprintf("%s_%s.gtpl", sourceName, suffix))
+			if _, err := os.Stat(f); err == nil {
+				b, err := os.ReadFile(f)
+				if err != nil {
+					g.Fatalf("reading %q: %s", f, err)
+				}
+				configTmplFile, configTmpl = f, string(b)
+				break
+			} else if !errors.Is(err, os.ErrNotExist) {
+				g.Fatalf("opening config template %q: %s", f, err)
+			}
+		}
+
+		if configTmplFile == "" {
+			g.Errorf("no basic or tags template found for %s in %q", sourceName, path.Join("testdata", "tmpl"))
+			failed = true
+			continue
+		}
+
+		filename := fmt.Sprintf("%s_lifecycle_gen_test.go", sourceName)
+
+		d := g.NewGoFileDestination(filename)
+		templates, err := template.New("lifecycletests").Parse(resourceTestGoTmpl)
+		if err != nil {
+			g.Fatalf("parsing base Go test template: %s", err)
+		}
+
+		if err := d.BufferTemplateSet(templates, resource); err != nil {
+			g.Fatalf("error generating %q service package data: %s", servicePackage, err)
+		}
+
+		if err := d.Write(); err != nil {
+			g.Fatalf("generating file (%s): %s", filename, err)
+		}
+
+		tfTemplates, err := template.New("lifecycletests").Parse(testTfTmpl)
+		if err != nil {
+			g.Fatalf("parsing base Terraform config template: %s", err)
+		}
+
+		_, err = tfTemplates.New("body").Parse(configTmpl)
+		if err != nil {
+			g.Fatalf("parsing config template %q: %s", configTmplFile, err)
+		}
+
+		configData := ConfigDatum{
+			AdditionalTfVars:        resource.AdditionalTfVarNames(),
+			WithRName:               (resource.Generator != ""),
+			AlternateRegionProvider: resource.AlternateRegionProvider,
+		}
+
+		generateTestConfig(g, path.Join("testdata", resource.Name, "basic"), tfTemplates, configData)
+	}
+
+	if failed {
+		os.Exit(1)
+	}
+}
+
+type ResourceDatum struct {
+	common.ResourceTestDatum
+	TestName        string
+	NoDisappears    bool
+	ResourceFactory string
+}
+
+type ConfigDatum struct {
+	AdditionalTfVars        []string
+	WithRName               bool
+	AlternateRegionProvider bool
+}
+
+//go:embed resource_test.go.gtpl
+var resourceTestGoTmpl string
+
+//go:embed test.tf.gtpl
+var testTfTmpl string
+
+var (
+	frameworkFactoryRegexp = regexp.MustCompile(`^new(\w+)Resource$`) // nosemgrep:ci.calling-regexp.MustCompile-directly
+)
+
+// lifecycleResource returns the template data for an annotated resource factory function
+// and whether lifecycle tests are enabled for it via `@Testing(lifecycleTest=true)`.
+func lifecycleResource(f *common.ResourceTestFunc) (ResourceDatum, bool, error) {
+	d := ResourceDatum{
+		ResourceTestDatum: f.Datum,
+	}
+	enabled := false
+
+	for _, a := range f.Annotations {
+		if a.Name != "Testing" {
+			continue
+		}
+
+		if attr, ok := a.Args.Keyword["disappearsTest"]; ok {
+			if b, err := strconv.ParseBool(attr); err != nil {
+				return d, false, fmt.Errorf("invalid disappearsTest value: %q at %s. Should be boolean value.", attr, f.QualifiedName())
+			} else {
+				d.NoDisappears = !b
+			}
+		}
+		if attr, ok := a.Args.Keyword["lifecycleTest"]; ok {
+			if b, err := strconv.ParseBool(attr); err != nil {
+				return d, false, fmt.Errorf("invalid lifecycleTest value: %q at %s. Should be boolean value.", attr, f.QualifiedName())
+			} else {
+				enabled = b
+			}
+		}
+		if attr, ok := a.Args.Keyword["resourceFactory"]; ok {
+			d.ResourceFactory = attr
+		}
+	}
+
+	if d.TypeName == "" || d.IsDataSource || !enabled {
+		return d, false, nil
+	}
+
+	if d.Name == "" {
+		return d, false, fmt.Errorf("no name parameter set: %s", f.QualifiedName())
+	}
+
+	if d.ResourceFactory == "" {
+		d.ResourceFactory = exportedFactoryName(d.Implementation, f.FunctionName)
+	}
+
+	d.TestName = testName(d.Implementation, f.FunctionName)
+
+	if !f.GeneratorSeen {
+		d.Generator = "acctest.RandomWithPrefix(t, acctest.ResourcePrefix)"
+	}
+
+	return d, true, nil
+}
+
+// exportedFactoryName returns the name under which a resource's factory function is conventionally exported for testing in exports_test.go.
+// For example, "resourceGroup" is exported as "ResourceGroup" and "newAppBundleResource" as "ResourceAppBundle".
+func exportedFactoryName(impl common.Implementation, funcName string) string {
+	if impl == common.ImplementationFramework {
+		if m := frameworkFactoryRegexp.FindStringSubmatch(funcName); m != nil {
+			return "Resource" + m[1]
+		}
+	}
+
+	return strings.ToUpper(funcName[:1]) + funcName[1:]
+}
+
+// testName returns the name used in the resource type's test function names, `TestAcc{SERVICE}{NAME}_...`,
+// following the convention for hand-written tests of naming them after the resource's factory function,
+// e.g. `Group` for `resourceGroup` or `AnomalyDetector` for `newAnomalyDetectorResource`.
+func testName(impl common.Implementation, funcName string) string {
+	if impl == common.ImplementationFramework {
+		if m := frameworkFactoryRegexp.FindStringSubmatch(funcName); m != nil {
+			return m[1]
+		}
+	}
+
+	name := strings.TrimPrefix(funcName, "resource")
+	name = strings.TrimPrefix(name, "Resource")
+
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+func generateTestConfig(g *common.Generator, dirPath string, tfTemplates *template.Template, configData ConfigDatum) {
+	if err := os.MkdirAll(dirPath, 0755); err != nil {
+		g.Fatalf("creating test directory %q: %s", dirPath, err)
+	}
+
+	mainPath := path.Join(dirPath, "main_gen.tf")
+	tf := g.NewUnformattedFileDestination(mainPath)
+
+	if err := tf.BufferTemplateSet(tfTemplates, configData); err != nil {
+		g.Fatalf("error generating Terraform file %q: %s", mainPath, err)
+	}
+
+	if err := tf.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", mainPath, err)
+	}
+}
@@ -10,25 +10,17 @@ import (
 	_ "embed"
 	"errors"
 	"fmt"
-	"go/ast"
-	"go/parser"
-	"go/token"
 	"iter"
 	"os"
 	"path"
 	"path/filepath"
-	"regexp"
 	"slices"
 	"strconv"
 	"strings"
 	"text/template"
 	"time"
 
-	"github.com/dlclark/regexp2"
-	acctestgen "github.com/hashicorp/terraform-provider-aws/internal/acctest/generate"
 	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
-	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
-	"github.com/hashicorp/terraform-provider-aws/names/data"
 	namesgen "github.com/hashicorp/terraform-provider-aws/names/generate"
 )
 
@@ -37,49 +29,13 @@ func main() {
 
 	g := common.NewGenerator()
 
-	serviceData, err := data.ReadAllServiceData()
-
-	if err != nil {
-		g.Fatalf("error reading service data: %s", err)
-	}
-
 	servicePackage := os.Getenv("GOPACKAGE")
 
 	g.Infof("Generating tagging tests for internal/service/%s", servicePackage)
 
-	var (
-		svc   serviceRecords
-		found bool
-	)
-
-	for _, l := range serviceData {
-		// See internal/generate/namesconsts/main.go.
-		if p := l.SplitPackageRealPackage(); p != "" {
-			if p != servicePackage {
-				continue
-			}
-
-			ep := l.ProviderPackage()
-			if p == ep {
-				svc.primary = l
-				found = true
-			} else {
-				svc.additional = append(svc.additional, l)
-			}
-		} else {
-			p := l.ProviderPackage()
-
-			if p != servicePackage {
-				continue
-			}
-
-			svc.primary = l
-			found = true
-		}
-	}
-
-	if !found {
-		g.Fatalf("service package not found: %s", servicePackage)
+	svc, err := common.ReadServiceRecords(servicePackage)
+	if err != nil {
+		g.Fatalf("%s", err)
 	}
 
 	// Look for Terraform Plugin Framework and SDK resource and data source annotations.
@@ -88,9 +44,7 @@ func main() {
 		g: g,
 	}
 
-	v.processDir(".")
-
-	if err := errors.Join(v.errs...); err != nil {
+	if err := common.VisitResourceTests(".", v.processFunc); err != nil {
 		g.Fatalf("%s", err.Error())
 	}
 
@@ -171,8 +125,7 @@ func main() {
 			}
 
 			if resource.GenerateConfig {
-				additionalTfVars := tfmaps.Keys(resource.additionalTfVars)
-				slices.Sort(additionalTfVars)
+				additionalTfVars := resource.AdditionalTfVarNames()
 				testDirPath := path.Join("testdata", resource.Name)
 
 				tfTemplates, err := template.New("taggingtests").Parse(testTfTmpl)
@@ -231,8 +184,7 @@ func main() {
 					g.Fatalf("opening data source config template %q: %w", dataSourceConfigTmplFile, err)
 				}
 
-				additionalTfVars := tfmaps.Keys(resource.additionalTfVars)
-				slices.Sort(additionalTfVars)
+				additionalTfVars := resource.AdditionalTfVarNames()
 				testDirPath := path.Join("testdata", resource.Name)
 
 				tfTemplates, err := template.New("taggingtests").Parse(testTfTmpl)
@@ -300,116 +252,19 @@ func main() {
 	}
 }
 
-type serviceRecords struct {
-	primary    data.ServiceRecord
-	additional []data.ServiceRecord
-}
-
-func (sr serviceRecords) ProviderNameUpper(resource string) (string, error) {
-	if len(sr.additional) == 0 {
-		return sr.primary.ProviderNameUpper(), nil
-	}
-
-	var (
-		service data.ServiceRecord
-		found   bool
-	)
-	for _, svc := range sr.additional {
-		re, err := regexp2.Compile(svc.ResourcePrefix(), 0)
-		if err != nil {
-			return "", err
-		}
-		if match, err := re.MatchString(resource); err != nil {
-			return "", err
-		} else if match {
-			service = svc
-			found = true
-		}
-	}
-
-	if !found {
-		re, err := regexp2.Compile(sr.primary.ResourcePrefix(), 0)
-		if err != nil {
-			return "", err
-		}
-		if match, err := re.MatchString(resource); err != nil {
-			return "", err
-		} else if match {
-			service = sr.primary
-			found = true
-		}
-	}
-
-	if found {
-		return service.ProviderNameUpper(), nil
-	}
-
-	return "", fmt.Errorf("No match found for resource type %q", resource)
-}
-
-func (sr serviceRecords) PackageProviderNameUpper() string {
-	return sr.primary.ProviderNameUpper()
-}
-
-type implementation string
-
-const (
-	implementationFramework implementation = "framework"
-	implementationSDK       implementation = "sdk"
-)
-
 type ResourceDatum struct {
-	ProviderPackage                  string
-	ResourceProviderNameUpper        string
-	PackageProviderNameUpper         string
-	Name                             string
-	TypeName                         string
-	DestroyTakesT                    bool
-	ExistsTypeName                   string
-	ExistsTakesT                     bool
-	FileName                         string
-	Generator                        string
-	NoImport                         bool
-	ImportStateID                    string
-	importStateIDAttribute           string
-	ImportStateIDFunc                string
-	ImportIgnore                     []string
-	Implementation                   implementation
-	Serialize                        bool
-	SerializeDelay                   bool
-	SerializeParallelTests           bool
-	PreCheck                         bool
+	common.ResourceTestDatum
 	SkipEmptyTags                    bool // TODO: Remove when we have a strategy for resources that have a minimum tag value length of 1
 	SkipNullTags                     bool
 	NoRemoveTags                     bool
-	GoImports                        []goImport
 	GenerateConfig                   bool
-	InitCodeBlocks                   []codeBlock
-	additionalTfVars                 map[string]string
-	AlternateRegionProvider          bool
 	TagsUpdateForceNew               bool
 	TagsUpdateGetTagsIn              bool // TODO: Works around a bug when getTagsIn() is used to pass tags directly to Update call
-	CheckDestroyNoop                 bool
-	IsDataSource                     bool
-	DataSourceResourceImplementation implementation
+	DataSourceResourceImplementation common.Implementation
 	overrideIdentifierAttribute      string
 	OverrideResourceType             string
 }
 
-func (d ResourceDatum) AdditionalTfVars() map[string]string {
-	return tfmaps.ApplyToAllKeys(d.additionalTfVars, func(k string) string {
-		return acctestgen.ConstOrQuote(k)
-	})
-}
-
-func (d ResourceDatum) HasImportStateIDAttribute() bool {
-	return d.importStateIDAttribute != ""
-}
-
-func (d ResourceDatum) ImportStateIDAttribute() string {
-	return namesgen.ConstOrQuote(d.importStateIDAttribute)
-}
-
 func (d ResourceDatum) OverrideIdentifier() bool {
 	return d.overrideIdentifierAttribute != ""
 }
@@ -418,15 +273,6 @@ func (d ResourceDatum) OverrideIdentifierAttribute() string {
 	return namesgen.ConstOrQuote(d.overrideIdentifierAttribute)
 }
 
-type goImport struct {
-	Path  string
-	Alias string
-}
-
-type codeBlock struct {
-	Code string
-}
-
 type commonConfig struct {
 	AdditionalTfVars        []string
 	WithRName               bool
@@ -452,368 +298,123 @@ var testTfTmpl string
 //go:embed tags_check.go.gtpl
 var tagsCheckTmpl string
 
-// Annotation processing.
-var (
-	annotation = regexp.MustCompile(`^//\s*@([0-9A-Za-z]+)(\((.*)\))?\s*$`) // nosemgrep:ci.calling-regexp.MustCompile-directly
-)
-
-var (
-	sdkNameRegexp = regexp.MustCompile(`^(?i:Resource|DataSource)(\w+)$`) // nosemgrep:ci.calling-regexp.MustCompile-directly
-)
-
 type visitor struct {
-	errs []error
-	g    *common.Generator
-
-	fileName     string
-	functionName string
-	packageName  string
+	g *common.Generator
 
 	taggedResources []ResourceDatum
 }
 
-// processDir scans a single service package directory and processes contained Go sources files.
-func (v *visitor) processDir(path string) {
-	fileSet := token.NewFileSet()
-	packageMap, err := parser.ParseDir(fileSet, path, func(fi os.FileInfo) bool {
-		// Skip tests.
-		return !strings.HasSuffix(fi.Name(), "_test.go")
-	}, parser.ParseComments)
-
-	if err != nil {
-		v.errs = append(v.errs, fmt.Errorf("parsing (%s): %w", path, err))
-
-		return
-	}
-
-	for name, pkg := range packageMap {
-		v.packageName = name
-
-		for name, file := range pkg.Files {
-			v.fileName = name
-
-			v.processFile(file)
-
-			v.fileName = ""
-		}
-
-		v.packageName = ""
-	}
-}
-
-// processFile processes a single Go source file.
-func (v *visitor) processFile(file *ast.File) {
-	ast.Walk(v, file)
-}
-
-// processFuncDecl processes a single Go function.
-// The function's comments are scanned for annotations indicating a Plugin Framework or SDK resource or data source.
-func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
-	v.functionName = funcDecl.Name.Name
-
-	// Look first for tagging annotations.
+// processFunc processes a single annotated Go function.
+// The function's annotations are scanned for tagging annotations and @Testing keys.
+func (v *visitor) processFunc(f *common.ResourceTestFunc) error {
 	d := ResourceDatum{
-		FileName:         v.fileName,
-		additionalTfVars: make(map[string]string),
+		ResourceTestDatum: f.Datum,
 	}
 	tagged := false
 	skip := false
-	generatorSeen := false
-	tlsKey := false
-	var tlsKeyCN string
 	hasIdentifierAttribute := false
 
-	for _, line := range funcDecl.Doc.List {
-		line := line.Text
-
-		if m := annotation.FindStringSubmatch(line); len(m) > 0 {
-			switch annotationName := m[1]; annotationName {
-			case "FrameworkDataSource":
-				d.IsDataSource = true
-				fallthrough
-
-			case "FrameworkResource":
-				d.Implementation = implementationFramework
-				args := common.ParseArgs(m[3])
-				if len(args.Positional) == 0 {
-					v.errs = append(v.errs, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
-					continue
-				}
-				d.TypeName = args.Positional[0]
-
-				if attr, ok := args.Keyword["name"]; ok {
-					attr = strings.ReplaceAll(attr, " ", "")
-					d.Name = strings.ReplaceAll(attr, "-", "")
-				}
-
-			case "SDKDataSource":
-				d.IsDataSource = true
-				fallthrough
+	for _, a := range f.Annotations {
+		args := a.Args
 
-			case "SDKResource":
-				d.Implementation = implementationSDK
-				args := common.ParseArgs(m[3])
-				if len(args.Positional) == 0 {
-					v.errs = append(v.errs, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
-					continue
-				}
-				d.TypeName = args.Positional[0]
-
-				if attr, ok := args.Keyword["name"]; ok {
-					attr = strings.ReplaceAll(attr, " ", "")
-					d.Name = strings.ReplaceAll(attr, "-", "")
-				} else if d.IsDataSource {
-					m := sdkNameRegexp.FindStringSubmatch(v.functionName)
-					if m == nil {
-						v.errs = append(v.errs, fmt.Errorf("no name parameter set: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
-						continue
-					}
-					d.Name = m[1]
-				}
-
-			case "Tags":
-				tagged = true
-				args := common.ParseArgs(m[3])
-				if _, ok := args.Keyword["identifierAttribute"]; ok {
-					hasIdentifierAttribute = true
-				}
+		switch a.Name {
+		case "Tags":
+			tagged = true
+			if _, ok := args.Keyword["identifierAttribute"]; ok {
+				hasIdentifierAttribute = true
+			}
 
-			case "Testing":
-				args := common.ParseArgs(m[3])
-				if attr, ok := args.Keyword["altRegionProvider"]; ok {
-					if b, err := strconv.ParseBool(attr); err != nil {
-						v.errs = append(v.errs, fmt.Errorf("invalid altRegionProvider value: %q at %s. Should be boolean value.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
-						continue
-					} else {
-						d.AlternateRegionProvider = b
-					}
-				}
+		case "Testing":
+			if _, ok := args.Keyword["checkDestroyNoop"]; ok {
+				d.GoImports = append(d.GoImports,
+					common.GoImport{
+						Path: "github.com/hashicorp/terraform-provider-aws/internal/acctest",
+					},
+				)
+			}
+			if attr, ok := args.Keyword["tagsIdentifierAttribute"]; ok {
+				d.overrideIdentifierAttribute = attr
+			}
+			if attr, ok := args.Keyword["tagsResourceType"]; ok {
+				d.OverrideResourceType = attr
+			}
+			if attr, ok := args.Keyword["tagsTest"]; ok {
+				switch attr {
+				case "true":
+					// Add tagging tests for non-transparent tagging resources
+					tagged = true
 
-				if attr, ok := args.Keyword["destroyTakesT"]; ok {
-					if b, err := strconv.ParseBool(attr); err != nil {
-						v.errs = append(v.errs, fmt.Errorf("invalid destroyTakesT value: %q at %s. Should be boolean value.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
-						continue
-					} else {
-						d.DestroyTakesT = b
-					}
-				}
-				if attr, ok := args.Keyword["checkDestroyNoop"]; ok {
-					if b, err := strconv.ParseBool(attr); err != nil {
-						v.errs = append(v.errs, fmt.Errorf("invalid checkDestroyNoop value: %q at %s. Should be boolean value.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
-						continue
-					} else {
-						d.CheckDestroyNoop = b
-						d.GoImports = append(d.GoImports,
-							goImport{
-								Path: "github.com/hashicorp/terraform-provider-aws/internal/acctest",
-							},
-						)
-					}
-				}
-				if attr, ok := args.Keyword["existsType"]; ok {
-					if typeName, importSpec, err := parseIdentifierSpec(attr); err != nil {
-						v.errs = append(v.errs, fmt.Errorf("%s: %w", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
-						continue
-					} else {
-						d.ExistsTypeName = typeName
-						if importSpec != nil {
-							d.GoImports = append(d.GoImports, *importSpec)
-						}
-					}
-				}
-				if attr, ok := args.Keyword["existsTakesT"]; ok {
-					if b, err := strconv.ParseBool(attr); err != nil {
-						v.errs = append(v.errs, fmt.Errorf("invalid existsTakesT value: %q at %s. Should be boolean value.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
-						continue
-					} else {
-						d.ExistsTakesT = b
-					}
-				}
-				if attr, ok := args.Keyword["generator"]; ok {
-					if attr == "false" {
-						generatorSeen = true
-					} else if funcName, importSpec, err := parseIdentifierSpec(attr); err != nil {
-						v.errs = append(v.errs, fmt.Errorf("%s: %w", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
-						continue
-					} else {
-						d.Generator = funcName
-						if importSpec != nil {
-							d.GoImports = append(d.GoImports, *importSpec)
-						}
-						generatorSeen = true
-					}
-				}
-				if attr, ok := args.Keyword["importIgnore"]; ok {
-					d.ImportIgnore = strings.Split(attr, ";")
+				case "false":
+					v.g.Infof("Skipping tags test for %s", f.QualifiedName())
+					skip = true
 
-					for i, val := range d.ImportIgnore {
-						d.ImportIgnore[i] = namesgen.ConstOrQuote(val)
-					}
-				}
-				if attr, ok := args.Keyword["importStateId"]; ok {
-					d.ImportStateID = attr
-				}
-				if attr, ok := args.Keyword["importStateIdAttribute"]; ok {
-					d.importStateIDAttribute = attr
-				}
-				if attr, ok := args.Keyword["importStateIdFunc"]; ok {
-					d.ImportStateIDFunc = attr
-				}
-				if attr, ok := args.Keyword["name"]; ok {
-					d.Name = strings.ReplaceAll(attr, " ", "")
-				}
-				if attr, ok := args.Keyword["noImport"]; ok {
-					if b, err := strconv.ParseBool(attr); err != nil {
-						v.errs = append(v.errs, fmt.Errorf("invalid noImport value: %q at %s. Should be boolean value.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
-						continue
-					} else {
-						d.NoImport = b
-					}
-				}
-				if attr, ok := args.Keyword["preCheck"]; ok {
-					if b, err := strconv.ParseBool(attr); err != nil {
-						v.errs = append(v.errs, fmt.Errorf("invalid preCheck value: %q at %s. Should be boolean value.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
-						continue
-					} else {
-						d.PreCheck = b
-					}
+				default:
+					return fmt.Errorf("invalid tagsTest value: %q at %s.", attr, f.QualifiedName())
 				}
-				if attr, ok := args.Keyword["serialize"]; ok {
-					if b, err := strconv.ParseBool(attr); err != nil {
-						v.errs = append(v.errs, fmt.Errorf("invalid serialize value: %q at %s. Should be boolean value.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
-						continue
-					} else {
-						d.Serialize = b
-					}
-				}
-				if attr, ok := args.Keyword["serializeParallelTests"]; ok {
-					if b, err := strconv.ParseBool(attr); err != nil {
-						v.errs = append(v.errs, fmt.Errorf("invalid serializeParallelTests value: %q at %s. Should be boolean value.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
-						continue
-					} else {
-						d.SerializeParallelTests = b
-					}
-				}
-				if attr, ok := args.Keyword["serializeDelay"]; ok {
-					if b, err := strconv.ParseBool(attr); err != nil {
-						v.errs = append(v.errs, fmt.Errorf("invalid serializeDelay value: %q at %s. Should be duration value.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
-						continue
-					} else {
-						d.SerializeDelay = b
-					}
-				}
-				if attr, ok := args.Keyword["tagsIdentifierAttribute"]; ok {
-					d.overrideIdentifierAttribute = attr
-				}
-				if attr, ok := args.Keyword["tagsResourceType"]; ok {
-					d.OverrideResourceType = attr
-				}
-				if attr, ok := args.Keyword["tagsTest"]; ok {
-					switch attr {
-					case "true":
-						// Add tagging tests for non-transparent tagging resources
-						tagged = true
-
-					case "false":
-						v.g.Infof("Skipping tags test for %s.%s", v.packageName, v.functionName)
-						skip = true
-
-					default:
-						v.errs = append(v.errs, fmt.Errorf("invalid tagsTest value: %q at %s.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
-						continue
-					}
-				}
-				// TODO: should probably be a parameter on @Tags
-				if attr, ok := args.Keyword["tagsUpdateForceNew"]; ok {
-					if b, err := strconv.ParseBool(attr); err != nil {
-						v.errs = append(v.errs, fmt.Errorf("invalid tagsUpdateForceNew value: %q at %s. Should be boolean value.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
-						continue
-					} else {
-						d.TagsUpdateForceNew = b
-					}
-				}
-				if attr, ok := args.Keyword["tagsUpdateGetTagsIn"]; ok {
-					if b, err := strconv.ParseBool(attr); err != nil {
-						v.errs = append(v.errs, fmt.Errorf("invalid tagsUpdateGetTagsIn value: %q at %s. Should be boolean value.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
-						continue
-					} else {
-						d.TagsUpdateGetTagsIn = b
-					}
-				}
-				if attr, ok := args.Keyword["skipEmptyTags"]; ok {
-					if b, err := strconv.ParseBool(attr); err != nil {
-						v.errs = append(v.errs, fmt.Errorf("invalid skipEmptyTags value: %q at %s. Should be boolean value.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
-						continue
-					} else {
-						d.SkipEmptyTags = b
-					}
+			}
+			// TODO: should probably be a parameter on @Tags
+			if attr, ok := args.Keyword["tagsUpdateForceNew"]; ok {
+				if b, err := strconv.ParseBool(attr); err != nil {
+					return fmt.Errorf("invalid tagsUpdateForceNew value: %q at %s. Should be boolean value.", attr, f.QualifiedName())
+				} else {
+					d.TagsUpdateForceNew = b
 				}
-				if attr, ok := args.Keyword["skipNullTags"]; ok {
-					if b, err := strconv.ParseBool(attr); err != nil {
-						v.errs = append(v.errs, fmt.Errorf("invalid skipNullTags value: %q at %s. Should be boolean value.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
-						continue
-					} else {
-						d.SkipNullTags = b
-					}
+			}
+			if attr, ok := args.Keyword["tagsUpdateGetTagsIn"]; ok {
+				if b, err := strconv.ParseBool(attr); err != nil {
+					return fmt.Errorf("invalid tagsUpdateGetTagsIn value: %q at %s. Should be boolean value.", attr, f.QualifiedName())
+				} else {
+					d.TagsUpdateGetTagsIn = b
 				}
-				if attr, ok := args.Keyword["noRemoveTags"]; ok {
-					if b, err := strconv.ParseBool(attr); err != nil {
-						v.errs = append(v.errs, fmt.Errorf("invalid noRemoveTags value: %q at %s. Should be boolean value.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
-						continue
-					} else {
-						d.NoRemoveTags = b
-					}
+			}
+			if attr, ok := args.Keyword["skipEmptyTags"]; ok {
+				if b, err := strconv.ParseBool(attr); err != nil {
+					return fmt.Errorf("invalid skipEmptyTags value: %q at %s. Should be boolean value.", attr, f.QualifiedName())
+				} else {
+					d.SkipEmptyTags = b
 				}
-				if attr, ok := args.Keyword["tlsKey"]; ok {
-					if b, err := strconv.ParseBool(attr); err != nil {
-						v.errs = append(v.errs, fmt.Errorf("invalid skipEmptyTags value: %q at %s. Should be boolean value.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
-						continue
-					} else {
-						tlsKey = b
-					}
+			}
+			if attr, ok := args.Keyword["skipNullTags"]; ok {
+				if b, err := strconv.ParseBool(attr); err != nil {
+					return fmt.Errorf("invalid skipNullTags value: %q at %s. Should be boolean value.", attr, f.QualifiedName())
+				} else {
+					d.SkipNullTags = b
 				}
-				if attr, ok := args.Keyword["tlsKeyDomain"]; ok {
-					tlsKeyCN = attr
+			}
+			if attr, ok := args.Keyword["noRemoveTags"]; ok {
+				if b, err := strconv.ParseBool(attr); err != nil {
+					return fmt.Errorf("invalid noRemoveTags value: %q at %s. Should be boolean value.", attr, f.QualifiedName())
+				} else {
+					d.NoRemoveTags = b
 				}
 			}
 		}
 	}
 
-	if tlsKey {
-		if len(tlsKeyCN) == 0 {
-			tlsKeyCN = "acctest.RandomDomain().String()"
-			d.GoImports = append(d.GoImports,
-				goImport{
-					Path: "github.com/hashicorp/terraform-provider-aws/internal/acctest",
-				},
-			)
-		}
-		d.InitCodeBlocks = append(d.InitCodeBlocks, codeBlock{
-			Code: fmt.Sprintf(`privateKeyPEM := acctest.TLSRSAPrivateKeyPEM(t, 2048)
-			certificatePEM := acctest.TLSRSAX509SelfSignedCertificatePEM(t, privateKeyPEM, %s)`, tlsKeyCN),
-		})
-		d.additionalTfVars["certificate_pem"] = "certificatePEM"
-		d.additionalTfVars["private_key_pem"] = "privateKeyPEM"
+	if f.TLSKey && len(f.TLSKeyDomain) == 0 {
+		d.GoImports = append(d.GoImports,
+			common.GoImport{
+				Path: "github.com/hashicorp/terraform-provider-aws/internal/acctest",
+			},
+		)
 	}
 
 	if tagged {
 		if !skip {
 			if d.Name == "" {
-				v.errs = append(v.errs, fmt.Errorf("no name parameter set: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
-				return
+				return fmt.Errorf("no name parameter set: %s", f.QualifiedName())
 			}
 			if !hasIdentifierAttribute && len(d.overrideIdentifierAttribute) == 0 {
-				v.errs = append(v.errs, fmt.Errorf("@Tags specification for %s does not use identifierAttribute. Missing @Testing(tagsIdentifierAttribute) and possibly tagsResourceType", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
-				return
+				return fmt.Errorf("@Tags specification for %s does not use identifierAttribute. Missing @Testing(tagsIdentifierAttribute) and possibly tagsResourceType", f.QualifiedName())
 			}
-			if !generatorSeen {
+			if !f.GeneratorSeen {
 				d.Generator = "sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)"
 				d.GoImports = append(d.GoImports,
-					goImport{
+					common.GoImport{
 						Path:  "github.com/hashicorp/terraform-plugin-testing/helper/acctest",
 						Alias: "sdkacctest",
 					},
-					goImport{
+					common.GoImport{
 						Path: "github.com/hashicorp/terraform-provider-aws/internal/acctest",
 					},
 				)
@@ -822,17 +423,7 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 		}
 	}
 
-	v.functionName = ""
-}
-
-// Visit is called for each node visited by ast.Walk.
-func (v *visitor) Visit(node ast.Node) ast.Visitor {
-	// Look at functions (not methods) with comments.
-	if funcDecl, ok := node.(*ast.FuncDecl); ok && funcDecl.Recv == nil && funcDecl.Doc != nil {
-		v.processFuncDecl(funcDecl)
-	}
-
-	return v
+	return nil
 }
 
 func generateTestConfig(g *common.Generator, dirPath, test string, withDefaults bool, tfTemplates *template.Template, common commonConfig) {
@@ -863,28 +454,6 @@ func generateTestConfig(g *common.Generator, dirPath, test string, withDefaults
 	}
 }
 
-func parseIdentifierSpec(s string) (string, *goImport, error) {
-	parts := strings.Split(s, ";")
-	switch len(parts) {
-	case 1:
-		return parts[0], nil, nil
-
-	case 2:
-		return parts[1], &goImport{
-			Path: parts[0],
-		}, nil
-
-	case 3:
-		return parts[2], &goImport{
-			Path:  parts[0],
-			Alias: parts[1],
-		}, nil
-
-	default:
-		return "", nil, fmt.Errorf("invalid generator value: %q", s)
-	}
-}
-
 func generateDurationStatement(d time.Duration) string {
 	var buf strings.Builder
 
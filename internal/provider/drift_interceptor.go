@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/interceptors"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// driftInterceptor reports resource attributes changed outside of Terraform.
+type driftInterceptor struct {
+	schema func() map[string]*schema.Schema
+}
+
+// newDriftInterceptor returns an interceptor that reports drift for a resource with the specified schema.
+// The schema is not obtained until drift diagnostics are first reported, so nothing is built for resources when drift diagnostics are disabled.
+func newDriftInterceptor(schemaMap func() map[string]*schema.Schema) interceptor {
+	return &driftInterceptor{
+		schema: sync.OnceValue(schemaMap),
+	}
+}
+
+func (r driftInterceptor) run(ctx context.Context, opts interceptorOptions) diag.Diagnostics {
+	c := opts.c
+	var diags diag.Diagnostics
+
+	if !c.DriftDiagnostics(ctx) {
+		return diags
+	}
+
+	schemaMap := r.schema()
+
+	switch d, when, why := opts.d, opts.when, opts.why; when {
+	case After:
+		switch why {
+		case Read:
+			rawState := d.GetRawState()
+			if rawState.IsNull() || !rawState.Type().IsObjectType() || !rawState.Type().HasAttribute(names.AttrID) {
+				return diags
+			}
+			var id string
+			if v := rawState.GetAttr(names.AttrID); v.IsKnown() && !v.IsNull() {
+				id = v.AsString()
+			}
+			if id == "" {
+				return diags
+			}
+
+			var attributes []interceptors.DriftedAttribute
+			if d.Id() != "" {
+				var priorValues int
+				for _, name := range slices.Sorted(maps.Keys(schemaMap)) {
+					o, _ := d.GetChange(name)
+					o, n := normalizeDriftValue(o), normalizeDriftValue(d.Get(name))
+
+					if name != names.AttrRegion && !isZeroDriftValue(o) {
+						priorValues++
+					}
+
+					if reflect.DeepEqual(o, n) || (isZeroDriftValue(o) && isZeroDriftValue(n)) {
+						continue
+					}
+
+					attributes = append(attributes, interceptors.DriftedAttribute{
+						Name:      name,
+						Old:       driftValueString(o),
+						New:       driftValueString(n),
+						Sensitive: isSensitiveSchema(schemaMap[name]),
+					})
+				}
+
+				// On import the prior state contains only the resource ID and at most one other identifying attribute.
+				if priorValues <= 1 || len(attributes) == 0 {
+					return diags
+				}
+			}
+
+			typeName := "resource"
+			if inContext, ok := conns.FromContext(ctx); ok {
+				typeName = inContext.TypeName()
+			}
+
+			identifiers := []string{id}
+			if _, ok := schemaMap[names.AttrARN]; ok {
+				o, _ := d.GetChange(names.AttrARN)
+				if v, ok := o.(string); ok {
+					identifiers = append(identifiers, v)
+				}
+			}
+			events := interceptors.LookupDriftEvents(ctx, c, identifiers...)
+
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  interceptors.DriftSummary(typeName),
+				Detail:   interceptors.DriftDetail(id, attributes, events),
+			})
+		}
+	}
+
+	return diags
+}
+
+// normalizeDriftValue converts sets, at any level of nesting, to lists so that values can be compared and rendered.
+func normalizeDriftValue(v any) any {
+	switch v := v.(type) {
+	case *schema.Set:
+		return normalizeDriftValue(v.List())
+	case []any:
+		return tfslices.ApplyToAll(v, normalizeDriftValue)
+	case map[string]any:
+		m := make(map[string]any, len(v))
+		for k, v := range v {
+			m[k] = normalizeDriftValue(v)
+		}
+		return m
+	default:
+		return v
+	}
+}
+
+func isZeroDriftValue(v any) bool {
+	if v == nil {
+		return true
+	}
+
+	switch v := reflect.ValueOf(v); v.Kind() {
+	case reflect.Map, reflect.Slice:
+		return v.Len() == 0
+	default:
+		return v.IsZero()
+	}
+}
+
+func driftValueString(v any) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+
+	return string(b)
+}
+
+// isSensitiveSchema returns whether the schema, or any nested schema, is sensitive.
+func isSensitiveSchema(s *schema.Schema) bool {
+	if s.Sensitive {
+		return true
+	}
+
+	if v, ok := s.Elem.(*schema.Resource); ok {
+		for _, v := range v.SchemaMap() {
+			if isSensitiveSchema(v) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestDriftInterceptorDisabled(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	var calls int
+	interceptor := newDriftInterceptor(func() map[string]*schema.Schema {
+		calls++
+		return map[string]*schema.Schema{}
+	})
+
+	diags := interceptor.run(ctx, interceptorOptions{
+		c:    new(conns.AWSClient),
+		when: After,
+		why:  Read,
+	})
+
+	if diags.HasError() {
+		t.Errorf("unexpected error: %v", diags)
+	}
+	if calls != 0 {
+		t.Errorf("schema built %d times with drift diagnostics disabled, want 0", calls)
+	}
+}
+
+func TestDriftValueString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    any
+		expected string
+	}{
+		"string": {
+			value:    "example",
+			expected: `"example"`,
+		},
+		"int": {
+			value:    30,
+			expected: "30",
+		},
+		"set": {
+			value:    schema.NewSet(schema.HashString, []any{"a"}),
+			expected: `["a"]`,
+		},
+		"list of nested sets": {
+			value: []any{
+				map[string]any{
+					"values": schema.NewSet(schema.HashString, []any{"b"}),
+				},
+			},
+			expected: `[{"values":["b"]}]`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := driftValueString(normalizeDriftValue(testCase.value)), testCase.expected; got != want {
+				t.Errorf("driftValueString = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestIsSensitiveSchema(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema   *schema.Schema
+		expected bool
+	}{
+		"not sensitive": {
+			schema: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"sensitive": {
+			schema: &schema.Schema{
+				Type:      schema.TypeString,
+				Sensitive: true,
+			},
+			expected: true,
+		},
+		"nested sensitive": {
+			schema: &schema.Schema{
+				Type: schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type: schema.TypeString,
+						},
+						"password": {
+							Type:      schema.TypeString,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := isSensitiveSchema(testCase.schema), testCase.expected; got != want {
+				t.Errorf("isSensitiveSchema = %t, want %t", got, want)
+			}
+		})
+	}
+}
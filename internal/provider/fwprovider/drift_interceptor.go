@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/interceptors"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// driftInterceptor reports resource attributes changed outside of Terraform.
+type driftInterceptor struct{}
+
+func newDriftInterceptor() resourceInterceptor {
+	return &driftInterceptor{}
+}
+
+func (r driftInterceptor) create(ctx context.Context, opts interceptorOptions[resource.CreateRequest, resource.CreateResponse]) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	return diags
+}
+
+func (r driftInterceptor) read(ctx context.Context, opts interceptorOptions[resource.ReadRequest, resource.ReadResponse]) diag.Diagnostics {
+	c := opts.c
+	var diags diag.Diagnostics
+
+	if c == nil || !c.DriftDiagnostics(ctx) {
+		return diags
+	}
+
+	switch request, response, when := opts.request, opts.response, opts.when; when {
+	case After:
+		if request.State.Raw.IsNull() {
+			return diags
+		}
+
+		var prior map[string]tftypes.Value
+		if err := request.State.Raw.As(&prior); err != nil {
+			return diags
+		}
+
+		id, arn := driftStringValue(prior[names.AttrID]), driftStringValue(prior[names.AttrARN])
+		if id == "" {
+			id = arn
+		}
+		if id == "" {
+			return diags
+		}
+
+		var attributes []interceptors.DriftedAttribute
+		if !response.State.Raw.IsNull() {
+			var current map[string]tftypes.Value
+			if err := response.State.Raw.As(&current); err != nil {
+				return diags
+			}
+
+			var priorValues int
+			for _, name := range slices.Sorted(maps.Keys(prior)) {
+				o, n := prior[name], current[name]
+
+				if name != names.AttrID && name != names.AttrRegion && !o.IsNull() {
+					priorValues++
+				}
+
+				if o.Equal(n) {
+					continue
+				}
+
+				path := tftypes.NewAttributePath().WithAttributeName(name)
+				attributes = append(attributes, interceptors.DriftedAttribute{
+					Name:      name,
+					Old:       driftValueString(ctx, request.State, path, o),
+					New:       driftValueString(ctx, response.State, path, n),
+					Sensitive: isSensitiveValue(ctx, request.State, path, o) || isSensitiveValue(ctx, response.State, path, n),
+				})
+			}
+
+			// On import the prior state contains only the resource ID and at most one other identifying attribute.
+			if priorValues <= 1 || len(attributes) == 0 {
+				return diags
+			}
+		}
+
+		typeName := "resource"
+		if inContext, ok := conns.FromContext(ctx); ok {
+			typeName = inContext.TypeName()
+		}
+
+		events := interceptors.LookupDriftEvents(ctx, c, id, arn)
+
+		diags.AddWarning(interceptors.DriftSummary(typeName), interceptors.DriftDetail(id, attributes, events))
+	}
+
+	return diags
+}
+
+func (r driftInterceptor) update(ctx context.Context, opts interceptorOptions[resource.UpdateRequest, resource.UpdateResponse]) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	return diags
+}
+
+func (r driftInterceptor) delete(ctx context.Context, opts interceptorOptions[resource.DeleteRequest, resource.DeleteResponse]) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	return diags
+}
+
+func driftStringValue(v tftypes.Value) string {
+	var s string
+
+	if !v.IsKnown() || v.IsNull() || !v.Type().Is(tftypes.String) {
+		return s
+	}
+	if err := v.As(&s); err != nil {
+		return ""
+	}
+
+	return s
+}
+
+// driftValueString returns the string representation of the Terraform value at the specified path.
+func driftValueString(ctx context.Context, state tfsdk.State, path *tftypes.AttributePath, v tftypes.Value) string {
+	if t, err := state.Schema.TypeAtTerraformPath(ctx, path); err == nil {
+		if v, err := t.ValueFromTerraform(ctx, v); err == nil {
+			return v.String()
+		}
+	}
+
+	return v.String()
+}
+
+// isSensitiveValue returns whether the attribute at the specified path, or any nested attribute, is sensitive.
+func isSensitiveValue(ctx context.Context, state tfsdk.State, root *tftypes.AttributePath, v tftypes.Value) bool {
+	var sensitive bool
+
+	_ = tftypes.Walk(v, func(p *tftypes.AttributePath, _ tftypes.Value) (bool, error) {
+		path := tftypes.NewAttributePathWithSteps(append(slices.Clone(root.Steps()), p.Steps()...))
+		// Paths inside blocks and non-nested attributes do not resolve to an attribute.
+		if attr, err := state.Schema.AttributeAtTerraformPath(ctx, path); err == nil && attr.IsSensitive() {
+			sensitive = true
+		}
+
+		return !sensitive, nil
+	})
+
+	return sensitive
+}
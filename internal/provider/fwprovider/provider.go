@@ -61,10 +61,22 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"batch_tagging": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to update the tags of resources in services supporting the Resource Groups Tagging API in batches. Can also be configured with the " + conns.BatchTaggingEnvVar + " environment variable.",
+			},
 			"custom_ca_bundle": schema.StringAttribute{
 				Optional:    true,
 				Description: "File containing custom root and intermediate certificates. Can also be configured using the `AWS_CA_BUNDLE` environment variable. (Setting `ca_bundle` in the shared config file is not supported.)",
 			},
+			"drift_diagnostics": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to report resource attributes changed outside of Terraform as warnings when refreshing. Can also be configured with the " + conns.DriftDiagnosticsEnvVar + " environment variable.",
+			},
+			"drift_diagnostics_cloudtrail": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether drift diagnostics include recent CloudTrail events for the resource. Can also be configured with the " + conns.DriftDiagnosticsCloudTrailEnvVar + " environment variable.",
+			},
 			"ec2_metadata_service_endpoint": schema.StringAttribute{
 				Optional:    true,
 				Description: "Address of the EC2 metadata service endpoint to use. Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT` environment variable.",
@@ -171,6 +183,45 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 			},
 		},
 		Blocks: map[string]schema.Block{
+			"api_limits": schema.ListNestedBlock{
+				Description: "Configuration blocks with per-service limits on AWS API calls.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_concurrent_requests": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of concurrent requests to the service's API.",
+						},
+						"requests_per_second": schema.Float64Attribute{
+							Optional:    true,
+							Description: "The maximum number of requests per second to the service's API.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service to limit, using the same names as the `endpoints` block.",
+						},
+					},
+				},
+			},
+			"api_telemetry": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to record AWS API call telemetry.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"file": schema.StringAttribute{
+							Optional: true,
+							Description: "File to which AWS API call telemetry is appended as calls complete. " +
+								"Can also be configured with the " + conns.APITelemetryFileEnvVar + " environment variable.",
+						},
+						"format": schema.StringAttribute{
+							Optional: true,
+							Description: "Format of the AWS API call telemetry file. " +
+								"Can also be configured with the " + conns.APITelemetryFormatEnvVar + " environment variable.",
+						},
+					},
+				},
+			},
 			"assume_role": schema.ListNestedBlock{
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
@@ -293,6 +344,47 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 					},
 				},
 			},
+			"tag_policy": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with a tag policy enforced on resource tags at plan time.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"case_insensitive": schema.BoolAttribute{
+							Optional:    true,
+							Description: "Whether tag keys and allowed value patterns are matched case-insensitively.",
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"tag": schema.ListNestedBlock{
+							Description: "Policy rules for a single tag key.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"allowed_value_patterns": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Regular expressions, one of which the tag value must match.",
+									},
+									names.AttrKey: schema.StringAttribute{
+										Required:    true,
+										Description: "The tag key.",
+									},
+									"precedence": schema.StringAttribute{
+										Optional: true,
+										Description: "Whether the `default_tags` value (`provider`) or the resource `tags` value (`resource`) wins. " +
+											"Resources may not override a `provider` tag. Defaults to `resource`.",
+									},
+									"required": schema.BoolAttribute{
+										Optional:    true,
+										Description: "Whether the tag must be set on every taggable resource, either directly or through `default_tags`.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -333,12 +425,16 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 
 			typeName := v.TypeName
 			interceptors := dataSourceInterceptors{}
+			schemaResponse := datasource.SchemaResponse{}
+			inner.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
+
+			// Inject the `region` attribute unless the data source is global or already defines it.
+			_, hasRegion := schemaResponse.Schema.Attributes[names.AttrRegion]
+			isRegionOverrideEnabled := v.Region.IsRegionOverrideEnabled() && !hasRegion
+
 			if v.Tags != nil {
 				// The data source has opted in to transparent tagging.
 				// Ensure that the schema look OK.
-				schemaResponse := datasource.SchemaResponse{}
-				inner.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
-
 				if v, ok := schemaResponse.Schema.Attributes[names.AttrTags]; ok {
 					if !v.IsComputed() {
 						errs = append(errs, fmt.Errorf("`%s` attribute must be Computed: %s", names.AttrTags, typeName))
@@ -354,10 +450,15 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 
 			opts := wrappedDataSourceOptions{
 				// bootstrapContext is run on all wrapped methods before any interceptors.
-				bootstrapContext: func(ctx context.Context, _ getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
+				bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
 					var diags diag.Diagnostics
 
-					ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+					var overrideRegion string
+					if isRegionOverrideEnabled {
+						overrideRegion = overrideRegionFromAttribute(ctx, getAttribute)
+					}
+
+					ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name, typeName, overrideRegion)
 					if c != nil {
 						ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx))
 						ctx = c.RegisterLogger(ctx)
@@ -366,8 +467,9 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 
 					return ctx, diags
 				},
-				interceptors: interceptors,
-				typeName:     typeName,
+				interceptors:            interceptors,
+				isRegionOverrideEnabled: isRegionOverrideEnabled,
+				typeName:                typeName,
 			}
 			dataSources = append(dataSources, func() datasource.DataSource {
 				return newWrappedDataSource(inner, opts)
@@ -406,12 +508,21 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 			typeName := v.TypeName
 			var modifyPlanFuncs []modifyPlanFunc
 			interceptors := resourceInterceptors{}
+			schemaResponse := resource.SchemaResponse{}
+			inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+			// Inject the `region` attribute unless the resource is global or already defines it.
+			_, hasRegion := schemaResponse.Schema.Attributes[names.AttrRegion]
+			isRegionOverrideEnabled := v.Region.IsRegionOverrideEnabled() && !hasRegion
+			if isRegionOverrideEnabled {
+				modifyPlanFuncs = append(modifyPlanFuncs, setRegionInPlan)
+			}
+
+			// After interceptors are run last to first, so drift is reported once all others have updated state.
+			interceptors = append(interceptors, newDriftInterceptor())
 			if v.Tags != nil {
 				// The resource has opted in to transparent tagging.
 				// Ensure that the schema look OK.
-				schemaResponse := resource.SchemaResponse{}
-				inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
-
 				if v, ok := schemaResponse.Schema.Attributes[names.AttrTags]; ok {
 					if v.IsComputed() {
 						errs = append(errs, fmt.Errorf("`%s` attribute cannot be Computed: %s", names.AttrTags, typeName))
@@ -437,10 +548,16 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 
 			opts := wrappedResourceOptions{
 				// bootstrapContext is run on all wrapped methods before any interceptors.
-				bootstrapContext: func(ctx context.Context, _ getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
+				bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
 					var diags diag.Diagnostics
 
-					ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+					var overrideRegion string
+					if isRegionOverrideEnabled {
+						overrideRegion = overrideRegionFromAttribute(ctx, getAttribute)
+					}
+
+					ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, typeName, overrideRegion)
+					ctx = conns.WithResourceID(ctx, resourceIDFromAttribute(ctx, getAttribute))
 					if c != nil {
 						ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx))
 						ctx = c.RegisterLogger(ctx)
@@ -449,9 +566,10 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 
 					return ctx, diags
 				},
-				interceptors:    interceptors,
-				modifyPlanFuncs: modifyPlanFuncs,
-				typeName:        typeName,
+				interceptors:            interceptors,
+				isRegionOverrideEnabled: isRegionOverrideEnabled,
+				modifyPlanFuncs:         modifyPlanFuncs,
+				typeName:                typeName,
 			}
 			resources = append(resources, func() resource.Resource {
 				return newWrappedResource(inner, opts)
@@ -493,12 +611,24 @@ func (p *fwprovider) EphemeralResources(ctx context.Context) []func() ephemeral.
 				}
 
 				interceptors := ephemeralResourceInterceptors{}
+				schemaResponse := ephemeral.SchemaResponse{}
+				inner.Schema(ctx, ephemeral.SchemaRequest{}, &schemaResponse)
+
+				// Inject the `region` attribute unless the ephemeral resource is global or already defines it.
+				_, hasRegion := schemaResponse.Schema.Attributes[names.AttrRegion]
+				isRegionOverrideEnabled := v.Region.IsRegionOverrideEnabled() && !hasRegion
+
 				opts := wrappedEphemeralResourceOptions{
 					// bootstrapContext is run on all wrapped methods before any interceptors.
-					bootstrapContext: func(ctx context.Context, _ getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
+					bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
 						var diags diag.Diagnostics
 
-						ctx = conns.NewEphemeralResourceContext(ctx, servicePackageName, v.Name)
+						var overrideRegion string
+						if isRegionOverrideEnabled {
+							overrideRegion = overrideRegionFromAttribute(ctx, getAttribute)
+						}
+
+						ctx = conns.NewEphemeralResourceContext(ctx, servicePackageName, v.Name, v.TypeName, overrideRegion)
 						if c != nil {
 							ctx = c.RegisterLogger(ctx)
 							ctx = flex.RegisterLogger(ctx)
@@ -506,8 +636,9 @@ func (p *fwprovider) EphemeralResources(ctx context.Context) []func() ephemeral.
 						}
 						return ctx, diags
 					},
-					interceptors: interceptors,
-					typeName:     v.TypeName,
+					interceptors:            interceptors,
+					isRegionOverrideEnabled: isRegionOverrideEnabled,
+					typeName:                v.TypeName,
 				}
 				ephemeralResources = append(ephemeralResources, func() ephemeral.EphemeralResource {
 					return newWrappedEphemeralResource(inner, opts)
@@ -534,6 +665,12 @@ func (p *fwprovider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewEC2PrivateDNSNameFunction,
+		tffunction.NewIAMPolicyEquivalentFunction,
+		tffunction.NewIAMPolicyNormalizeFunction,
+		tffunction.NewS3URIParseFunction,
+		tffunction.NewTagsMergeFunction,
 		tffunction.NewTrimIAMRolePathFunction,
+		tffunction.NewVPCSubnetCIDRFunction,
 	}
 }
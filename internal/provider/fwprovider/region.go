@@ -0,0 +1,300 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	ephemeralschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	regionAttributeDescription = "The AWS Region to use for API operations. Defaults to the Region set in the provider configuration."
+)
+
+// regionDataSourceSchemaAttribute returns the schema for the `region` attribute injected into regional data sources.
+func regionDataSourceSchemaAttribute() datasourceschema.Attribute {
+	return datasourceschema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			fwvalidators.AWSRegion(),
+		},
+		Description: regionAttributeDescription,
+	}
+}
+
+// regionEphemeralResourceSchemaAttribute returns the schema for the `region` attribute injected into regional ephemeral resources.
+func regionEphemeralResourceSchemaAttribute() ephemeralschema.Attribute {
+	return ephemeralschema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			fwvalidators.AWSRegion(),
+		},
+		Description: regionAttributeDescription,
+	}
+}
+
+// regionResourceSchemaAttribute returns the schema for the `region` attribute injected into regional resources.
+// Replacement on change is handled by setRegionInPlan.
+func regionResourceSchemaAttribute() resourceschema.Attribute {
+	return resourceschema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			fwvalidators.AWSRegion(),
+		},
+		Description: regionAttributeDescription,
+	}
+}
+
+// overrideRegionFromAttribute returns the value of any configured per-resource Region override.
+func overrideRegionFromAttribute(ctx context.Context, getAttribute getAttributeFunc) string {
+	if getAttribute == nil {
+		return ""
+	}
+
+	var region types.String
+	if diags := getAttribute(ctx, path.Root(names.AttrRegion), &region); diags.HasError() {
+		return ""
+	}
+
+	return region.ValueString()
+}
+
+// setRegionInPlan is a modifyPlanFunc that defaults the `region` attribute to the provider-configured Region
+// and requires replacement if the Region changes.
+func setRegionInPlan(ctx context.Context, c *conns.AWSClient, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// Exit early if this is a destroy.
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var configRegion, stateRegion types.String
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root(names.AttrRegion), &configRegion)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	exists := !request.State.Raw.IsNull()
+	if exists {
+		response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root(names.AttrRegion), &stateRegion)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// If a Region is configured (or is unknown), use it.
+	planRegion := configRegion
+	if configRegion.IsNull() {
+		// Don't force replacement of resources created before the attribute was introduced.
+		if exists && stateRegion.ValueString() == "" {
+			return
+		}
+
+		planRegion = types.StringValue(c.AwsConfig(ctx).Region)
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrRegion), planRegion)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if exists && stateRegion.ValueString() != "" && !planRegion.Equal(stateRegion) {
+		response.RequiresReplace = append(response.RequiresReplace, path.Root(names.AttrRegion))
+	}
+}
+
+// The `region` attribute is not present in the schemas (and hence models) of the wrapped resources, data sources and ephemeral resources.
+// The wrappers strip the attribute from any values passed to the inner implementation and restore it in any values returned.
+
+// stripRegion returns a copy of the specified object value without any `region` attribute.
+func stripRegion(v tftypes.Value) (tftypes.Value, error) {
+	typ, ok := v.Type().(tftypes.Object)
+	if !ok {
+		return v, nil
+	}
+
+	if _, ok := typ.AttributeTypes[names.AttrRegion]; !ok {
+		return v, nil
+	}
+
+	innerType := tftypes.Object{
+		AttributeTypes: maps.Clone(typ.AttributeTypes),
+	}
+	delete(innerType.AttributeTypes, names.AttrRegion)
+	if len(typ.OptionalAttributes) > 0 {
+		innerType.OptionalAttributes = maps.Clone(typ.OptionalAttributes)
+		delete(innerType.OptionalAttributes, names.AttrRegion)
+	}
+
+	if !v.IsKnown() {
+		return tftypes.NewValue(innerType, tftypes.UnknownValue), nil
+	}
+	if v.IsNull() {
+		return tftypes.NewValue(innerType, nil), nil
+	}
+
+	var attrs map[string]tftypes.Value
+	if err := v.As(&attrs); err != nil {
+		return tftypes.Value{}, fmt.Errorf("stripping %s attribute: %w", names.AttrRegion, err)
+	}
+	delete(attrs, names.AttrRegion)
+
+	return tftypes.NewValue(innerType, attrs), nil
+}
+
+// restoreRegion returns a copy of the specified object value with the `region` attribute set.
+func restoreRegion(v tftypes.Value, outerType tftypes.Type, region tftypes.Value) (tftypes.Value, error) {
+	if v.Type() == nil || outerType == nil {
+		return v, nil
+	}
+
+	if !v.IsKnown() {
+		return tftypes.NewValue(outerType, tftypes.UnknownValue), nil
+	}
+	if v.IsNull() {
+		return tftypes.NewValue(outerType, nil), nil
+	}
+
+	var attrs map[string]tftypes.Value
+	if err := v.As(&attrs); err != nil {
+		return tftypes.Value{}, fmt.Errorf("restoring %s attribute: %w", names.AttrRegion, err)
+	}
+	attrs[names.AttrRegion] = region
+
+	return tftypes.NewValue(outerType, attrs), nil
+}
+
+// regionValue returns the `region` attribute value from the specified object value.
+func regionValue(v tftypes.Value) tftypes.Value {
+	if v.Type() != nil && v.IsKnown() && !v.IsNull() {
+		var attrs map[string]tftypes.Value
+		if err := v.As(&attrs); err == nil {
+			if v, ok := attrs[names.AttrRegion]; ok {
+				return v
+			}
+		}
+	}
+
+	return tftypes.NewValue(tftypes.String, nil)
+}
+
+// regionStringValue returns a Terraform string value for the specified Region.
+func regionStringValue(region string) tftypes.Value {
+	return tftypes.NewValue(tftypes.String, region)
+}
+
+// regionFromRawState returns the `region` attribute value from JSON-encoded raw state.
+func regionFromRawState(raw []byte) tftypes.Value {
+	var attrs map[string]any
+	if err := json.Unmarshal(raw, &attrs); err == nil {
+		if v, ok := attrs[names.AttrRegion].(string); ok && v != "" {
+			return regionStringValue(v)
+		}
+	}
+
+	return tftypes.NewValue(tftypes.String, nil)
+}
+
+// regionShim converts values between a wrapper's schema, which includes the `region` attribute, and the inner schema.
+// Any errors are accumulated in diags.
+type regionShim struct {
+	diags diag.Diagnostics
+}
+
+func (s *regionShim) strip(v tftypes.Value) tftypes.Value {
+	v, err := stripRegion(v)
+	if err != nil {
+		s.diags.AddError("Converting value", err.Error())
+	}
+
+	return v
+}
+
+func (s *regionShim) restore(v tftypes.Value, outerType tftypes.Type, region tftypes.Value) tftypes.Value {
+	v, err := restoreRegion(v, outerType, region)
+	if err != nil {
+		s.diags.AddError("Converting value", err.Error())
+	}
+
+	return v
+}
+
+// restoreState returns the outer state with the inner state's value and the specified `region` attribute value.
+func (s *regionShim) restoreState(outer, inner tfsdk.State, region tftypes.Value) tfsdk.State {
+	outer.Raw = s.restore(inner.Raw, outer.Raw.Type(), region)
+
+	return outer
+}
+
+// restorePlan returns the outer plan with the inner plan's value and the specified `region` attribute value.
+func (s *regionShim) restorePlan(outer, inner tfsdk.Plan, region tftypes.Value) tfsdk.Plan {
+	outer.Raw = s.restore(inner.Raw, outer.Raw.Type(), region)
+
+	return outer
+}
+
+// restoreResult returns the outer ephemeral result with the inner result's value and the specified `region` attribute value.
+func (s *regionShim) restoreResult(outer, inner tfsdk.EphemeralResultData, region tftypes.Value) tfsdk.EphemeralResultData {
+	outer.Raw = s.restore(inner.Raw, outer.Raw.Type(), region)
+
+	return outer
+}
+
+// callWithoutRegion calls a wrapped method's inner implementation with request and response values that exclude the `region` attribute.
+// toInner returns the inner request and response, stripping values using the shim.
+// fromInner is called with response set to the inner response and restores the response's values from the original outer response.
+// The outer response's diagnostics are retained and the inner response's diagnostics are appended.
+func callWithoutRegion[Req, Resp any](
+	request Req,
+	response *Resp,
+	diagnostics func(*Resp) *diag.Diagnostics,
+	toInner func(shim *regionShim, request Req, response Resp) (Req, Resp),
+	call func(Req, *Resp),
+	fromInner func(shim *regionShim, outer Resp, response *Resp),
+) {
+	var shim regionShim
+	innerRequest, innerResponse := toInner(&shim, request, *response)
+	*diagnostics(&innerResponse) = nil
+	diagnostics(response).Append(shim.diags...)
+	if diagnostics(response).HasError() {
+		return
+	}
+
+	call(innerRequest, &innerResponse)
+
+	shim = regionShim{}
+	outer := *response
+	*response = innerResponse
+	fromInner(&shim, outer, response)
+
+	diags := *diagnostics(&outer)
+	diags.Append(*diagnostics(&innerResponse)...)
+	diags.Append(shim.diags...)
+	*diagnostics(response) = diags
+}
+
+// stripConfigRegion returns the configuration value without the `region` attribute.
+func stripConfigRegion(config tfsdk.Config) (tftypes.Value, diag.Diagnostics) {
+	var shim regionShim
+	v := shim.strip(config.Raw)
+
+	return v, shim.diags
+}
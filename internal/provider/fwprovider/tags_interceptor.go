@@ -118,7 +118,7 @@ func (r tagsResourceInterceptor) create(ctx context.Context, opts interceptorOpt
 		}
 
 		// Merge the resource's configured tags with any provider configured default_tags.
-		tags := c.DefaultTagsConfig(ctx).MergeTags(tftags.New(ctx, planTags))
+		tags := c.TagPolicyConfig(ctx).MergeTags(tftags.New(ctx, planTags), c.DefaultTagsConfig(ctx))
 		// Remove system tags.
 		tags = tags.IgnoreSystem(sp.ServicePackageName())
 		tagsInContext.TagsIn = option.Some(tags)
@@ -217,7 +217,7 @@ func (r tagsResourceInterceptor) update(ctx context.Context, opts interceptorOpt
 		}
 
 		// Merge the resource's configured tags with any provider configured default_tags.
-		tags := c.DefaultTagsConfig(ctx).MergeTags(tftags.New(ctx, planTags))
+		tags := c.TagPolicyConfig(ctx).MergeTags(tftags.New(ctx, planTags), c.DefaultTagsConfig(ctx))
 		// Remove system tags.
 		tags = tags.IgnoreSystem(sp.ServicePackageName())
 		tagsInContext.TagsIn = option.Some(tags)
@@ -286,7 +286,15 @@ func setTagsAll(ctx context.Context, meta *conns.AWSClient, request resource.Mod
 	}
 
 	if planTags.IsWhollyKnown() {
-		allTags := meta.DefaultTagsConfig(ctx).MergeTags(tftags.New(ctx, planTags)).IgnoreConfig(meta.IgnoreTagsConfig(ctx))
+		newTags := tftags.New(ctx, planTags)
+		for _, err := range meta.TagPolicyConfig(ctx).Validate(newTags, meta.DefaultTagsConfig(ctx)) {
+			response.Diagnostics.AddAttributeError(path.Root(names.AttrTags), "Tag policy violation", err.Error())
+		}
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		allTags := meta.TagPolicyConfig(ctx).MergeTags(newTags, meta.DefaultTagsConfig(ctx)).IgnoreConfig(meta.IgnoreTagsConfig(ctx))
 		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrTagsAll), fwflex.FlattenFrameworkStringValueMapLegacy(ctx, allTags.Map()))...)
 	} else {
 		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrTagsAll), tftags.Unknown)...)
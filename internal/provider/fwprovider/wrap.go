@@ -7,13 +7,21 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	ephemeralschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/interceptors"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // Implemented by (Config|Plan|State).GetAttribute().
@@ -22,11 +30,27 @@ type getAttributeFunc func(context.Context, path.Path, any) diag.Diagnostics
 // contextFunc augments Context.
 type contextFunc func(context.Context, getAttributeFunc, *conns.AWSClient) (context.Context, diag.Diagnostics)
 
+// resourceIDFromAttribute returns the value of the `id` attribute, or "" if the resource has no known ID.
+func resourceIDFromAttribute(ctx context.Context, getAttribute getAttributeFunc) string {
+	if getAttribute == nil {
+		return ""
+	}
+
+	var id types.String
+	if diags := getAttribute(ctx, path.Root(names.AttrID), &id); diags.HasError() {
+		return ""
+	}
+
+	return id.ValueString()
+}
+
 type wrappedDataSourceOptions struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
 	interceptors     dataSourceInterceptors
-	typeName         string
+	// isRegionOverrideEnabled indicates whether the `region` attribute is injected into the schema.
+	isRegionOverrideEnabled bool
+	typeName                string
 }
 
 // wrappedDataSource represents an interceptor dispatcher for a Plugin Framework data source.
@@ -56,6 +80,18 @@ func (w *wrappedDataSource) Schema(ctx context.Context, request datasource.Schem
 	}
 
 	w.inner.Schema(ctx, request, response)
+
+	if w.opts.isRegionOverrideEnabled && response.Schema.Attributes != nil {
+		response.Schema.Attributes[names.AttrRegion] = regionDataSourceSchemaAttribute()
+	}
+}
+
+// innerSchema returns the inner data source's schema, without any `region` attribute.
+func (w *wrappedDataSource) innerSchema(ctx context.Context) (datasourceschema.Schema, diag.Diagnostics) {
+	var response datasource.SchemaResponse
+	w.inner.Schema(ctx, datasource.SchemaRequest{}, &response)
+
+	return response.Schema, response.Diagnostics
 }
 
 func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
@@ -66,7 +102,32 @@ func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadReq
 	}
 
 	f := func(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) diag.Diagnostics {
-		w.inner.Read(ctx, request, response)
+		if !w.opts.isRegionOverrideEnabled {
+			w.inner.Read(ctx, request, response)
+			return response.Diagnostics
+		}
+
+		schema, diags := w.innerSchema(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return response.Diagnostics
+		}
+
+		callWithoutRegion(request, response,
+			func(response *datasource.ReadResponse) *diag.Diagnostics { return &response.Diagnostics },
+			func(shim *regionShim, request datasource.ReadRequest, response datasource.ReadResponse) (datasource.ReadRequest, datasource.ReadResponse) {
+				request.Config = tfsdk.Config{Schema: schema, Raw: shim.strip(request.Config.Raw)}
+				response.State = tfsdk.State{Schema: schema, Raw: shim.strip(response.State.Raw)}
+				return request, response
+			},
+			func(request datasource.ReadRequest, response *datasource.ReadResponse) {
+				w.inner.Read(ctx, request, response)
+			},
+			func(shim *regionShim, outer datasource.ReadResponse, response *datasource.ReadResponse) {
+				response.State = shim.restoreState(outer.State, response.State, regionStringValue(w.meta.Region(ctx)))
+			},
+		)
+
 		return response.Diagnostics
 	}
 	response.Diagnostics.Append(interceptedHandler(w.opts.interceptors.read(), f, w.meta)(ctx, request, response)...)
@@ -112,6 +173,21 @@ func (w *wrappedDataSource) ValidateConfig(ctx context.Context, request datasour
 			return
 		}
 
+		if w.opts.isRegionOverrideEnabled {
+			schema, diags := w.innerSchema(ctx)
+			response.Diagnostics.Append(diags...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			raw, diags := stripConfigRegion(request.Config)
+			response.Diagnostics.Append(diags...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+			request.Config = tfsdk.Config{Schema: schema, Raw: raw}
+		}
+
 		v.ValidateConfig(ctx, request, response)
 	}
 }
@@ -120,7 +196,9 @@ type wrappedEphemeralResourceOptions struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
 	interceptors     ephemeralResourceInterceptors
-	typeName         string
+	// isRegionOverrideEnabled indicates whether the `region` attribute is injected into the schema.
+	isRegionOverrideEnabled bool
+	typeName                string
 }
 
 // wrappedEphemeralResource represents an interceptor dispatcher for a Plugin Framework ephemeral resource.
@@ -150,6 +228,18 @@ func (w *wrappedEphemeralResource) Schema(ctx context.Context, request ephemeral
 	}
 
 	w.inner.Schema(ctx, request, response)
+
+	if w.opts.isRegionOverrideEnabled && response.Schema.Attributes != nil {
+		response.Schema.Attributes[names.AttrRegion] = regionEphemeralResourceSchemaAttribute()
+	}
+}
+
+// innerSchema returns the inner ephemeral resource's schema, without any `region` attribute.
+func (w *wrappedEphemeralResource) innerSchema(ctx context.Context) (ephemeralschema.Schema, diag.Diagnostics) {
+	var response ephemeral.SchemaResponse
+	w.inner.Schema(ctx, ephemeral.SchemaRequest{}, &response)
+
+	return response.Schema, response.Diagnostics
 }
 
 func (w *wrappedEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
@@ -160,7 +250,32 @@ func (w *wrappedEphemeralResource) Open(ctx context.Context, request ephemeral.O
 	}
 
 	f := func(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) diag.Diagnostics {
-		w.inner.Open(ctx, request, response)
+		if !w.opts.isRegionOverrideEnabled {
+			w.inner.Open(ctx, request, response)
+			return response.Diagnostics
+		}
+
+		schema, diags := w.innerSchema(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return response.Diagnostics
+		}
+
+		callWithoutRegion(request, response,
+			func(response *ephemeral.OpenResponse) *diag.Diagnostics { return &response.Diagnostics },
+			func(shim *regionShim, request ephemeral.OpenRequest, response ephemeral.OpenResponse) (ephemeral.OpenRequest, ephemeral.OpenResponse) {
+				request.Config = tfsdk.Config{Schema: schema, Raw: shim.strip(request.Config.Raw)}
+				response.Result = tfsdk.EphemeralResultData{Schema: schema, Raw: shim.strip(response.Result.Raw)}
+				return request, response
+			},
+			func(request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+				w.inner.Open(ctx, request, response)
+			},
+			func(shim *regionShim, outer ephemeral.OpenResponse, response *ephemeral.OpenResponse) {
+				response.Result = shim.restoreResult(outer.Result, response.Result, regionStringValue(w.meta.Region(ctx)))
+			},
+		)
+
 		return response.Diagnostics
 	}
 	response.Diagnostics.Append(interceptedHandler(w.opts.interceptors.open(), f, w.meta)(ctx, request, response)...)
@@ -238,6 +353,21 @@ func (w *wrappedEphemeralResource) ValidateConfig(ctx context.Context, request e
 			return
 		}
 
+		if w.opts.isRegionOverrideEnabled {
+			schema, diags := w.innerSchema(ctx)
+			response.Diagnostics.Append(diags...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			raw, diags := stripConfigRegion(request.Config)
+			response.Diagnostics.Append(diags...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+			request.Config = tfsdk.Config{Schema: schema, Raw: raw}
+		}
+
 		v.ValidateConfig(ctx, request, response)
 	}
 }
@@ -249,8 +379,10 @@ type wrappedResourceOptions struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
 	interceptors     resourceInterceptors
-	modifyPlanFuncs  []modifyPlanFunc
-	typeName         string
+	// isRegionOverrideEnabled indicates whether the `region` attribute is injected into the schema.
+	isRegionOverrideEnabled bool
+	modifyPlanFuncs         []modifyPlanFunc
+	typeName                string
 }
 
 // wrappedResource represents an interceptor dispatcher for a Plugin Framework resource.
@@ -280,6 +412,18 @@ func (w *wrappedResource) Schema(ctx context.Context, request resource.SchemaReq
 	}
 
 	w.inner.Schema(ctx, request, response)
+
+	if w.opts.isRegionOverrideEnabled && response.Schema.Attributes != nil {
+		response.Schema.Attributes[names.AttrRegion] = regionResourceSchemaAttribute()
+	}
+}
+
+// innerSchema returns the inner resource's schema, without any `region` attribute.
+func (w *wrappedResource) innerSchema(ctx context.Context) (resourceschema.Schema, diag.Diagnostics) {
+	var response resource.SchemaResponse
+	w.inner.Schema(ctx, resource.SchemaRequest{}, &response)
+
+	return response.Schema, response.Diagnostics
 }
 
 func (w *wrappedResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
@@ -290,7 +434,33 @@ func (w *wrappedResource) Create(ctx context.Context, request resource.CreateReq
 	}
 
 	f := func(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) diag.Diagnostics {
-		w.inner.Create(ctx, request, response)
+		if !w.opts.isRegionOverrideEnabled {
+			w.inner.Create(ctx, request, response)
+			return response.Diagnostics
+		}
+
+		schema, diags := w.innerSchema(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return response.Diagnostics
+		}
+
+		callWithoutRegion(request, response,
+			func(response *resource.CreateResponse) *diag.Diagnostics { return &response.Diagnostics },
+			func(shim *regionShim, request resource.CreateRequest, response resource.CreateResponse) (resource.CreateRequest, resource.CreateResponse) {
+				request.Config = tfsdk.Config{Schema: schema, Raw: shim.strip(request.Config.Raw)}
+				request.Plan = tfsdk.Plan{Schema: schema, Raw: shim.strip(request.Plan.Raw)}
+				response.State = tfsdk.State{Schema: schema, Raw: shim.strip(response.State.Raw)}
+				return request, response
+			},
+			func(request resource.CreateRequest, response *resource.CreateResponse) {
+				w.inner.Create(ctx, request, response)
+			},
+			func(shim *regionShim, outer resource.CreateResponse, response *resource.CreateResponse) {
+				response.State = shim.restoreState(outer.State, response.State, regionStringValue(w.meta.Region(ctx)))
+			},
+		)
+
 		return response.Diagnostics
 	}
 	response.Diagnostics.Append(interceptedHandler(w.opts.interceptors.create(), f, w.meta)(ctx, request, response)...)
@@ -304,7 +474,32 @@ func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest
 	}
 
 	f := func(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) diag.Diagnostics {
-		w.inner.Read(ctx, request, response)
+		if !w.opts.isRegionOverrideEnabled {
+			w.inner.Read(ctx, request, response)
+			return response.Diagnostics
+		}
+
+		schema, diags := w.innerSchema(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return response.Diagnostics
+		}
+
+		callWithoutRegion(request, response,
+			func(response *resource.ReadResponse) *diag.Diagnostics { return &response.Diagnostics },
+			func(shim *regionShim, request resource.ReadRequest, response resource.ReadResponse) (resource.ReadRequest, resource.ReadResponse) {
+				request.State = tfsdk.State{Schema: schema, Raw: shim.strip(request.State.Raw)}
+				response.State = tfsdk.State{Schema: schema, Raw: shim.strip(response.State.Raw)}
+				return request, response
+			},
+			func(request resource.ReadRequest, response *resource.ReadResponse) {
+				w.inner.Read(ctx, request, response)
+			},
+			func(shim *regionShim, outer resource.ReadResponse, response *resource.ReadResponse) {
+				response.State = shim.restoreState(outer.State, response.State, regionStringValue(w.meta.Region(ctx)))
+			},
+		)
+
 		return response.Diagnostics
 	}
 	response.Diagnostics.Append(interceptedHandler(w.opts.interceptors.read(), f, w.meta)(ctx, request, response)...)
@@ -318,7 +513,34 @@ func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateReq
 	}
 
 	f := func(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) diag.Diagnostics {
-		w.inner.Update(ctx, request, response)
+		if !w.opts.isRegionOverrideEnabled {
+			w.inner.Update(ctx, request, response)
+			return response.Diagnostics
+		}
+
+		schema, diags := w.innerSchema(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return response.Diagnostics
+		}
+
+		callWithoutRegion(request, response,
+			func(response *resource.UpdateResponse) *diag.Diagnostics { return &response.Diagnostics },
+			func(shim *regionShim, request resource.UpdateRequest, response resource.UpdateResponse) (resource.UpdateRequest, resource.UpdateResponse) {
+				request.Config = tfsdk.Config{Schema: schema, Raw: shim.strip(request.Config.Raw)}
+				request.Plan = tfsdk.Plan{Schema: schema, Raw: shim.strip(request.Plan.Raw)}
+				request.State = tfsdk.State{Schema: schema, Raw: shim.strip(request.State.Raw)}
+				response.State = tfsdk.State{Schema: schema, Raw: shim.strip(response.State.Raw)}
+				return request, response
+			},
+			func(request resource.UpdateRequest, response *resource.UpdateResponse) {
+				w.inner.Update(ctx, request, response)
+			},
+			func(shim *regionShim, outer resource.UpdateResponse, response *resource.UpdateResponse) {
+				response.State = shim.restoreState(outer.State, response.State, regionStringValue(w.meta.Region(ctx)))
+			},
+		)
+
 		return response.Diagnostics
 	}
 	response.Diagnostics.Append(interceptedHandler(w.opts.interceptors.update(), f, w.meta)(ctx, request, response)...)
@@ -332,7 +554,32 @@ func (w *wrappedResource) Delete(ctx context.Context, request resource.DeleteReq
 	}
 
 	f := func(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) diag.Diagnostics {
-		w.inner.Delete(ctx, request, response)
+		if !w.opts.isRegionOverrideEnabled {
+			w.inner.Delete(ctx, request, response)
+			return response.Diagnostics
+		}
+
+		schema, diags := w.innerSchema(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return response.Diagnostics
+		}
+
+		callWithoutRegion(request, response,
+			func(response *resource.DeleteResponse) *diag.Diagnostics { return &response.Diagnostics },
+			func(shim *regionShim, request resource.DeleteRequest, response resource.DeleteResponse) (resource.DeleteRequest, resource.DeleteResponse) {
+				request.State = tfsdk.State{Schema: schema, Raw: shim.strip(request.State.Raw)}
+				response.State = tfsdk.State{Schema: schema, Raw: shim.strip(response.State.Raw)}
+				return request, response
+			},
+			func(request resource.DeleteRequest, response *resource.DeleteResponse) {
+				w.inner.Delete(ctx, request, response)
+			},
+			func(shim *regionShim, outer resource.DeleteResponse, response *resource.DeleteResponse) {
+				response.State = shim.restoreState(outer.State, response.State, regionStringValue(w.meta.Region(ctx)))
+			},
+		)
+
 		return response.Diagnostics
 	}
 	response.Diagnostics.Append(interceptedHandler(w.opts.interceptors.delete(), f, w.meta)(ctx, request, response)...)
@@ -360,7 +607,38 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 			return
 		}
 
-		v.ImportState(ctx, request, response)
+		if !w.opts.isRegionOverrideEnabled {
+			v.ImportState(ctx, request, response)
+
+			return
+		}
+
+		// Handle any `@<region>` suffix on the imported ID.
+		region := regionStringValue(w.meta.Region(ctx))
+		if id, v, ok := interceptors.ParseImportIDRegion(request.ID); ok {
+			request.ID = id
+			region = regionStringValue(v)
+		}
+
+		schema, diags := w.innerSchema(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		callWithoutRegion(request, response,
+			func(response *resource.ImportStateResponse) *diag.Diagnostics { return &response.Diagnostics },
+			func(shim *regionShim, request resource.ImportStateRequest, response resource.ImportStateResponse) (resource.ImportStateRequest, resource.ImportStateResponse) {
+				response.State = tfsdk.State{Schema: schema, Raw: shim.strip(response.State.Raw)}
+				return request, response
+			},
+			func(request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+				v.ImportState(ctx, request, response)
+			},
+			func(shim *regionShim, outer resource.ImportStateResponse, response *resource.ImportStateResponse) {
+				response.State = shim.restoreState(outer.State, response.State, region)
+			},
+		)
 
 		return
 	}
@@ -386,7 +664,34 @@ func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.Modif
 	}
 
 	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
-		v.ModifyPlan(ctx, request, response)
+		if !w.opts.isRegionOverrideEnabled {
+			v.ModifyPlan(ctx, request, response)
+
+			return
+		}
+
+		schema, diags := w.innerSchema(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		callWithoutRegion(request, response,
+			func(response *resource.ModifyPlanResponse) *diag.Diagnostics { return &response.Diagnostics },
+			func(shim *regionShim, request resource.ModifyPlanRequest, response resource.ModifyPlanResponse) (resource.ModifyPlanRequest, resource.ModifyPlanResponse) {
+				request.Config = tfsdk.Config{Schema: schema, Raw: shim.strip(request.Config.Raw)}
+				request.Plan = tfsdk.Plan{Schema: schema, Raw: shim.strip(request.Plan.Raw)}
+				request.State = tfsdk.State{Schema: schema, Raw: shim.strip(request.State.Raw)}
+				response.Plan = tfsdk.Plan{Schema: schema, Raw: shim.strip(response.Plan.Raw)}
+				return request, response
+			},
+			func(request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+				v.ModifyPlan(ctx, request, response)
+			},
+			func(shim *regionShim, outer resource.ModifyPlanResponse, response *resource.ModifyPlanResponse) {
+				response.Plan = shim.restorePlan(outer.Plan, response.Plan, regionValue(outer.Plan.Raw))
+			},
+		)
 	}
 }
 
@@ -416,6 +721,21 @@ func (w *wrappedResource) ValidateConfig(ctx context.Context, request resource.V
 			return
 		}
 
+		if w.opts.isRegionOverrideEnabled {
+			schema, diags := w.innerSchema(ctx)
+			response.Diagnostics.Append(diags...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			raw, diags := stripConfigRegion(request.Config)
+			response.Diagnostics.Append(diags...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+			request.Config = tfsdk.Config{Schema: schema, Raw: raw}
+		}
+
 		v.ValidateConfig(ctx, request, response)
 	}
 }
@@ -432,7 +752,44 @@ func (w *wrappedResource) UpgradeState(ctx context.Context) map[int64]resource.S
 			return nil
 		}
 
-		return v.UpgradeState(ctx)
+		upgraders := v.UpgradeState(ctx)
+		if !w.opts.isRegionOverrideEnabled {
+			return upgraders
+		}
+
+		for k, v := range upgraders {
+			f := v.StateUpgrader
+			v.StateUpgrader = func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+				schema, diags := w.innerSchema(ctx)
+				response.Diagnostics.Append(diags...)
+				if response.Diagnostics.HasError() {
+					return
+				}
+
+				// Retain any Region from the prior state.
+				region := tftypes.NewValue(tftypes.String, nil)
+				if request.RawState != nil {
+					region = regionFromRawState(request.RawState.JSON)
+				}
+
+				callWithoutRegion(request, response,
+					func(response *resource.UpgradeStateResponse) *diag.Diagnostics { return &response.Diagnostics },
+					func(shim *regionShim, request resource.UpgradeStateRequest, response resource.UpgradeStateResponse) (resource.UpgradeStateRequest, resource.UpgradeStateResponse) {
+						response.State = tfsdk.State{Schema: schema, Raw: shim.strip(response.State.Raw)}
+						return request, response
+					},
+					func(request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+						f(ctx, request, response)
+					},
+					func(shim *regionShim, outer resource.UpgradeStateResponse, response *resource.UpgradeStateResponse) {
+						response.State = shim.restoreState(outer.State, response.State, region)
+					},
+				)
+			}
+			upgraders[k] = v
+		}
+
+		return upgraders
 	}
 
 	return nil
@@ -450,7 +807,44 @@ func (w *wrappedResource) MoveState(ctx context.Context) []resource.StateMover {
 			return nil
 		}
 
-		return v.MoveState(ctx)
+		movers := v.MoveState(ctx)
+		if !w.opts.isRegionOverrideEnabled {
+			return movers
+		}
+
+		for i, v := range movers {
+			f := v.StateMover
+			v.StateMover = func(ctx context.Context, request resource.MoveStateRequest, response *resource.MoveStateResponse) {
+				schema, diags := w.innerSchema(ctx)
+				response.Diagnostics.Append(diags...)
+				if response.Diagnostics.HasError() {
+					return
+				}
+
+				// Retain any Region from the source state.
+				region := tftypes.NewValue(tftypes.String, nil)
+				if request.SourceRawState != nil {
+					region = regionFromRawState(request.SourceRawState.JSON)
+				}
+
+				callWithoutRegion(request, response,
+					func(response *resource.MoveStateResponse) *diag.Diagnostics { return &response.Diagnostics },
+					func(shim *regionShim, request resource.MoveStateRequest, response resource.MoveStateResponse) (resource.MoveStateRequest, resource.MoveStateResponse) {
+						response.TargetState = tfsdk.State{Schema: schema, Raw: shim.strip(response.TargetState.Raw)}
+						return request, response
+					},
+					func(request resource.MoveStateRequest, response *resource.MoveStateResponse) {
+						f(ctx, request, response)
+					},
+					func(shim *regionShim, outer resource.MoveStateResponse, response *resource.MoveStateResponse) {
+						response.TargetState = shim.restoreState(outer.TargetState, response.TargetState, region)
+					},
+				)
+			}
+			movers[i] = v
+		}
+
+		return movers
 	}
 
 	return nil
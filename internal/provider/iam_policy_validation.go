@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"reflect"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// injectIAMPolicyValidation adds IAM policy linting to every configurable attribute in the specified resource's schema
+// that suppresses differences between equivalent IAM policies.
+func injectIAMPolicyValidation(r *schema.Resource) {
+	if v := r.SchemaFunc; v != nil {
+		r.SchemaFunc = func() map[string]*schema.Schema {
+			s := v()
+			injectIAMPolicyValidationIntoSchema(s)
+			return s
+		}
+	} else {
+		injectIAMPolicyValidationIntoSchema(r.Schema)
+	}
+}
+
+func injectIAMPolicyValidationIntoSchema(m map[string]*schema.Schema) {
+	for k, v := range m {
+		if v.Type == schema.TypeString && isSuppressEquivalentPolicyDiffs(v.DiffSuppressFunc) && (v.Required || v.Optional) {
+			v := *v
+			v.ValidateDiagFunc = withIAMPolicyValidation(v.ValidateFunc, v.ValidateDiagFunc)
+			v.ValidateFunc = nil
+			m[k] = &v
+			continue
+		}
+
+		if v, ok := v.Elem.(*schema.Resource); ok {
+			injectIAMPolicyValidation(v)
+		}
+	}
+}
+
+// isSuppressEquivalentPolicyDiffs returns whether the specified function is verify.SuppressEquivalentPolicyDiffs.
+func isSuppressEquivalentPolicyDiffs(f schema.SchemaDiffSuppressFunc) bool {
+	return f != nil && reflect.ValueOf(f).Pointer() == reflect.ValueOf(verify.SuppressEquivalentPolicyDiffs).Pointer()
+}
+
+// withIAMPolicyValidation returns a SchemaValidateDiagFunc that runs any existing validation followed,
+// if that succeeds, by IAM policy linting.
+func withIAMPolicyValidation(validateFunc schema.SchemaValidateFunc, validateDiagFunc schema.SchemaValidateDiagFunc) schema.SchemaValidateDiagFunc {
+	if validateDiagFunc == nil && validateFunc != nil {
+		validateDiagFunc = validation.ToDiagFunc(validateFunc)
+	}
+	if validateDiagFunc == nil {
+		return verify.ValidIAMPolicyDocument
+	}
+
+	return func(v any, path cty.Path) diag.Diagnostics {
+		diags := validateDiagFunc(v, path)
+		if diags.HasError() {
+			return diags
+		}
+
+		return append(diags, verify.ValidIAMPolicyDocument(v, path)...)
+	}
+}
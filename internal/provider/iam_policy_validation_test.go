@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestInjectIAMPolicyValidation(t *testing.T) {
+	t.Parallel()
+
+	r := &schema.Resource{
+		SchemaFunc: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				names.AttrPolicy: {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateFunc:     validation.StringIsJSON,
+					DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+				},
+				"json": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				},
+				"nested": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							names.AttrPolicy: {
+								Type:             schema.TypeString,
+								Optional:         true,
+								DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+							},
+						},
+					},
+				},
+			}
+		},
+	}
+
+	injectIAMPolicyValidation(r)
+
+	s := r.SchemaMap()
+	if err := schema.InternalMap(s).InternalValidate(nil); err != nil {
+		t.Fatalf("InternalValidate: %s", err)
+	}
+
+	if v := s[names.AttrPolicy]; v.ValidateFunc != nil || v.ValidateDiagFunc == nil {
+		t.Errorf("%s: expected ValidateDiagFunc only", names.AttrPolicy)
+	}
+	if v := s["json"]; v.ValidateDiagFunc != nil {
+		t.Errorf("json: unexpected ValidateDiagFunc")
+	}
+	if v := s["nested"].Elem.(*schema.Resource).SchemaMap()[names.AttrPolicy]; v.ValidateDiagFunc == nil {
+		t.Errorf("nested.%s: expected ValidateDiagFunc", names.AttrPolicy)
+	}
+
+	path := cty.GetAttrPath(names.AttrPolicy)
+	f := s[names.AttrPolicy].ValidateDiagFunc
+
+	if diags := f(`{"Statement": [{"Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"}]}`, path); diags.HasError() {
+		t.Errorf("valid policy: unexpected error: %v", diags)
+	}
+	if diags := f(`not JSON`, path); len(diags) != 1 {
+		t.Errorf("invalid JSON: expected 1 diagnostic, got %v", diags)
+	}
+	if diags := f(`{"Statement": [{"Effect": "Permit", "Action": "s3:GetObject", "Resource": "*"}]}`, path); diags.HasError() || len(diags) != 1 {
+		t.Errorf("invalid grammar: expected 1 warning, got %v", diags)
+	}
+	if diags := f(`{"Statement": [{"Effect": "Allow", "Action": "s3:GetObject", "Resource": 1}]}`, path); !diags.HasError() {
+		t.Errorf("invalid structure: expected error")
+	}
+}
@@ -87,6 +87,9 @@ func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag
 		if diags.HasError() {
 			return diags
 		}
+		if d != nil {
+			ctx = conns.WithResourceID(ctx, d.Id())
+		}
 
 		// Before interceptors are run first to last.
 		forward := interceptors.why(why)
@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package interceptors
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudtrail/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
+)
+
+const (
+	// DriftRedactedValue replaces the values of sensitive attributes in drift diagnostics.
+	DriftRedactedValue = "(sensitive value)"
+
+	// maxDriftEvents is the maximum number of CloudTrail events reported for a drifted resource.
+	maxDriftEvents = 3
+
+	// driftEventsLookback is how far back CloudTrail events are looked up.
+	driftEventsLookback = 24 * time.Hour
+
+	// driftEventsTimeout is the maximum time spent looking up CloudTrail events for a drifted resource.
+	driftEventsTimeout = 2 * time.Second
+
+	// driftEventsRate is the maximum number of CloudTrail LookupEvents calls per second.
+	// CloudTrail allows 2 LookupEvents calls per second per account and Region.
+	driftEventsRate = 2
+)
+
+// driftEventsRateLimiter is shared by all resources so that drift diagnostics never exceed CloudTrail's LookupEvents quota.
+var driftEventsRateLimiter = tfsync.NewRateLimiter(driftEventsRate, 1)
+
+// DriftedAttribute represents a resource attribute whose value changed outside of Terraform.
+type DriftedAttribute struct {
+	Name      string
+	Old       string
+	New       string
+	Sensitive bool
+}
+
+// DriftEvent represents a CloudTrail management event that could explain a resource's drift.
+type DriftEvent struct {
+	Name      string
+	Source    string
+	Time      time.Time
+	UserAgent string
+	Username  string
+}
+
+func (e DriftEvent) String() string {
+	var sb strings.Builder
+
+	sb.WriteString(e.Name)
+	if e.Source != "" {
+		fmt.Fprintf(&sb, " (%s)", e.Source)
+	}
+	if !e.Time.IsZero() {
+		fmt.Fprintf(&sb, " at %s", e.Time.UTC().Format(time.RFC3339))
+	}
+	if e.Username != "" {
+		fmt.Fprintf(&sb, " by %s", e.Username)
+	}
+	if e.UserAgent != "" {
+		fmt.Fprintf(&sb, " using %s", e.UserAgent)
+	}
+
+	return sb.String()
+}
+
+// DriftSummary returns the summary of a drift diagnostic.
+func DriftSummary(typeName string) string {
+	return fmt.Sprintf("%s changed outside of Terraform", typeName)
+}
+
+// DriftDetail returns the detail of a drift diagnostic.
+// If attributes is empty the resource no longer exists.
+func DriftDetail(id string, attributes []DriftedAttribute, events []DriftEvent) string {
+	var sb strings.Builder
+
+	if len(attributes) == 0 {
+		fmt.Fprintf(&sb, "Resource %q no longer exists.\n", id)
+	} else {
+		fmt.Fprintf(&sb, "Refreshing resource %q found the following changes:\n", id)
+		for _, v := range attributes {
+			if v.Sensitive {
+				fmt.Fprintf(&sb, "  - %s: %s\n", v.Name, DriftRedactedValue)
+			} else {
+				fmt.Fprintf(&sb, "  - %s: %s => %s\n", v.Name, v.Old, v.New)
+			}
+		}
+	}
+
+	if len(events) > 0 {
+		sb.WriteString("\nThe most recent AWS CloudTrail management events for the resource that could have caused the change are:\n")
+		for _, v := range events {
+			fmt.Fprintf(&sb, "  - %s\n", v)
+		}
+	}
+
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+// LookupDriftEvents returns the most recent CloudTrail write management events for a resource.
+// Events are only looked up if enabled in provider configuration.
+// Each identifier (e.g. resource ID, then ARN) is tried in turn until events are found.
+// Looking up events never fails or delays a refresh: errors, e.g. missing permissions, are logged and result in no events,
+// and no events are looked up once the shared rate limit is reached.
+func LookupDriftEvents(ctx context.Context, c *conns.AWSClient, identifiers ...string) []DriftEvent {
+	if !c.DriftDiagnosticsCloudTrail(ctx) {
+		return nil
+	}
+
+	return lookupDriftEvents(ctx, c.CloudTrailClient(ctx), driftEventsRateLimiter, time.Now(), identifiers...)
+}
+
+type lookupEventsAPIClient interface {
+	LookupEvents(context.Context, *cloudtrail.LookupEventsInput, ...func(*cloudtrail.Options)) (*cloudtrail.LookupEventsOutput, error)
+}
+
+// lookupDriftEvents looks up at most one page of events for each identifier, covering the last driftEventsLookback.
+func lookupDriftEvents(ctx context.Context, conn lookupEventsAPIClient, limiter *tfsync.RateLimiter, now time.Time, identifiers ...string) []DriftEvent {
+	ctx, cancel := context.WithTimeout(ctx, driftEventsTimeout)
+	defer cancel()
+
+	for _, identifier := range identifiers {
+		if identifier == "" {
+			continue
+		}
+
+		if !limiter.Allow() {
+			tflog.Debug(ctx, "skipping CloudTrail event lookup for drift diagnostics: rate limit reached", map[string]any{
+				"identifier": identifier,
+			})
+			return nil
+		}
+
+		input := cloudtrail.LookupEventsInput{
+			EndTime: aws.Time(now),
+			LookupAttributes: []awstypes.LookupAttribute{
+				{
+					AttributeKey:   awstypes.LookupAttributeKeyResourceName,
+					AttributeValue: aws.String(identifier),
+				},
+			},
+			MaxResults: aws.Int32(50),
+			StartTime:  aws.Time(now.Add(-driftEventsLookback)),
+		}
+		output, err := conn.LookupEvents(ctx, &input, func(o *cloudtrail.Options) {
+			// Don't retry throttled or failed calls.
+			o.RetryMaxAttempts = 1
+		})
+
+		if err != nil {
+			tflog.Debug(ctx, "looking up CloudTrail events for drift diagnostics", map[string]any{
+				"error":      err.Error(),
+				"identifier": identifier,
+			})
+			return nil
+		}
+
+		var events []DriftEvent
+		for _, v := range output.Events {
+			if aws.ToString(v.ReadOnly) == "true" {
+				continue
+			}
+
+			events = append(events, driftEventFromCloudTrailEvent(v))
+			if len(events) == maxDriftEvents {
+				break
+			}
+		}
+
+		if len(events) > 0 {
+			return events
+		}
+	}
+
+	return nil
+}
+
+func driftEventFromCloudTrailEvent(apiObject awstypes.Event) DriftEvent {
+	event := DriftEvent{
+		Name:     aws.ToString(apiObject.EventName),
+		Source:   aws.ToString(apiObject.EventSource),
+		Time:     aws.ToTime(apiObject.EventTime),
+		Username: aws.ToString(apiObject.Username),
+	}
+
+	// The user agent distinguishes changes made in the AWS Management Console from those made by tools.
+	var record struct {
+		UserAgent string `json:"userAgent"`
+	}
+	if err := json.Unmarshal([]byte(aws.ToString(apiObject.CloudTrailEvent)), &record); err == nil {
+		event.UserAgent = record.UserAgent
+	}
+
+	return event
+}
@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudtrail/types"
+	"github.com/google/go-cmp/cmp"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
+)
+
+func TestDriftDetail(t *testing.T) {
+	t.Parallel()
+
+	eventTime := time.Date(2026, 10, 16, 9, 30, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		attributes []DriftedAttribute
+		events     []DriftEvent
+		expected   string
+	}{
+		"removed": {
+			expected: `Resource "example" no longer exists.`,
+		},
+		"changed": {
+			attributes: []DriftedAttribute{
+				{Name: "description", Old: `"old"`, New: `"new"`},
+				{Name: "password", Old: `"secret1"`, New: `"secret2"`, Sensitive: true},
+			},
+			expected: `Refreshing resource "example" found the following changes:
+  - description: "old" => "new"
+  - password: (sensitive value)`,
+		},
+		"changed with events": {
+			attributes: []DriftedAttribute{
+				{Name: "visibility_timeout_seconds", Old: "30", New: "60"},
+			},
+			events: []DriftEvent{
+				{
+					Name:      "SetQueueAttributes",
+					Source:    "sqs.amazonaws.com",
+					Time:      eventTime,
+					UserAgent: "AWS Internal",
+					Username:  "jdoe",
+				},
+				{
+					Name: "TagQueue",
+				},
+			},
+			expected: `Refreshing resource "example" found the following changes:
+  - visibility_timeout_seconds: 30 => 60
+
+The most recent AWS CloudTrail management events for the resource that could have caused the change are:
+  - SetQueueAttributes (sqs.amazonaws.com) at 2026-10-16T09:30:00Z by jdoe using AWS Internal
+  - TagQueue`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := DriftDetail("example", testCase.attributes, testCase.events)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+type mockLookupEventsClient struct {
+	err    error
+	events map[string][]awstypes.Event
+	inputs []cloudtrail.LookupEventsInput
+}
+
+func (m *mockLookupEventsClient) LookupEvents(_ context.Context, input *cloudtrail.LookupEventsInput, optFns ...func(*cloudtrail.Options)) (*cloudtrail.LookupEventsOutput, error) {
+	m.inputs = append(m.inputs, *input)
+
+	var options cloudtrail.Options
+	for _, fn := range optFns {
+		fn(&options)
+	}
+	if options.RetryMaxAttempts != 1 {
+		return nil, errors.New("retries not disabled")
+	}
+
+	if m.err != nil {
+		return nil, m.err
+	}
+
+	return &cloudtrail.LookupEventsOutput{
+		Events:    m.events[aws.ToString(input.LookupAttributes[0].AttributeValue)],
+		NextToken: aws.String("next"),
+	}, nil
+}
+
+func TestLookupDriftEvents(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	eventTime := now.Add(-time.Hour)
+	write := awstypes.Event{EventName: aws.String("SetQueueAttributes"), EventTime: aws.Time(eventTime), ReadOnly: aws.String("false")}
+	read := awstypes.Event{EventName: aws.String("GetQueueAttributes"), EventTime: aws.Time(eventTime), ReadOnly: aws.String("true")}
+
+	testCases := map[string]struct {
+		client        *mockLookupEventsClient
+		limiter       *tfsync.RateLimiter
+		expected      []DriftEvent
+		expectedCalls int
+	}{
+		"found by ID": {
+			client:        &mockLookupEventsClient{events: map[string][]awstypes.Event{"id": {read, write}}},
+			limiter:       tfsync.NewRateLimiter(0, 0),
+			expected:      []DriftEvent{{Name: "SetQueueAttributes", Time: eventTime}},
+			expectedCalls: 1,
+		},
+		"found by ARN": {
+			client:        &mockLookupEventsClient{events: map[string][]awstypes.Event{"arn": {write}}},
+			limiter:       tfsync.NewRateLimiter(0, 0),
+			expected:      []DriftEvent{{Name: "SetQueueAttributes", Time: eventTime}},
+			expectedCalls: 2,
+		},
+		"capped": {
+			client:        &mockLookupEventsClient{events: map[string][]awstypes.Event{"id": {write, write, write, write, write}}},
+			limiter:       tfsync.NewRateLimiter(0, 0),
+			expected:      []DriftEvent{{Name: "SetQueueAttributes", Time: eventTime}, {Name: "SetQueueAttributes", Time: eventTime}, {Name: "SetQueueAttributes", Time: eventTime}},
+			expectedCalls: 1,
+		},
+		"error": {
+			client:        &mockLookupEventsClient{err: errors.New("AccessDeniedException")},
+			limiter:       tfsync.NewRateLimiter(0, 0),
+			expectedCalls: 1,
+		},
+		"rate limited": {
+			client:        &mockLookupEventsClient{events: map[string][]awstypes.Event{"arn": {write}}},
+			limiter:       tfsync.NewRateLimiter(0.001, 1),
+			expectedCalls: 1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := lookupDriftEvents(ctx, testCase.client, testCase.limiter, now, "id", "", "arn")
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+
+			if got, want := len(testCase.client.inputs), testCase.expectedCalls; got != want {
+				t.Fatalf("LookupEvents calls = %d, want %d", got, want)
+			}
+			for _, input := range testCase.client.inputs {
+				if got, want := aws.ToTime(input.StartTime), now.Add(-driftEventsLookback); !got.Equal(want) {
+					t.Errorf("StartTime = %s, want %s", got, want)
+				}
+				if input.NextToken != nil {
+					t.Errorf("NextToken = %q, want nil", aws.ToString(input.NextToken))
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package interceptors
+
+import (
+	"strings"
+
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// ParseImportIDRegion splits an import ID of the form `<id>@<region>` into its constituent parts.
+// Only suffixes that are valid AWS Region codes are considered so that identifiers containing '@', e.g. email addresses, are unaffected.
+func ParseImportIDRegion(id string) (string, string, bool) {
+	if i := strings.LastIndex(id, "@"); i > 0 {
+		if region := id[i+1:]; itypes.IsAWSRegion(region) {
+			return id[:i], region, true
+		}
+	}
+
+	return id, "", false
+}
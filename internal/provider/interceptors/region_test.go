@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package interceptors
+
+import (
+	"testing"
+)
+
+func TestParseImportIDRegion(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		importID       string
+		expectedID     string
+		expectedRegion string
+		expectedOK     bool
+	}{
+		"no suffix": {
+			importID:   "vpc-12345678",
+			expectedID: "vpc-12345678",
+		},
+		"Region suffix": {
+			importID:       "vpc-12345678@eu-west-1", //lintignore:AWSAT003
+			expectedID:     "vpc-12345678",
+			expectedRegion: "eu-west-1", //lintignore:AWSAT003
+			expectedOK:     true,
+		},
+		"composite ID with Region suffix": {
+			importID:       "rtb-12345678,subnet-12345678@us-gov-west-1", //lintignore:AWSAT003
+			expectedID:     "rtb-12345678,subnet-12345678",
+			expectedRegion: "us-gov-west-1", //lintignore:AWSAT003
+			expectedOK:     true,
+		},
+		"email address": {
+			importID:   "someone@example.com",
+			expectedID: "someone@example.com",
+		},
+		"email address with Region suffix": {
+			importID:       "someone@example.com@ap-southeast-2", //lintignore:AWSAT003
+			expectedID:     "someone@example.com",
+			expectedRegion: "ap-southeast-2", //lintignore:AWSAT003
+			expectedOK:     true,
+		},
+		"only Region": {
+			importID:   "@us-west-2", //lintignore:AWSAT003
+			expectedID: "@us-west-2", //lintignore:AWSAT003
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			id, region, ok := ParseImportIDRegion(testCase.importID)
+
+			if got, want := ok, testCase.expectedOK; got != want {
+				t.Errorf("ok = %t, want %t", got, want)
+			}
+			if got, want := id, testCase.expectedID; got != want {
+				t.Errorf("id = %q, want %q", got, want)
+			}
+			if got, want := region, testCase.expectedRegion; got != want {
+				t.Errorf("region = %q, want %q", got, want)
+			}
+		})
+	}
+}
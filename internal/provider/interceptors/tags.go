@@ -5,7 +5,10 @@ package interceptors
 
 import (
 	"context"
+	"fmt"
+	"slices"
 
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -61,11 +64,59 @@ func (w WithTaggingMethods) ListTags(ctx context.Context, sp conns.ServicePackag
 	return err
 }
 
+// batchTaggingServicePackages are the service packages whose resources' tags can be updated
+// using the Resource Groups Tagging API, as an equivalent to the service's own tagging API.
+// Only packages whose tagged resources are identified by ARN are included;
+// e.g. EC2, EFS and KMS resources are tagged by ID and so cannot be batched.
+var batchTaggingServicePackages = []string{
+	names.ACM,
+	names.CloudWatch,
+	names.CodeBuild,
+	names.DynamoDB,
+	names.ECR,
+	names.ELBV2,
+	names.ElastiCache,
+	names.Lambda,
+	names.RDS,
+	names.SFN,
+	names.SNS,
+	names.SecretsManager,
+}
+
+// canBatchUpdateTags returns whether the resource's tags can be updated using the provider's tag batcher.
+func canBatchUpdateTags(ctx context.Context, sp conns.ServicePackage, c *conns.AWSClient, identifier string) bool {
+	if c.TagBatcher(ctx) == nil || !slices.Contains(batchTaggingServicePackages, sp.ServicePackageName()) {
+		return false
+	}
+
+	// Global resources have no Region in their ARN.
+	v, err := arn.Parse(identifier)
+	if err != nil {
+		return false
+	}
+
+	return v.Region != ""
+}
+
 // If the service package has a generic resource update tags methods, call it.
+// If batch tagging is enabled and supported, the update is made using the Resource Groups Tagging API instead.
 func (w WithTaggingMethods) UpdateTags(ctx context.Context, sp conns.ServicePackage, c *conns.AWSClient, identifier string, oldTags, newTags any) error {
 	var err error
 
-	if v, ok := sp.(tftags.ServiceTagUpdater); ok {
+	if canBatchUpdateTags(ctx, sp, c, identifier) {
+		oldTags, newTags := tftags.New(ctx, oldTags), tftags.New(ctx, newTags)
+		removedTags := oldTags.Removed(newTags).IgnoreSystem(sp.ServicePackageName())
+		updatedTags := oldTags.Updated(newTags).IgnoreSystem(sp.ServicePackageName())
+
+		err = c.TagBatcher(ctx).UpdateTags(ctx, identifier, removedTags, updatedTags)
+
+		// Wait for the changes to be visible in the service's own tagging API, as its UpdateTags method would.
+		if v, ok := sp.(tftags.ServiceTagsPropagationWaiter); ok && err == nil && (len(removedTags) > 0 || len(updatedTags) > 0) {
+			if err = v.WaitTagsPropagated(ctx, c, identifier, newTags); err != nil {
+				err = fmt.Errorf("waiting for resource (%s) tag propagation: %w", identifier, err)
+			}
+		}
+	} else if v, ok := sp.(tftags.ServiceTagUpdater); ok {
 		err = v.UpdateTags(ctx, c, identifier, oldTags, newTags)
 	} else if v, ok := sp.(tftags.ResourceTypeTagUpdater); ok {
 		if w.ServicePackageResourceTags.ResourceType == "" {
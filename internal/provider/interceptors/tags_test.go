@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package interceptors
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type mockServicePackage struct {
+	name string
+}
+
+func (sp mockServicePackage) FrameworkDataSources(context.Context) []*types.ServicePackageFrameworkDataSource {
+	return nil
+}
+
+func (sp mockServicePackage) FrameworkResources(context.Context) []*types.ServicePackageFrameworkResource {
+	return nil
+}
+
+func (sp mockServicePackage) SDKDataSources(context.Context) []*types.ServicePackageSDKDataSource {
+	return nil
+}
+
+func (sp mockServicePackage) SDKResources(context.Context) []*types.ServicePackageSDKResource {
+	return nil
+}
+
+func (sp mockServicePackage) ServicePackageName() string {
+	return sp.name
+}
+
+func TestCanBatchUpdateTags(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		servicePackageName string
+		identifier         string
+		batcher            bool
+		expected           bool
+	}{
+		"ARN": {
+			servicePackageName: names.SNS,
+			identifier:         "arn:aws:sns:us-west-2:123456789012:example", //lintignore:AWSAT003,AWSAT005
+			batcher:            true,
+			expected:           true,
+		},
+		"ARN batching disabled": {
+			servicePackageName: names.SNS,
+			identifier:         "arn:aws:sns:us-west-2:123456789012:example", //lintignore:AWSAT003,AWSAT005
+		},
+		"ID": {
+			servicePackageName: names.ELBV2,
+			identifier:         "example",
+			batcher:            true,
+		},
+		"EC2 ID": {
+			servicePackageName: names.EC2,
+			identifier:         "vpc-12345678",
+			batcher:            true,
+		},
+		"global ARN": {
+			servicePackageName: names.CloudWatch,
+			identifier:         "arn:aws:cloudwatch::123456789012:dashboard/example", //lintignore:AWSAT005
+			batcher:            true,
+		},
+		"unsupported service package": {
+			servicePackageName: names.S3,
+			identifier:         "arn:aws:s3:us-west-2:123456789012:accesspoint/example", //lintignore:AWSAT003,AWSAT005
+			batcher:            true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			c := &conns.AWSClient{}
+			if testCase.batcher {
+				conns.SetTagBatcher(c, &conns.TagBatcher{})
+			}
+
+			if got, want := canBatchUpdateTags(ctx, mockServicePackage{name: testCase.servicePackageName}, c, testCase.identifier), testCase.expected; got != want {
+				t.Errorf("canBatchUpdateTags(%q) = %t, want %t", testCase.identifier, got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// SDKResource returns a new instance of the Plugin SDK resource of the specified type.
+// Unlike the resources in the provider's ResourcesMap, the returned resource's handlers are not wrapped,
+// so it is suitable for tools that inspect resource implementations.
+func SDKResource(ctx context.Context, typeName string) (*schema.Resource, bool) {
+	for _, sp := range servicePackages(ctx) {
+		for _, v := range sp.SDKResources(ctx) {
+			if v.TypeName == typeName {
+				return v.Factory(), true
+			}
+		}
+	}
+
+	return nil, false
+}
@@ -10,6 +10,8 @@ import (
 	"log"
 	"maps"
 	"os"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -20,6 +22,7 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -53,8 +56,61 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Optional:      true,
 				ConflictsWith: []string{"forbidden_account_ids"},
 			},
+			"api_limits": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Configuration blocks with per-service limits on AWS API calls.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_concurrent_requests": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "The maximum number of concurrent requests to the service's API.",
+						},
+						"requests_per_second": {
+							Type:        schema.TypeFloat,
+							Optional:    true,
+							Description: "The maximum number of requests per second to the service's API.",
+						},
+						"service": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The service to limit, using the same names as the `endpoints` block.",
+						},
+					},
+				},
+			},
+			"api_telemetry": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to record AWS API call telemetry.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"file": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Description: "File to which AWS API call telemetry is appended as calls complete. " +
+								"Can also be configured with the " + conns.APITelemetryFileEnvVar + " environment variable.",
+						},
+						"format": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(conns.APITelemetryFormat_Values(), false),
+							Description: "Format of the AWS API call telemetry file. " +
+								"Can also be configured with the " + conns.APITelemetryFormatEnvVar + " environment variable.",
+						},
+					},
+				},
+			},
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+			"batch_tagging": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Whether to update the tags of resources in services supporting the Resource Groups Tagging API in batches. " +
+					"Can also be configured with the " + conns.BatchTaggingEnvVar + " environment variable.",
+			},
 			"custom_ca_bundle": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -79,6 +135,18 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					},
 				},
 			},
+			"drift_diagnostics": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Whether to report resource attributes changed outside of Terraform as warnings when refreshing. " +
+					"Can also be configured with the " + conns.DriftDiagnosticsEnvVar + " environment variable.",
+			},
+			"drift_diagnostics_cloudtrail": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Whether drift diagnostics include recent CloudTrail events for the resource. " +
+					"Can also be configured with the " + conns.DriftDiagnosticsCloudTrailEnvVar + " environment variable.",
+			},
 			"ec2_metadata_service_endpoint": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -235,6 +303,56 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The region where AWS STS operations will take place. Examples\n" +
 					"are us-east-1 and us-west-2.", // lintignore:AWSAT003,
 			},
+			"tag_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with a tag policy enforced on resource tags at plan time.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"case_insensitive": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Whether tag keys and allowed value patterns are matched case-insensitively.",
+						},
+						"tag": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Policy rules for a single tag key.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"allowed_value_patterns": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringIsValidRegExp,
+										},
+										Description: "Regular expressions, one of which the tag value must match.",
+									},
+									names.AttrKey: {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The tag key.",
+									},
+									"precedence": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(tftags.PolicyPrecedence_Values(), false),
+										Description: "Whether the `default_tags` value (`provider`) or the resource `tags` value (`resource`) wins. " +
+											"Resources may not override a `provider` tag. Defaults to `resource`.",
+									},
+									"required": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Description: "Whether the tag must be set on every taggable resource, either directly or through `default_tags`.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"token": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -293,6 +411,15 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			}
 
 			interceptors := interceptorItems{}
+			isRegionOverrideEnabled := func() bool { return false }
+			if v.Region.IsRegionOverrideEnabled() {
+				isRegionOverrideEnabled = injectRegionAttribute(r, regionDataSourceSchema)
+				interceptors = append(interceptors, interceptorItem{
+					when:        After,
+					why:         Read,
+					interceptor: newRegionInterceptor(isRegionOverrideEnabled),
+				})
+			}
 			if v.Tags != nil {
 				schema := r.SchemaMap()
 
@@ -316,10 +443,15 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			}
 
 			opts := wrappedDataSourceOptions{
-				bootstrapContext: func(ctx context.Context, _ getAttributeFunc, meta any) (context.Context, diag.Diagnostics) {
+				bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, meta any) (context.Context, diag.Diagnostics) {
 					var diags diag.Diagnostics
+					var overrideRegion string
+
+					if isRegionOverrideEnabled() {
+						overrideRegion = overrideRegionFromAttribute(getAttribute)
+					}
 
-					ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+					ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name, typeName, overrideRegion)
 					if v, ok := meta.(*conns.AWSClient); ok {
 						ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx))
 						ctx = v.RegisterLogger(ctx)
@@ -362,8 +494,37 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				continue
 			}
 
+			injectIAMPolicyValidation(r)
+
 			var customizeDiffFuncs []schema.CustomizeDiffFunc
+			var importFuncs []importFunc
 			interceptors := interceptorItems{}
+			isRegionOverrideEnabled := func() bool { return false }
+			if v.Region.IsRegionOverrideEnabled() {
+				isRegionOverrideEnabled = injectRegionAttribute(r, regionResourceSchema)
+			}
+			// After interceptors are run last to first, so drift is reported once all others have updated state.
+			interceptors = append(interceptors, interceptorItem{
+				when:        After,
+				why:         Read,
+				interceptor: newDriftInterceptor(r.SchemaMap),
+			})
+			if v.Region.IsRegionOverrideEnabled() {
+				customizeDiffFuncs = append(customizeDiffFuncs, customdiff.If(func(context.Context, *schema.ResourceDiff, any) bool {
+					return isRegionOverrideEnabled()
+				}, setRegionInPlan))
+				importFuncs = append(importFuncs, func(ctx context.Context, d *schema.ResourceData, meta any) error {
+					if !isRegionOverrideEnabled() {
+						return nil
+					}
+					return importRegion(ctx, d, meta)
+				})
+				interceptors = append(interceptors, interceptorItem{
+					when:        After,
+					why:         Create | Read | Update,
+					interceptor: newRegionInterceptor(isRegionOverrideEnabled),
+				})
+			}
 			if v.Tags != nil {
 				schema := r.SchemaMap()
 
@@ -398,10 +559,15 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			opts := wrappedResourceOptions{
 				// bootstrapContext is run on all wrapped methods before any interceptors.
-				bootstrapContext: func(ctx context.Context, _ getAttributeFunc, meta any) (context.Context, diag.Diagnostics) {
+				bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, meta any) (context.Context, diag.Diagnostics) {
 					var diags diag.Diagnostics
+					var overrideRegion string
+
+					if isRegionOverrideEnabled() {
+						overrideRegion = overrideRegionFromAttribute(getAttribute)
+					}
 
-					ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+					ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, typeName, overrideRegion)
 					if v, ok := meta.(*conns.AWSClient); ok {
 						ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx))
 						ctx = v.RegisterLogger(ctx)
@@ -410,6 +576,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					return ctx, diags
 				},
 				customizeDiffFuncs: customizeDiffFuncs,
+				importFuncs:        importFuncs,
 				interceptors:       interceptors,
 				typeName:           typeName,
 			}
@@ -451,10 +618,14 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 	config := conns.Config{
 		AccessKey:                      d.Get("access_key").(string),
 		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
+		BatchTagging:                   d.Get("batch_tagging").(bool),
+		DriftDiagnostics:               d.Get("drift_diagnostics").(bool),
+		DriftDiagnosticsCloudTrail:     d.Get("drift_diagnostics_cloudtrail").(bool),
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
 		Endpoints:                      make(map[string]string),
 		Insecure:                       d.Get("insecure").(bool),
+		LocalEndpoint:                  os.Getenv(conns.LocalEndpointEnvVar),
 		MaxRetries:                     25, // Set default here, not in schema (muxing with v6 provider).
 		Profile:                        d.Get("profile").(string),
 		Region:                         d.Get("region").(string),
@@ -471,6 +642,30 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		UseFIPSEndpoint:                d.Get("use_fips_endpoint").(bool),
 	}
 
+	if v := os.Getenv(conns.BatchTaggingEnvVar); v != "" && !config.BatchTagging {
+		batchTagging, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "parsing %s environment variable: %s", conns.BatchTaggingEnvVar, err)
+		}
+		config.BatchTagging = batchTagging
+	}
+
+	if v := os.Getenv(conns.DriftDiagnosticsEnvVar); v != "" && !config.DriftDiagnostics {
+		driftDiagnostics, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "parsing %s environment variable: %s", conns.DriftDiagnosticsEnvVar, err)
+		}
+		config.DriftDiagnostics = driftDiagnostics
+	}
+
+	if v := os.Getenv(conns.DriftDiagnosticsCloudTrailEnvVar); v != "" && !config.DriftDiagnosticsCloudTrail {
+		driftDiagnosticsCloudTrail, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "parsing %s environment variable: %s", conns.DriftDiagnosticsCloudTrailEnvVar, err)
+		}
+		config.DriftDiagnosticsCloudTrail = driftDiagnosticsCloudTrail
+	}
+
 	if v, ok := d.Get("retry_mode").(string); ok && v != "" {
 		mode, err := aws.ParseRetryMode(v)
 		if err != nil {
@@ -487,6 +682,24 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.AllowedAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
+	if v, ok := d.GetOk("api_limits"); ok && len(v.([]any)) > 0 {
+		apiLimits, dx := expandAPILimits(ctx, cty.GetAttrPath("api_limits"), v.([]any))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.APILimits = apiLimits
+	}
+
+	if v, ok := d.GetOk("api_telemetry"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		config.APITelemetry = expandAPITelemetry(ctx, v.([]any)[0].(map[string]any))
+	} else {
+		config.APITelemetry = expandAPITelemetry(ctx, nil)
+	}
+	if v := config.APITelemetry; v != nil && !slices.Contains(conns.APITelemetryFormat_Values(), v.Format) {
+		return nil, sdkdiag.AppendErrorf(diags, "invalid API telemetry format %q, expected one of %s", v.Format, strings.Join(conns.APITelemetryFormat_Values(), ", "))
+	}
+
 	if v, ok := d.GetOk("assume_role"); ok {
 		path := cty.GetAttrPath("assume_role")
 		v := v.([]any)
@@ -567,6 +780,15 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.IgnoreTagsConfig = expandIgnoreTags(ctx, nil)
 	}
 
+	if v, ok := d.GetOk("tag_policy"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		tagPolicy, dx := expandTagPolicy(ctx, cty.GetAttrPath("tag_policy").IndexInt(0), v.([]any)[0].(map[string]any))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.TagPolicyConfig = tagPolicy
+	}
+
 	if v, ok := d.GetOk("max_retries"); ok {
 		config.MaxRetries = v.(int)
 	}
@@ -730,6 +952,74 @@ func assumeRoleWithWebIdentitySchema() *schema.Schema {
 	}
 }
 
+func expandAPILimits(ctx context.Context, path cty.Path, tfList []any) (map[string]conns.APILimit, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	apiLimits := make(map[string]conns.APILimit)
+
+	for i, v := range tfList {
+		tfMap, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		path := path.IndexInt(i)
+		service := tfMap["service"].(string)
+		servicePackageName, err := names.ProviderPackageForAlias(service)
+		if err != nil {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(path.GetAttr("service"), "Invalid Attribute Value", fmt.Sprintf("Unknown service %q.", service)))
+			continue
+		}
+		if _, ok := apiLimits[servicePackageName]; ok {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(path.GetAttr("service"), "Invalid Attribute Value", fmt.Sprintf("Duplicate limits for service %q.", service)))
+			continue
+		}
+
+		apiLimit := conns.APILimit{
+			MaxConcurrentRequests: max(tfMap["max_concurrent_requests"].(int), 0),
+			RequestsPerSecond:     max(tfMap["requests_per_second"].(float64), 0),
+		}
+		apiLimits[servicePackageName] = apiLimit
+
+		tflog.Info(ctx, "api_limits configuration set", map[string]any{
+			"tf_aws.api_limits.service_package":         servicePackageName,
+			"tf_aws.api_limits.max_concurrent_requests": apiLimit.MaxConcurrentRequests,
+			"tf_aws.api_limits.requests_per_second":     apiLimit.RequestsPerSecond,
+		})
+	}
+
+	return apiLimits, diags
+}
+
+func expandAPITelemetry(ctx context.Context, tfMap map[string]any) *conns.APITelemetryConfig {
+	var apiTelemetry conns.APITelemetryConfig
+
+	if v, ok := tfMap["file"].(string); ok && v != "" {
+		apiTelemetry.File = v
+	} else {
+		apiTelemetry.File = os.Getenv(conns.APITelemetryFileEnvVar)
+	}
+
+	if apiTelemetry.File == "" {
+		return nil
+	}
+
+	if v, ok := tfMap["format"].(string); ok && v != "" {
+		apiTelemetry.Format = v
+	} else if v := os.Getenv(conns.APITelemetryFormatEnvVar); v != "" {
+		apiTelemetry.Format = v
+	} else {
+		apiTelemetry.Format = conns.APITelemetryFormatJSONLines
+	}
+
+	tflog.Info(ctx, "api_telemetry configuration set", map[string]any{
+		"tf_aws.api_telemetry.file":   apiTelemetry.File,
+		"tf_aws.api_telemetry.format": apiTelemetry.Format,
+	})
+
+	return &apiTelemetry
+}
+
 func expandAssumeRoles(ctx context.Context, path cty.Path, tfList []any) (result []awsbase.AssumeRole, diags diag.Diagnostics) {
 	result = make([]awsbase.AssumeRole, len(tfList))
 
@@ -861,6 +1151,52 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]any) *tftags.Defaul
 	return nil
 }
 
+func expandTagPolicy(ctx context.Context, path cty.Path, tfMap map[string]any) (*tftags.PolicyConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tagPolicy := &tftags.PolicyConfig{
+		CaseSensitive: !tfMap["case_insensitive"].(bool),
+	}
+
+	for i, v := range tfMap["tag"].([]any) {
+		tfMap, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		path := path.GetAttr("tag").IndexInt(i)
+		policyTag := tftags.PolicyTag{
+			Key:        tfMap[names.AttrKey].(string),
+			Precedence: tfMap["precedence"].(string),
+			Required:   tfMap["required"].(bool),
+		}
+		if policyTag.Precedence == "" {
+			policyTag.Precedence = tftags.PolicyPrecedenceResource
+		}
+
+		if v, ok := tfMap["allowed_value_patterns"].(*schema.Set); ok {
+			for _, v := range v.List() {
+				re, err := tagPolicy.CompileValuePattern(v.(string))
+				if err != nil {
+					diags = append(diags, errs.NewAttributeErrorDiagnostic(path.GetAttr("allowed_value_patterns"), "Invalid Attribute Value", fmt.Sprintf("Invalid regular expression %q: %s.", v, err)))
+					continue
+				}
+				policyTag.AllowedValuePatterns = append(policyTag.AllowedValuePatterns, re)
+			}
+		}
+
+		tagPolicy.Tags = append(tagPolicy.Tags, policyTag)
+
+		tflog.Info(ctx, "tag_policy configuration set", map[string]any{
+			"tf_aws.tag_policy.key":        policyTag.Key,
+			"tf_aws.tag_policy.precedence": policyTag.Precedence,
+			"tf_aws.tag_policy.required":   policyTag.Required,
+		})
+	}
+
+	return tagPolicy, diags
+}
+
 func expandIgnoreTags(ctx context.Context, tfMap map[string]any) *tftags.IgnoreConfig {
 	var keys, keyPrefixes []any
 
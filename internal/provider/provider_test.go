@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -438,3 +439,136 @@ func popEnv(env []string) {
 		os.Setenv(k, v)
 	}
 }
+
+func TestExpandAPILimits(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testcases := map[string]struct {
+		tfList        []any
+		expected      map[string]conns.APILimit
+		expectedError bool
+	}{
+		"empty": {
+			tfList:   []any{},
+			expected: map[string]conns.APILimit{},
+		},
+		"aliases": {
+			tfList: []any{
+				map[string]any{"service": "iam", "max_concurrent_requests": 2, "requests_per_second": 0.0},
+				map[string]any{"service": "route53", "max_concurrent_requests": 0, "requests_per_second": 2.5},
+				map[string]any{"service": "cloudwatchlog", "max_concurrent_requests": 0, "requests_per_second": 10.0},
+			},
+			expected: map[string]conns.APILimit{
+				names.IAM:     {MaxConcurrentRequests: 2},
+				names.Route53: {RequestsPerSecond: 2.5},
+				names.Logs:    {RequestsPerSecond: 10},
+			},
+		},
+		"unknown service": {
+			tfList: []any{
+				map[string]any{"service": "example", "max_concurrent_requests": 1, "requests_per_second": 0.0},
+			},
+			expectedError: true,
+		},
+		"duplicate service": {
+			tfList: []any{
+				map[string]any{"service": "logs", "max_concurrent_requests": 1, "requests_per_second": 0.0},
+				map[string]any{"service": "cloudwatchlogs", "max_concurrent_requests": 2, "requests_per_second": 0.0},
+			},
+			expectedError: true,
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			results, diags := expandAPILimits(ctx, cty.GetAttrPath("api_limits"), testcase.tfList)
+
+			if got, want := diags.HasError(), testcase.expectedError; got != want {
+				t.Fatalf("expected error %t, got %t: %v", want, got, diags)
+			}
+			if testcase.expectedError {
+				return
+			}
+
+			if diff := cmp.Diff(results, testcase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandAPITelemetry(t *testing.T) { //nolint:paralleltest
+	ctx := context.Background()
+	testcases := map[string]struct {
+		tfMap    map[string]any
+		envvars  map[string]string
+		expected *conns.APITelemetryConfig
+	}{
+		"nil": {
+			tfMap:    nil,
+			envvars:  map[string]string{},
+			expected: nil,
+		},
+		"format only": {
+			tfMap: map[string]any{
+				"format": conns.APITelemetryFormatOTLPJSON,
+			},
+			envvars:  map[string]string{},
+			expected: nil,
+		},
+		"config": {
+			tfMap: map[string]any{
+				"file": "calls.json",
+			},
+			envvars: map[string]string{},
+			expected: &conns.APITelemetryConfig{
+				File:   "calls.json",
+				Format: conns.APITelemetryFormatJSONLines,
+			},
+		},
+		"envvar": {
+			tfMap: nil,
+			envvars: map[string]string{
+				conns.APITelemetryFileEnvVar:   "spans.json",
+				conns.APITelemetryFormatEnvVar: conns.APITelemetryFormatOTLPJSON,
+			},
+			expected: &conns.APITelemetryConfig{
+				File:   "spans.json",
+				Format: conns.APITelemetryFormatOTLPJSON,
+			},
+		},
+		"envvar and config": {
+			tfMap: map[string]any{
+				"file": "calls.json",
+			},
+			envvars: map[string]string{
+				conns.APITelemetryFileEnvVar:   "spans.json",
+				conns.APITelemetryFormatEnvVar: conns.APITelemetryFormatOTLPJSON,
+			},
+			expected: &conns.APITelemetryConfig{
+				File:   "calls.json",
+				Format: conns.APITelemetryFormatOTLPJSON,
+			},
+		},
+	}
+
+	for name, testcase := range testcases { //nolint:paralleltest
+		t.Run(name, func(t *testing.T) {
+			oldEnv := stashEnv()
+			defer popEnv(oldEnv)
+
+			for k, v := range testcase.envvars {
+				os.Setenv(k, v) //nolint:usetesting // stashEnv & popEnv require os.Setenv
+			}
+
+			results := expandAPITelemetry(ctx, testcase.tfMap)
+
+			if diff := cmp.Diff(results, testcase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/interceptors"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// regionDataSourceSchema returns the schema for the `region` attribute injected into regional data sources.
+func regionDataSourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: verify.ValidRegionName,
+		Description:  "The AWS Region to use for API operations. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+// regionResourceSchema returns the schema for the `region` attribute injected into regional resources.
+func regionResourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     true,
+		ValidateFunc: verify.ValidRegionName,
+		// Don't force replacement of resources created before the attribute was introduced.
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			return d.Id() != "" && old == ""
+		},
+		Description: "The AWS Region to use for API operations. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+// injectRegionAttribute adds the `region` attribute to the specified resource or data source's schema.
+// A schema that already defines a top-level `region` attribute manages its own Region semantics and is left untouched.
+// Schemas defined by SchemaFunc are not built here; the returned function reports whether the attribute was injected.
+func injectRegionAttribute(r *schema.Resource, f func() *schema.Schema) func() bool {
+	if v := r.SchemaFunc; v != nil {
+		r.SchemaFunc = func() map[string]*schema.Schema {
+			s := v()
+			if _, ok := s[names.AttrRegion]; !ok {
+				s[names.AttrRegion] = f()
+			}
+			return s
+		}
+
+		return sync.OnceValue(func() bool {
+			_, ok := v()[names.AttrRegion]
+			return !ok
+		})
+	}
+
+	if _, ok := r.Schema[names.AttrRegion]; ok {
+		return func() bool { return false }
+	}
+
+	r.Schema[names.AttrRegion] = f()
+
+	return func() bool { return true }
+}
+
+// overrideRegionFromAttribute returns the value of any configured per-resource Region override.
+func overrideRegionFromAttribute(getAttribute getAttributeFunc) string {
+	if getAttribute == nil {
+		return ""
+	}
+
+	if v, ok := getAttribute(names.AttrRegion); ok {
+		if v, ok := v.(string); ok {
+			return v
+		}
+	}
+
+	return ""
+}
+
+// regionInterceptor sets the `region` attribute in state.
+type regionInterceptor struct {
+	isEnabled func() bool
+}
+
+func newRegionInterceptor(isEnabled func() bool) interceptor {
+	return &regionInterceptor{
+		isEnabled: isEnabled,
+	}
+}
+
+func (r regionInterceptor) run(ctx context.Context, opts interceptorOptions) diag.Diagnostics {
+	c := opts.c
+	var diags diag.Diagnostics
+
+	if !r.isEnabled() {
+		return diags
+	}
+
+	switch d, when, why := opts.d, opts.when, opts.why; when {
+	case After:
+		switch why {
+		case Read:
+			// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+			if d.Id() == "" {
+				return diags
+			}
+
+			fallthrough
+		case Create, Update:
+			// Set the Region in state, including for resources created before the attribute was introduced.
+			if err := d.Set(names.AttrRegion, c.Region(ctx)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrRegion, err)
+			}
+		}
+	}
+
+	return diags
+}
+
+// setRegionInPlan is a CustomizeDiff function that defaults the `region` attribute to the provider-configured Region.
+func setRegionInPlan(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	c := meta.(*conns.AWSClient)
+
+	// If a Region is configured (or is unknown), use it.
+	if config := d.GetRawConfig(); !config.IsKnown() || (!config.IsNull() && !config.GetAttr(names.AttrRegion).IsNull()) {
+		return nil
+	}
+
+	// Don't force replacement of resources created before the attribute was introduced.
+	if d.Id() != "" {
+		if o, _ := d.GetChange(names.AttrRegion); o.(string) == "" {
+			return nil
+		}
+	}
+
+	if err := d.SetNew(names.AttrRegion, c.AwsConfig(ctx).Region); err != nil {
+		return fmt.Errorf("setting %s: %w", names.AttrRegion, err)
+	}
+
+	return nil
+}
+
+// importRegion is an import function that handles any `@<region>` suffix on the imported ID.
+func importRegion(_ context.Context, d *schema.ResourceData, _ any) error {
+	if id, region, ok := interceptors.ParseImportIDRegion(d.Id()); ok {
+		d.SetId(id)
+		if err := d.Set(names.AttrRegion, region); err != nil {
+			return fmt.Errorf("setting %s: %w", names.AttrRegion, err)
+		}
+	}
+
+	return nil
+}
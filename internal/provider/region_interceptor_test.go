@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"maps"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestInjectRegionAttribute(t *testing.T) {
+	t.Parallel()
+
+	ownRegion := &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+
+	testCases := map[string]struct {
+		schema       map[string]*schema.Schema
+		useFunc      bool
+		wantInjected bool
+	}{
+		"Schema": {
+			schema: map[string]*schema.Schema{
+				names.AttrName: {Type: schema.TypeString, Optional: true},
+			},
+			wantInjected: true,
+		},
+		"Schema with region": {
+			schema: map[string]*schema.Schema{
+				names.AttrName:   {Type: schema.TypeString, Optional: true},
+				names.AttrRegion: ownRegion,
+			},
+		},
+		"SchemaFunc": {
+			schema: map[string]*schema.Schema{
+				names.AttrName: {Type: schema.TypeString, Optional: true},
+			},
+			useFunc:      true,
+			wantInjected: true,
+		},
+		"SchemaFunc with region": {
+			schema: map[string]*schema.Schema{
+				names.AttrName:   {Type: schema.TypeString, Optional: true},
+				names.AttrRegion: ownRegion,
+			},
+			useFunc: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls int
+			r := &schema.Resource{}
+			if testCase.useFunc {
+				r.SchemaFunc = func() map[string]*schema.Schema {
+					calls++
+					return maps.Clone(testCase.schema)
+				}
+			} else {
+				r.Schema = maps.Clone(testCase.schema)
+			}
+
+			isInjected := injectRegionAttribute(r, regionResourceSchema)
+
+			if calls != 0 {
+				t.Errorf("SchemaFunc called %d times on injection, want 0", calls)
+			}
+
+			if got, want := isInjected(), testCase.wantInjected; got != want {
+				t.Errorf("injected = %t, want %t", got, want)
+			}
+
+			v, ok := r.SchemaMap()[names.AttrRegion]
+			if !ok {
+				t.Fatalf("no %s attribute in schema", names.AttrRegion)
+			}
+			if testCase.wantInjected {
+				if !v.Optional || !v.Computed || !v.ForceNew {
+					t.Errorf("%s attribute is not the injected schema: %#v", names.AttrRegion, v)
+				}
+			} else if v != ownRegion {
+				t.Errorf("%s attribute was overwritten: %#v", names.AttrRegion, v)
+			}
+		})
+	}
+}
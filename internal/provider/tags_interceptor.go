@@ -5,8 +5,10 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -51,7 +53,7 @@ func (r tagsResourceInterceptor) run(ctx context.Context, opts interceptorOption
 		switch why {
 		case Create, Update:
 			// Merge the resource's configured tags with any provider configured default_tags.
-			tags := c.DefaultTagsConfig(ctx).MergeTags(tftags.New(ctx, d.Get(names.AttrTags).(map[string]any)))
+			tags := c.TagPolicyConfig(ctx).MergeTags(tftags.New(ctx, d.Get(names.AttrTags).(map[string]any)), c.DefaultTagsConfig(ctx))
 			// Remove system tags.
 			tags = tags.IgnoreSystem(sp.ServicePackageName())
 
@@ -145,7 +147,7 @@ func (r tagsResourceInterceptor) run(ctx context.Context, opts interceptorOption
 				oldTags := tftags.New(ctx, stateTags)
 				// if tags_all was computed because not wholly known
 				// Merge the resource's configured tags with any provider configured default_tags.
-				newTags := c.DefaultTagsConfig(ctx).MergeTags(tftags.New(ctx, configTags))
+				newTags := c.TagPolicyConfig(ctx).MergeTags(tftags.New(ctx, configTags), c.DefaultTagsConfig(ctx))
 				// Remove system tags.
 				newTags = newTags.IgnoreSystem(sp.ServicePackageName())
 
@@ -275,7 +277,12 @@ func setTagsAll(ctx context.Context, d *schema.ResourceDiff, meta any) error {
 	}
 
 	newTags := tftags.New(ctx, d.Get(names.AttrTags).(map[string]any))
-	allTags := c.DefaultTagsConfig(ctx).MergeTags(newTags).IgnoreConfig(c.IgnoreTagsConfig(ctx))
+	if errs := c.TagPolicyConfig(ctx).Validate(newTags, c.DefaultTagsConfig(ctx)); len(errs) > 0 {
+		// Returning a cty.PathError surfaces the violation as a diagnostic on the `tags` attribute.
+		return cty.GetAttrPath(names.AttrTags).NewErrorf("tag policy violation: %s", errors.Join(errs...))
+	}
+
+	allTags := c.TagPolicyConfig(ctx).MergeTags(newTags, c.DefaultTagsConfig(ctx)).IgnoreConfig(c.IgnoreTagsConfig(ctx))
 	if d.HasChange(names.AttrTags) {
 		if newTags.HasZeroValue() {
 			if err := d.SetNewComputed(names.AttrTagsAll); err != nil {
@@ -6,6 +6,7 @@ package provider
 import (
 	"context"
 	"errors"
+	"maps"
 	"testing"
 
 	"github.com/hashicorp/go-cty/cty"
@@ -84,7 +85,7 @@ func TestTagsResourceInterceptor(t *testing.T) {
 	}))
 
 	bootstrapContext := func(ctx context.Context, meta any) context.Context {
-		ctx = conns.NewResourceContext(ctx, "Test", "aws_test")
+		ctx = conns.NewResourceContext(ctx, "Test", "aws_test", "aws_test", "")
 		if v, ok := meta.(*conns.AWSClient); ok {
 			ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx))
 		}
@@ -109,6 +110,121 @@ func TestTagsResourceInterceptor(t *testing.T) {
 	}
 }
 
+func TestTagsResourceInterceptorPolicyPrecedence(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policy   *tftags.PolicyConfig
+		expected map[string]string
+	}{
+		"no policy": {
+			expected: map[string]string{
+				"env":   "resource",
+				"owner": "provider",
+			},
+		},
+		"provider precedence": {
+			policy: &tftags.PolicyConfig{
+				Tags: []tftags.PolicyTag{
+					{Key: "env", Precedence: tftags.PolicyPrecedenceProvider},
+				},
+			},
+			expected: map[string]string{
+				"env":   "provider",
+				"owner": "provider",
+			},
+		},
+		"provider precedence case insensitive": {
+			policy: &tftags.PolicyConfig{
+				Tags: []tftags.PolicyTag{
+					{Key: "ENV", Precedence: tftags.PolicyPrecedenceProvider},
+				},
+			},
+			expected: map[string]string{
+				"env":   "provider",
+				"owner": "provider",
+			},
+		},
+		"resource precedence": {
+			policy: &tftags.PolicyConfig{
+				Tags: []tftags.PolicyTag{
+					{Key: "env", Precedence: tftags.PolicyPrecedenceResource},
+				},
+			},
+			expected: map[string]string{
+				"env":   "resource",
+				"owner": "provider",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			conn := &conns.AWSClient{}
+			conn.SetServicePackages(ctx, map[string]conns.ServicePackage{
+				"Test": &mockService{},
+			})
+			conns.SetDefaultTagsConfig(conn, expandDefaultTags(ctx, map[string]any{
+				"tags": map[string]any{
+					"env":   "provider",
+					"owner": "provider",
+				},
+			}))
+			conns.SetTagPolicyConfig(conn, testCase.policy)
+
+			ctx = conns.NewResourceContext(ctx, "Test", "aws_test", "aws_test", "")
+			ctx = tftags.NewContext(ctx, conn.DefaultTagsConfig(ctx), conn.IgnoreTagsConfig(ctx))
+
+			interceptor := newTagsResourceInterceptor(&types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			})
+			opts := interceptorOptions{
+				c: conn,
+				d: &tagsResourceData{
+					tags: map[string]any{
+						"env": "resource",
+					},
+				},
+				when: Before,
+				why:  Create,
+			}
+			if diags := interceptor.run(ctx, opts); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			inContext, ok := tftags.FromContext(ctx)
+			if !ok {
+				t.Fatal("tags not in context")
+			}
+
+			// The tags sent to AWS must be the tags planned for tags_all.
+			if got, want := inContext.TagsIn.UnwrapOrDefault().Map(), testCase.expected; !maps.Equal(got, want) {
+				t.Errorf("TagsIn = %v, want %v", got, want)
+			}
+			if got, want := conn.TagPolicyConfig(ctx).MergeTags(tftags.New(ctx, opts.d.Get("tags")), conn.DefaultTagsConfig(ctx)).Map(), testCase.expected; !maps.Equal(got, want) {
+				t.Errorf("tags_all = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+// tagsResourceData is a resourceData with configured tags.
+type tagsResourceData struct {
+	resourceData
+	tags map[string]any
+}
+
+func (d *tagsResourceData) Get(key string) any {
+	if key == "tags" {
+		return d.tags
+	}
+
+	return nil
+}
+
 type resourceData struct{}
 
 func (d *resourceData) GetRawConfig() cty.Value {
@@ -19,6 +19,9 @@ type getAttributeFunc func(string) (any, bool)
 // contextFunc augments Context.
 type contextFunc func(context.Context, getAttributeFunc, any) (context.Context, diag.Diagnostics)
 
+// importFunc is run on a resource import before the resource's importer.
+type importFunc func(context.Context, *schema.ResourceData, any) error
+
 type wrappedDataSourceOptions struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
@@ -49,6 +52,7 @@ type wrappedResourceOptions struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext   contextFunc
 	customizeDiffFuncs []schema.CustomizeDiffFunc
+	importFuncs        []importFunc
 	interceptors       interceptorItems
 	typeName           string
 }
@@ -114,6 +118,12 @@ func (w *wrappedResource) state(f schema.StateContextFunc) schema.StateContextFu
 	}
 
 	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		for _, f := range w.opts.importFuncs {
+			if err := f(ctx, d, meta); err != nil {
+				return nil, err
+			}
+		}
+
 		ctx, diags := w.opts.bootstrapContext(ctx, d.GetOk, meta)
 		if diags.HasError() {
 			return nil, sdkdiag.DiagnosticsError(diags)
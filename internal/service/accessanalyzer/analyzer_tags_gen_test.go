@@ -53,7 +53,11 @@ func testAccAccessAnalyzerAnalyzer_tags(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckLocalEndpoint(ctx, t, names.AccessAnalyzer)
+			testAccPreCheck(ctx, t)
+		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.AccessAnalyzerServiceID),
 		CheckDestroy:             testAccCheckAnalyzerDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -235,7 +239,11 @@ func testAccAccessAnalyzerAnalyzer_tags_null(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckLocalEndpoint(ctx, t, names.AccessAnalyzer)
+			testAccPreCheck(ctx, t)
+		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.AccessAnalyzerServiceID),
 		CheckDestroy:             testAccCheckAnalyzerDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -296,7 +304,11 @@ func testAccAccessAnalyzerAnalyzer_tags_EmptyMap(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckLocalEndpoint(ctx, t, names.AccessAnalyzer)
+			testAccPreCheck(ctx, t)
+		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.AccessAnalyzerServiceID),
 		CheckDestroy:             testAccCheckAnalyzerDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -353,7 +365,11 @@ func testAccAccessAnalyzerAnalyzer_tags_AddOnUpdate(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckLocalEndpoint(ctx, t, names.AccessAnalyzer)
+			testAccPreCheck(ctx, t)
+		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.AccessAnalyzerServiceID),
 		CheckDestroy:             testAccCheckAnalyzerDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -434,7 +450,11 @@ func testAccAccessAnalyzerAnalyzer_tags_EmptyTag_OnCreate(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckLocalEndpoint(ctx, t, names.AccessAnalyzer)
+			testAccPreCheck(ctx, t)
+		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.AccessAnalyzerServiceID),
 		CheckDestroy:             testAccCheckAnalyzerDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -523,7 +543,11 @@ func testAccAccessAnalyzerAnalyzer_tags_EmptyTag_OnUpdate_Add(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckLocalEndpoint(ctx, t, names.AccessAnalyzer)
+			testAccPreCheck(ctx, t)
+		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.AccessAnalyzerServiceID),
 		CheckDestroy:             testAccCheckAnalyzerDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -660,7 +684,11 @@ func testAccAccessAnalyzerAnalyzer_tags_EmptyTag_OnUpdate_Replace(t *testing.T)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckLocalEndpoint(ctx, t, names.AccessAnalyzer)
+			testAccPreCheck(ctx, t)
+		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.AccessAnalyzerServiceID),
 		CheckDestroy:             testAccCheckAnalyzerDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -749,7 +777,11 @@ func testAccAccessAnalyzerAnalyzer_tags_DefaultTags_providerOnly(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckLocalEndpoint(ctx, t, names.AccessAnalyzer)
+			testAccPreCheck(ctx, t)
+		},
 		ErrorCheck:   acctest.ErrorCheck(t, names.AccessAnalyzerServiceID),
 		CheckDestroy: testAccCheckAnalyzerDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -930,7 +962,11 @@ func testAccAccessAnalyzerAnalyzer_tags_DefaultTags_nonOverlapping(t *testing.T)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckLocalEndpoint(ctx, t, names.AccessAnalyzer)
+			testAccPreCheck(ctx, t)
+		},
 		ErrorCheck:   acctest.ErrorCheck(t, names.AccessAnalyzerServiceID),
 		CheckDestroy: testAccCheckAnalyzerDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1090,7 +1126,11 @@ func testAccAccessAnalyzerAnalyzer_tags_DefaultTags_overlapping(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckLocalEndpoint(ctx, t, names.AccessAnalyzer)
+			testAccPreCheck(ctx, t)
+		},
 		ErrorCheck:   acctest.ErrorCheck(t, names.AccessAnalyzerServiceID),
 		CheckDestroy: testAccCheckAnalyzerDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1266,7 +1306,11 @@ func testAccAccessAnalyzerAnalyzer_tags_DefaultTags_updateToProviderOnly(t *test
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckLocalEndpoint(ctx, t, names.AccessAnalyzer)
+			testAccPreCheck(ctx, t)
+		},
 		ErrorCheck:   acctest.ErrorCheck(t, names.AccessAnalyzerServiceID),
 		CheckDestroy: testAccCheckAnalyzerDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1356,7 +1400,11 @@ func testAccAccessAnalyzerAnalyzer_tags_DefaultTags_updateToResourceOnly(t *test
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckLocalEndpoint(ctx, t, names.AccessAnalyzer)
+			testAccPreCheck(ctx, t)
+		},
 		ErrorCheck:   acctest.ErrorCheck(t, names.AccessAnalyzerServiceID),
 		CheckDestroy: testAccCheckAnalyzerDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1445,7 +1493,11 @@ func testAccAccessAnalyzerAnalyzer_tags_DefaultTags_emptyResourceTag(t *testing.
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckLocalEndpoint(ctx, t, names.AccessAnalyzer)
+			testAccPreCheck(ctx, t)
+		},
 		ErrorCheck:   acctest.ErrorCheck(t, names.AccessAnalyzerServiceID),
 		CheckDestroy: testAccCheckAnalyzerDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1510,7 +1562,11 @@ func testAccAccessAnalyzerAnalyzer_tags_DefaultTags_emptyProviderOnlyTag(t *test
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckLocalEndpoint(ctx, t, names.AccessAnalyzer)
+			testAccPreCheck(ctx, t)
+		},
 		ErrorCheck:   acctest.ErrorCheck(t, names.AccessAnalyzerServiceID),
 		CheckDestroy: testAccCheckAnalyzerDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1567,7 +1623,11 @@ func testAccAccessAnalyzerAnalyzer_tags_DefaultTags_nullOverlappingResourceTag(t
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckLocalEndpoint(ctx, t, names.AccessAnalyzer)
+			testAccPreCheck(ctx, t)
+		},
 		ErrorCheck:   acctest.ErrorCheck(t, names.AccessAnalyzerServiceID),
 		CheckDestroy: testAccCheckAnalyzerDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1629,7 +1689,11 @@ func testAccAccessAnalyzerAnalyzer_tags_DefaultTags_nullNonOverlappingResourceTa
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckLocalEndpoint(ctx, t, names.AccessAnalyzer)
+			testAccPreCheck(ctx, t)
+		},
 		ErrorCheck:   acctest.ErrorCheck(t, names.AccessAnalyzerServiceID),
 		CheckDestroy: testAccCheckAnalyzerDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1691,7 +1755,11 @@ func testAccAccessAnalyzerAnalyzer_tags_ComputedTag_OnCreate(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckLocalEndpoint(ctx, t, names.AccessAnalyzer)
+			testAccPreCheck(ctx, t)
+		},
 		ErrorCheck:   acctest.ErrorCheck(t, names.AccessAnalyzerServiceID),
 		CheckDestroy: testAccCheckAnalyzerDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1746,7 +1814,11 @@ func testAccAccessAnalyzerAnalyzer_tags_ComputedTag_OnUpdate_Add(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckLocalEndpoint(ctx, t, names.AccessAnalyzer)
+			testAccPreCheck(ctx, t)
+		},
 		ErrorCheck:   acctest.ErrorCheck(t, names.AccessAnalyzerServiceID),
 		CheckDestroy: testAccCheckAnalyzerDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1843,7 +1915,11 @@ func testAccAccessAnalyzerAnalyzer_tags_ComputedTag_OnUpdate_Replace(t *testing.
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckLocalEndpoint(ctx, t, names.AccessAnalyzer)
+			testAccPreCheck(ctx, t)
+		},
 		ErrorCheck:   acctest.ErrorCheck(t, names.AccessAnalyzerServiceID),
 		CheckDestroy: testAccCheckAnalyzerDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1930,7 +2006,11 @@ func testAccAccessAnalyzerAnalyzer_tags_IgnoreTags_Overlap_DefaultTag(t *testing
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckLocalEndpoint(ctx, t, names.AccessAnalyzer)
+			testAccPreCheck(ctx, t)
+		},
 		ErrorCheck:   acctest.ErrorCheck(t, names.AccessAnalyzerServiceID),
 		CheckDestroy: testAccCheckAnalyzerDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -2092,7 +2172,11 @@ func testAccAccessAnalyzerAnalyzer_tags_IgnoreTags_Overlap_ResourceTag(t *testin
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckLocalEndpoint(ctx, t, names.AccessAnalyzer)
+			testAccPreCheck(ctx, t)
+		},
 		ErrorCheck:   acctest.ErrorCheck(t, names.AccessAnalyzerServiceID),
 		CheckDestroy: testAccCheckAnalyzerDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -29,6 +29,7 @@ import (
 )
 
 // @SDKResource("aws_account_alternate_contact", name="Alternate Contact")
+// @Region(global=true)
 func resourceAlternateContact() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceAlternateContactCreate,
@@ -24,6 +24,7 @@ import (
 )
 
 // @SDKResource("aws_account_primary_contact", name="Primary Contact")
+// @Region(global=true)
 func resourcePrimaryContact() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourcePrimaryContactPut,
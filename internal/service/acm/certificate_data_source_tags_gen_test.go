@@ -21,7 +21,7 @@ func TestAccACMCertificateDataSource_tags(t *testing.T) {
 	certificatePEM := acctest.TLSRSAX509SelfSignedCertificatePEM(t, privateKeyPEM, acctest.RandomDomain().String())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ACM) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.ACMServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
 		Steps: []resource.TestStep{
@@ -51,7 +51,7 @@ func TestAccACMCertificateDataSource_tags_NullMap(t *testing.T) {
 	certificatePEM := acctest.TLSRSAX509SelfSignedCertificatePEM(t, privateKeyPEM, acctest.RandomDomain().String())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ACM) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.ACMServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
 		Steps: []resource.TestStep{
@@ -77,7 +77,7 @@ func TestAccACMCertificateDataSource_tags_EmptyMap(t *testing.T) {
 	certificatePEM := acctest.TLSRSAX509SelfSignedCertificatePEM(t, privateKeyPEM, acctest.RandomDomain().String())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ACM) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.ACMServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
 		Steps: []resource.TestStep{
@@ -103,7 +103,7 @@ func TestAccACMCertificateDataSource_tags_DefaultTags_nonOverlapping(t *testing.
 	certificatePEM := acctest.TLSRSAX509SelfSignedCertificatePEM(t, privateKeyPEM, acctest.RandomDomain().String())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		PreCheck:   func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ACM) },
 		ErrorCheck: acctest.ErrorCheck(t, names.ACMServiceID),
 		Steps: []resource.TestStep{
 			{
@@ -137,7 +137,7 @@ func TestAccACMCertificateDataSource_tags_IgnoreTags_Overlap_DefaultTag(t *testi
 	certificatePEM := acctest.TLSRSAX509SelfSignedCertificatePEM(t, privateKeyPEM, acctest.RandomDomain().String())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		PreCheck:   func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ACM) },
 		ErrorCheck: acctest.ErrorCheck(t, names.ACMServiceID),
 		Steps: []resource.TestStep{
 			{
@@ -177,7 +177,7 @@ func TestAccACMCertificateDataSource_tags_IgnoreTags_Overlap_ResourceTag(t *test
 	certificatePEM := acctest.TLSRSAX509SelfSignedCertificatePEM(t, privateKeyPEM, acctest.RandomDomain().String())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		PreCheck:   func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ACM) },
 		ErrorCheck: acctest.ErrorCheck(t, names.ACMServiceID),
 		Steps: []resource.TestStep{
 			{
@@ -24,7 +24,7 @@ func TestAccACMCertificate_tags(t *testing.T) {
 	certificatePEM := acctest.TLSRSAX509SelfSignedCertificatePEM(t, privateKeyPEM, acctest.RandomDomain().String())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ACM) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.ACMServiceID),
 		CheckDestroy:             testAccCheckCertificateDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -227,7 +227,7 @@ func TestAccACMCertificate_tags_null(t *testing.T) {
 	certificatePEM := acctest.TLSRSAX509SelfSignedCertificatePEM(t, privateKeyPEM, acctest.RandomDomain().String())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ACM) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.ACMServiceID),
 		CheckDestroy:             testAccCheckCertificateDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -295,7 +295,7 @@ func TestAccACMCertificate_tags_EmptyMap(t *testing.T) {
 	certificatePEM := acctest.TLSRSAX509SelfSignedCertificatePEM(t, privateKeyPEM, acctest.RandomDomain().String())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ACM) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.ACMServiceID),
 		CheckDestroy:             testAccCheckCertificateDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -359,7 +359,7 @@ func TestAccACMCertificate_tags_AddOnUpdate(t *testing.T) {
 	certificatePEM := acctest.TLSRSAX509SelfSignedCertificatePEM(t, privateKeyPEM, acctest.RandomDomain().String())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ACM) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.ACMServiceID),
 		CheckDestroy:             testAccCheckCertificateDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -447,7 +447,7 @@ func TestAccACMCertificate_tags_EmptyTag_OnCreate(t *testing.T) {
 	certificatePEM := acctest.TLSRSAX509SelfSignedCertificatePEM(t, privateKeyPEM, acctest.RandomDomain().String())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ACM) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.ACMServiceID),
 		CheckDestroy:             testAccCheckCertificateDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -547,7 +547,7 @@ func TestAccACMCertificate_tags_EmptyTag_OnUpdate_Add(t *testing.T) {
 	certificatePEM := acctest.TLSRSAX509SelfSignedCertificatePEM(t, privateKeyPEM, acctest.RandomDomain().String())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ACM) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.ACMServiceID),
 		CheckDestroy:             testAccCheckCertificateDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -696,7 +696,7 @@ func TestAccACMCertificate_tags_EmptyTag_OnUpdate_Replace(t *testing.T) {
 	certificatePEM := acctest.TLSRSAX509SelfSignedCertificatePEM(t, privateKeyPEM, acctest.RandomDomain().String())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ACM) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.ACMServiceID),
 		CheckDestroy:             testAccCheckCertificateDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -792,7 +792,7 @@ func TestAccACMCertificate_tags_DefaultTags_providerOnly(t *testing.T) {
 	certificatePEM := acctest.TLSRSAX509SelfSignedCertificatePEM(t, privateKeyPEM, acctest.RandomDomain().String())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ACM) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.ACMServiceID),
 		CheckDestroy: testAccCheckCertificateDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -994,7 +994,7 @@ func TestAccACMCertificate_tags_DefaultTags_nonOverlapping(t *testing.T) {
 	certificatePEM := acctest.TLSRSAX509SelfSignedCertificatePEM(t, privateKeyPEM, acctest.RandomDomain().String())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ACM) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.ACMServiceID),
 		CheckDestroy: testAccCheckCertificateDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1170,7 +1170,7 @@ func TestAccACMCertificate_tags_DefaultTags_overlapping(t *testing.T) {
 	certificatePEM := acctest.TLSRSAX509SelfSignedCertificatePEM(t, privateKeyPEM, acctest.RandomDomain().String())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ACM) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.ACMServiceID),
 		CheckDestroy: testAccCheckCertificateDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1362,7 +1362,7 @@ func TestAccACMCertificate_tags_DefaultTags_updateToProviderOnly(t *testing.T) {
 	certificatePEM := acctest.TLSRSAX509SelfSignedCertificatePEM(t, privateKeyPEM, acctest.RandomDomain().String())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ACM) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.ACMServiceID),
 		CheckDestroy: testAccCheckCertificateDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1459,7 +1459,7 @@ func TestAccACMCertificate_tags_DefaultTags_updateToResourceOnly(t *testing.T) {
 	certificatePEM := acctest.TLSRSAX509SelfSignedCertificatePEM(t, privateKeyPEM, acctest.RandomDomain().String())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ACM) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.ACMServiceID),
 		CheckDestroy: testAccCheckCertificateDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1555,7 +1555,7 @@ func TestAccACMCertificate_tags_DefaultTags_emptyResourceTag(t *testing.T) {
 	certificatePEM := acctest.TLSRSAX509SelfSignedCertificatePEM(t, privateKeyPEM, acctest.RandomDomain().String())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ACM) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.ACMServiceID),
 		CheckDestroy: testAccCheckCertificateDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1626,7 +1626,7 @@ func TestAccACMCertificate_tags_DefaultTags_emptyProviderOnlyTag(t *testing.T) {
 	certificatePEM := acctest.TLSRSAX509SelfSignedCertificatePEM(t, privateKeyPEM, acctest.RandomDomain().String())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ACM) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.ACMServiceID),
 		CheckDestroy: testAccCheckCertificateDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1689,7 +1689,7 @@ func TestAccACMCertificate_tags_DefaultTags_nullOverlappingResourceTag(t *testin
 	certificatePEM := acctest.TLSRSAX509SelfSignedCertificatePEM(t, privateKeyPEM, acctest.RandomDomain().String())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ACM) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.ACMServiceID),
 		CheckDestroy: testAccCheckCertificateDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1757,7 +1757,7 @@ func TestAccACMCertificate_tags_DefaultTags_nullNonOverlappingResourceTag(t *tes
 	certificatePEM := acctest.TLSRSAX509SelfSignedCertificatePEM(t, privateKeyPEM, acctest.RandomDomain().String())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ACM) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.ACMServiceID),
 		CheckDestroy: testAccCheckCertificateDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1825,7 +1825,7 @@ func TestAccACMCertificate_tags_ComputedTag_OnCreate(t *testing.T) {
 	certificatePEM := acctest.TLSRSAX509SelfSignedCertificatePEM(t, privateKeyPEM, acctest.RandomDomain().String())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ACM) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.ACMServiceID),
 		CheckDestroy: testAccCheckCertificateDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1886,7 +1886,7 @@ func TestAccACMCertificate_tags_ComputedTag_OnUpdate_Add(t *testing.T) {
 	certificatePEM := acctest.TLSRSAX509SelfSignedCertificatePEM(t, privateKeyPEM, acctest.RandomDomain().String())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ACM) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.ACMServiceID),
 		CheckDestroy: testAccCheckCertificateDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1990,7 +1990,7 @@ func TestAccACMCertificate_tags_ComputedTag_OnUpdate_Replace(t *testing.T) {
 	certificatePEM := acctest.TLSRSAX509SelfSignedCertificatePEM(t, privateKeyPEM, acctest.RandomDomain().String())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ACM) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.ACMServiceID),
 		CheckDestroy: testAccCheckCertificateDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -2084,7 +2084,7 @@ func TestAccACMCertificate_tags_IgnoreTags_Overlap_DefaultTag(t *testing.T) {
 	certificatePEM := acctest.TLSRSAX509SelfSignedCertificatePEM(t, privateKeyPEM, acctest.RandomDomain().String())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ACM) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.ACMServiceID),
 		CheckDestroy: testAccCheckCertificateDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -2250,7 +2250,7 @@ func TestAccACMCertificate_tags_IgnoreTags_Overlap_ResourceTag(t *testing.T) {
 	certificatePEM := acctest.TLSRSAX509SelfSignedCertificatePEM(t, privateKeyPEM, acctest.RandomDomain().String())
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ACM) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.ACMServiceID),
 		CheckDestroy: testAccCheckCertificateDestroy(ctx),
 		Steps: []resource.TestStep{
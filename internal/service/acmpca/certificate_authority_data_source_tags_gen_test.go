@@ -21,7 +21,7 @@ func TestAccACMPCACertificateAuthorityDataSource_tags(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ACMPCA) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.ACMPCAServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
 		Steps: []resource.TestStep{
@@ -49,7 +49,7 @@ func TestAccACMPCACertificateAuthorityDataSource_tags_NullMap(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ACMPCA) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.ACMPCAServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
 		Steps: []resource.TestStep{
@@ -73,7 +73,7 @@ func TestAccACMPCACertificateAuthorityDataSource_tags_EmptyMap(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ACMPCA) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.ACMPCAServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
 		Steps: []resource.TestStep{
@@ -97,7 +97,7 @@ func TestAccACMPCACertificateAuthorityDataSource_tags_DefaultTags_nonOverlapping
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		PreCheck:   func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ACMPCA) },
 		ErrorCheck: acctest.ErrorCheck(t, names.ACMPCAServiceID),
 		Steps: []resource.TestStep{
 			{
@@ -129,7 +129,7 @@ func TestAccACMPCACertificateAuthorityDataSource_tags_IgnoreTags_Overlap_Default
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		PreCheck:   func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ACMPCA) },
 		ErrorCheck: acctest.ErrorCheck(t, names.ACMPCAServiceID),
 		Steps: []resource.TestStep{
 			{
@@ -167,7 +167,7 @@ func TestAccACMPCACertificateAuthorityDataSource_tags_IgnoreTags_Overlap_Resourc
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		PreCheck:   func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ACMPCA) },
 		ErrorCheck: acctest.ErrorCheck(t, names.ACMPCAServiceID),
 		Steps: []resource.TestStep{
 			{
@@ -53,7 +53,7 @@ func testAccAmplifyBranch_tags(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Amplify) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.AmplifyServiceID),
 		CheckDestroy:             testAccCheckBranchDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -235,7 +235,7 @@ func testAccAmplifyBranch_tags_null(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Amplify) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.AmplifyServiceID),
 		CheckDestroy:             testAccCheckBranchDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -296,7 +296,7 @@ func testAccAmplifyBranch_tags_EmptyMap(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Amplify) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.AmplifyServiceID),
 		CheckDestroy:             testAccCheckBranchDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -353,7 +353,7 @@ func testAccAmplifyBranch_tags_AddOnUpdate(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Amplify) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.AmplifyServiceID),
 		CheckDestroy:             testAccCheckBranchDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -434,7 +434,7 @@ func testAccAmplifyBranch_tags_EmptyTag_OnCreate(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Amplify) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.AmplifyServiceID),
 		CheckDestroy:             testAccCheckBranchDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -523,7 +523,7 @@ func testAccAmplifyBranch_tags_EmptyTag_OnUpdate_Add(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Amplify) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.AmplifyServiceID),
 		CheckDestroy:             testAccCheckBranchDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -660,7 +660,7 @@ func testAccAmplifyBranch_tags_EmptyTag_OnUpdate_Replace(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Amplify) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.AmplifyServiceID),
 		CheckDestroy:             testAccCheckBranchDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -749,7 +749,7 @@ func testAccAmplifyBranch_tags_DefaultTags_providerOnly(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Amplify) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.AmplifyServiceID),
 		CheckDestroy: testAccCheckBranchDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -930,7 +930,7 @@ func testAccAmplifyBranch_tags_DefaultTags_nonOverlapping(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Amplify) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.AmplifyServiceID),
 		CheckDestroy: testAccCheckBranchDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1090,7 +1090,7 @@ func testAccAmplifyBranch_tags_DefaultTags_overlapping(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Amplify) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.AmplifyServiceID),
 		CheckDestroy: testAccCheckBranchDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1266,7 +1266,7 @@ func testAccAmplifyBranch_tags_DefaultTags_updateToProviderOnly(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Amplify) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.AmplifyServiceID),
 		CheckDestroy: testAccCheckBranchDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1356,7 +1356,7 @@ func testAccAmplifyBranch_tags_DefaultTags_updateToResourceOnly(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Amplify) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.AmplifyServiceID),
 		CheckDestroy: testAccCheckBranchDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1445,7 +1445,7 @@ func testAccAmplifyBranch_tags_DefaultTags_emptyResourceTag(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Amplify) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.AmplifyServiceID),
 		CheckDestroy: testAccCheckBranchDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1510,7 +1510,7 @@ func testAccAmplifyBranch_tags_DefaultTags_emptyProviderOnlyTag(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Amplify) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.AmplifyServiceID),
 		CheckDestroy: testAccCheckBranchDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1567,7 +1567,7 @@ func testAccAmplifyBranch_tags_DefaultTags_nullOverlappingResourceTag(t *testing
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Amplify) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.AmplifyServiceID),
 		CheckDestroy: testAccCheckBranchDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1629,7 +1629,7 @@ func testAccAmplifyBranch_tags_DefaultTags_nullNonOverlappingResourceTag(t *test
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Amplify) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.AmplifyServiceID),
 		CheckDestroy: testAccCheckBranchDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1691,7 +1691,7 @@ func testAccAmplifyBranch_tags_ComputedTag_OnCreate(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Amplify) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.AmplifyServiceID),
 		CheckDestroy: testAccCheckBranchDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1746,7 +1746,7 @@ func testAccAmplifyBranch_tags_ComputedTag_OnUpdate_Add(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Amplify) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.AmplifyServiceID),
 		CheckDestroy: testAccCheckBranchDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1843,7 +1843,7 @@ func testAccAmplifyBranch_tags_ComputedTag_OnUpdate_Replace(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Amplify) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.AmplifyServiceID),
 		CheckDestroy: testAccCheckBranchDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1930,7 +1930,7 @@ func testAccAmplifyBranch_tags_IgnoreTags_Overlap_DefaultTag(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Amplify) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.AmplifyServiceID),
 		CheckDestroy: testAccCheckBranchDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -2092,7 +2092,7 @@ func testAccAmplifyBranch_tags_IgnoreTags_Overlap_ResourceTag(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Amplify) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.AmplifyServiceID),
 		CheckDestroy: testAccCheckBranchDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -24,7 +24,7 @@ func TestAccApplicationInsightsApplication_tags(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ApplicationInsights) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationInsightsServiceID),
 		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -206,7 +206,7 @@ func TestAccApplicationInsightsApplication_tags_null(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ApplicationInsights) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationInsightsServiceID),
 		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -267,7 +267,7 @@ func TestAccApplicationInsightsApplication_tags_EmptyMap(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ApplicationInsights) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationInsightsServiceID),
 		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -324,7 +324,7 @@ func TestAccApplicationInsightsApplication_tags_AddOnUpdate(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ApplicationInsights) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationInsightsServiceID),
 		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -405,7 +405,7 @@ func TestAccApplicationInsightsApplication_tags_EmptyTag_OnCreate(t *testing.T)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ApplicationInsights) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationInsightsServiceID),
 		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -494,7 +494,7 @@ func TestAccApplicationInsightsApplication_tags_EmptyTag_OnUpdate_Add(t *testing
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ApplicationInsights) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationInsightsServiceID),
 		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -631,7 +631,7 @@ func TestAccApplicationInsightsApplication_tags_EmptyTag_OnUpdate_Replace(t *tes
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ApplicationInsights) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationInsightsServiceID),
 		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -720,7 +720,7 @@ func TestAccApplicationInsightsApplication_tags_DefaultTags_providerOnly(t *test
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ApplicationInsights) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.ApplicationInsightsServiceID),
 		CheckDestroy: testAccCheckApplicationDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -901,7 +901,7 @@ func TestAccApplicationInsightsApplication_tags_DefaultTags_nonOverlapping(t *te
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ApplicationInsights) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.ApplicationInsightsServiceID),
 		CheckDestroy: testAccCheckApplicationDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1061,7 +1061,7 @@ func TestAccApplicationInsightsApplication_tags_DefaultTags_overlapping(t *testi
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ApplicationInsights) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.ApplicationInsightsServiceID),
 		CheckDestroy: testAccCheckApplicationDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1237,7 +1237,7 @@ func TestAccApplicationInsightsApplication_tags_DefaultTags_updateToProviderOnly
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ApplicationInsights) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.ApplicationInsightsServiceID),
 		CheckDestroy: testAccCheckApplicationDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1327,7 +1327,7 @@ func TestAccApplicationInsightsApplication_tags_DefaultTags_updateToResourceOnly
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ApplicationInsights) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.ApplicationInsightsServiceID),
 		CheckDestroy: testAccCheckApplicationDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1416,7 +1416,7 @@ func TestAccApplicationInsightsApplication_tags_DefaultTags_emptyResourceTag(t *
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ApplicationInsights) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.ApplicationInsightsServiceID),
 		CheckDestroy: testAccCheckApplicationDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1481,7 +1481,7 @@ func TestAccApplicationInsightsApplication_tags_DefaultTags_emptyProviderOnlyTag
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ApplicationInsights) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.ApplicationInsightsServiceID),
 		CheckDestroy: testAccCheckApplicationDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1538,7 +1538,7 @@ func TestAccApplicationInsightsApplication_tags_DefaultTags_nullOverlappingResou
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ApplicationInsights) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.ApplicationInsightsServiceID),
 		CheckDestroy: testAccCheckApplicationDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1600,7 +1600,7 @@ func TestAccApplicationInsightsApplication_tags_DefaultTags_nullNonOverlappingRe
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ApplicationInsights) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.ApplicationInsightsServiceID),
 		CheckDestroy: testAccCheckApplicationDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1662,7 +1662,7 @@ func TestAccApplicationInsightsApplication_tags_ComputedTag_OnCreate(t *testing.
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ApplicationInsights) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.ApplicationInsightsServiceID),
 		CheckDestroy: testAccCheckApplicationDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1717,7 +1717,7 @@ func TestAccApplicationInsightsApplication_tags_ComputedTag_OnUpdate_Add(t *test
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ApplicationInsights) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.ApplicationInsightsServiceID),
 		CheckDestroy: testAccCheckApplicationDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1814,7 +1814,7 @@ func TestAccApplicationInsightsApplication_tags_ComputedTag_OnUpdate_Replace(t *
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ApplicationInsights) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.ApplicationInsightsServiceID),
 		CheckDestroy: testAccCheckApplicationDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1901,7 +1901,7 @@ func TestAccApplicationInsightsApplication_tags_IgnoreTags_Overlap_DefaultTag(t
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ApplicationInsights) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.ApplicationInsightsServiceID),
 		CheckDestroy: testAccCheckApplicationDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -2063,7 +2063,7 @@ func TestAccApplicationInsightsApplication_tags_IgnoreTags_Overlap_ResourceTag(t
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ApplicationInsights) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.ApplicationInsightsServiceID),
 		CheckDestroy: testAccCheckApplicationDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -53,7 +53,7 @@ func testAccAppMeshGatewayRoute_tags(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.AppMesh) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.AppMeshServiceID),
 		CheckDestroy:             testAccCheckGatewayRouteDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -239,7 +239,7 @@ func testAccAppMeshGatewayRoute_tags_null(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.AppMesh) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.AppMeshServiceID),
 		CheckDestroy:             testAccCheckGatewayRouteDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -301,7 +301,7 @@ func testAccAppMeshGatewayRoute_tags_EmptyMap(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.AppMesh) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.AppMeshServiceID),
 		CheckDestroy:             testAccCheckGatewayRouteDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -359,7 +359,7 @@ func testAccAppMeshGatewayRoute_tags_AddOnUpdate(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.AppMesh) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.AppMeshServiceID),
 		CheckDestroy:             testAccCheckGatewayRouteDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -441,7 +441,7 @@ func testAccAppMeshGatewayRoute_tags_EmptyTag_OnCreate(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.AppMesh) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.AppMeshServiceID),
 		CheckDestroy:             testAccCheckGatewayRouteDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -532,7 +532,7 @@ func testAccAppMeshGatewayRoute_tags_EmptyTag_OnUpdate_Add(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.AppMesh) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.AppMeshServiceID),
 		CheckDestroy:             testAccCheckGatewayRouteDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -671,7 +671,7 @@ func testAccAppMeshGatewayRoute_tags_EmptyTag_OnUpdate_Replace(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.AppMesh) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.AppMeshServiceID),
 		CheckDestroy:             testAccCheckGatewayRouteDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -761,7 +761,7 @@ func testAccAppMeshGatewayRoute_tags_DefaultTags_providerOnly(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.AppMesh) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.AppMeshServiceID),
 		CheckDestroy: testAccCheckGatewayRouteDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -946,7 +946,7 @@ func testAccAppMeshGatewayRoute_tags_DefaultTags_nonOverlapping(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.AppMesh) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.AppMeshServiceID),
 		CheckDestroy: testAccCheckGatewayRouteDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1109,7 +1109,7 @@ func testAccAppMeshGatewayRoute_tags_DefaultTags_overlapping(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.AppMesh) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.AppMeshServiceID),
 		CheckDestroy: testAccCheckGatewayRouteDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1288,7 +1288,7 @@ func testAccAppMeshGatewayRoute_tags_DefaultTags_updateToProviderOnly(t *testing
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.AppMesh) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.AppMeshServiceID),
 		CheckDestroy: testAccCheckGatewayRouteDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1379,7 +1379,7 @@ func testAccAppMeshGatewayRoute_tags_DefaultTags_updateToResourceOnly(t *testing
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.AppMesh) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.AppMeshServiceID),
 		CheckDestroy: testAccCheckGatewayRouteDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1469,7 +1469,7 @@ func testAccAppMeshGatewayRoute_tags_DefaultTags_emptyResourceTag(t *testing.T)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.AppMesh) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.AppMeshServiceID),
 		CheckDestroy: testAccCheckGatewayRouteDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1535,7 +1535,7 @@ func testAccAppMeshGatewayRoute_tags_DefaultTags_emptyProviderOnlyTag(t *testing
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.AppMesh) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.AppMeshServiceID),
 		CheckDestroy: testAccCheckGatewayRouteDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1593,7 +1593,7 @@ func testAccAppMeshGatewayRoute_tags_DefaultTags_nullOverlappingResourceTag(t *t
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.AppMesh) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.AppMeshServiceID),
 		CheckDestroy: testAccCheckGatewayRouteDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1656,7 +1656,7 @@ func testAccAppMeshGatewayRoute_tags_DefaultTags_nullNonOverlappingResourceTag(t
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.AppMesh) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.AppMeshServiceID),
 		CheckDestroy: testAccCheckGatewayRouteDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1719,7 +1719,7 @@ func testAccAppMeshGatewayRoute_tags_ComputedTag_OnCreate(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.AppMesh) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.AppMeshServiceID),
 		CheckDestroy: testAccCheckGatewayRouteDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1775,7 +1775,7 @@ func testAccAppMeshGatewayRoute_tags_ComputedTag_OnUpdate_Add(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.AppMesh) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.AppMeshServiceID),
 		CheckDestroy: testAccCheckGatewayRouteDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1873,7 +1873,7 @@ func testAccAppMeshGatewayRoute_tags_ComputedTag_OnUpdate_Replace(t *testing.T)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.AppMesh) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.AppMeshServiceID),
 		CheckDestroy: testAccCheckGatewayRouteDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1961,7 +1961,7 @@ func testAccAppMeshGatewayRoute_tags_IgnoreTags_Overlap_DefaultTag(t *testing.T)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.AppMesh) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.AppMeshServiceID),
 		CheckDestroy: testAccCheckGatewayRouteDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -2123,7 +2123,7 @@ func testAccAppMeshGatewayRoute_tags_IgnoreTags_Overlap_ResourceTag(t *testing.T
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.AppMesh) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.AppMeshServiceID),
 		CheckDestroy: testAccCheckGatewayRouteDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -52,7 +52,7 @@ func testAccBackupFramework_tags(t *testing.T) {
 	rName := randomFrameworkName()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Backup) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.BackupServiceID),
 		CheckDestroy:             testAccCheckFrameworkDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -234,7 +234,7 @@ func testAccBackupFramework_tags_null(t *testing.T) {
 	rName := randomFrameworkName()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Backup) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.BackupServiceID),
 		CheckDestroy:             testAccCheckFrameworkDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -295,7 +295,7 @@ func testAccBackupFramework_tags_EmptyMap(t *testing.T) {
 	rName := randomFrameworkName()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Backup) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.BackupServiceID),
 		CheckDestroy:             testAccCheckFrameworkDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -352,7 +352,7 @@ func testAccBackupFramework_tags_AddOnUpdate(t *testing.T) {
 	rName := randomFrameworkName()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Backup) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.BackupServiceID),
 		CheckDestroy:             testAccCheckFrameworkDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -433,7 +433,7 @@ func testAccBackupFramework_tags_EmptyTag_OnCreate(t *testing.T) {
 	rName := randomFrameworkName()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Backup) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.BackupServiceID),
 		CheckDestroy:             testAccCheckFrameworkDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -522,7 +522,7 @@ func testAccBackupFramework_tags_EmptyTag_OnUpdate_Add(t *testing.T) {
 	rName := randomFrameworkName()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Backup) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.BackupServiceID),
 		CheckDestroy:             testAccCheckFrameworkDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -659,7 +659,7 @@ func testAccBackupFramework_tags_EmptyTag_OnUpdate_Replace(t *testing.T) {
 	rName := randomFrameworkName()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Backup) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.BackupServiceID),
 		CheckDestroy:             testAccCheckFrameworkDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -748,7 +748,7 @@ func testAccBackupFramework_tags_DefaultTags_providerOnly(t *testing.T) {
 	rName := randomFrameworkName()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Backup) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.BackupServiceID),
 		CheckDestroy: testAccCheckFrameworkDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -929,7 +929,7 @@ func testAccBackupFramework_tags_DefaultTags_nonOverlapping(t *testing.T) {
 	rName := randomFrameworkName()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Backup) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.BackupServiceID),
 		CheckDestroy: testAccCheckFrameworkDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1089,7 +1089,7 @@ func testAccBackupFramework_tags_DefaultTags_overlapping(t *testing.T) {
 	rName := randomFrameworkName()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Backup) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.BackupServiceID),
 		CheckDestroy: testAccCheckFrameworkDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1265,7 +1265,7 @@ func testAccBackupFramework_tags_DefaultTags_updateToProviderOnly(t *testing.T)
 	rName := randomFrameworkName()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Backup) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.BackupServiceID),
 		CheckDestroy: testAccCheckFrameworkDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1355,7 +1355,7 @@ func testAccBackupFramework_tags_DefaultTags_updateToResourceOnly(t *testing.T)
 	rName := randomFrameworkName()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Backup) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.BackupServiceID),
 		CheckDestroy: testAccCheckFrameworkDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1444,7 +1444,7 @@ func testAccBackupFramework_tags_DefaultTags_emptyResourceTag(t *testing.T) {
 	rName := randomFrameworkName()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Backup) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.BackupServiceID),
 		CheckDestroy: testAccCheckFrameworkDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1509,7 +1509,7 @@ func testAccBackupFramework_tags_DefaultTags_emptyProviderOnlyTag(t *testing.T)
 	rName := randomFrameworkName()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Backup) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.BackupServiceID),
 		CheckDestroy: testAccCheckFrameworkDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1566,7 +1566,7 @@ func testAccBackupFramework_tags_DefaultTags_nullOverlappingResourceTag(t *testi
 	rName := randomFrameworkName()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Backup) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.BackupServiceID),
 		CheckDestroy: testAccCheckFrameworkDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1628,7 +1628,7 @@ func testAccBackupFramework_tags_DefaultTags_nullNonOverlappingResourceTag(t *te
 	rName := randomFrameworkName()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Backup) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.BackupServiceID),
 		CheckDestroy: testAccCheckFrameworkDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1690,7 +1690,7 @@ func testAccBackupFramework_tags_ComputedTag_OnCreate(t *testing.T) {
 	rName := randomFrameworkName()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Backup) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.BackupServiceID),
 		CheckDestroy: testAccCheckFrameworkDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1745,7 +1745,7 @@ func testAccBackupFramework_tags_ComputedTag_OnUpdate_Add(t *testing.T) {
 	rName := randomFrameworkName()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Backup) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.BackupServiceID),
 		CheckDestroy: testAccCheckFrameworkDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1842,7 +1842,7 @@ func testAccBackupFramework_tags_ComputedTag_OnUpdate_Replace(t *testing.T) {
 	rName := randomFrameworkName()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Backup) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.BackupServiceID),
 		CheckDestroy: testAccCheckFrameworkDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1929,7 +1929,7 @@ func testAccBackupFramework_tags_IgnoreTags_Overlap_DefaultTag(t *testing.T) {
 	rName := randomFrameworkName()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Backup) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.BackupServiceID),
 		CheckDestroy: testAccCheckFrameworkDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -2091,7 +2091,7 @@ func testAccBackupFramework_tags_IgnoreTags_Overlap_ResourceTag(t *testing.T) {
 	rName := randomFrameworkName()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Backup) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.BackupServiceID),
 		CheckDestroy: testAccCheckFrameworkDestroy(ctx),
 		Steps: []resource.TestStep{
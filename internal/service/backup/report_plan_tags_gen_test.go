@@ -23,7 +23,7 @@ func TestAccBackupReportPlan_tags(t *testing.T) {
 	rName := randomReportPlanName()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Backup) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.BackupServiceID),
 		CheckDestroy:             testAccCheckReportPlanDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -205,7 +205,7 @@ func TestAccBackupReportPlan_tags_null(t *testing.T) {
 	rName := randomReportPlanName()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Backup) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.BackupServiceID),
 		CheckDestroy:             testAccCheckReportPlanDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -266,7 +266,7 @@ func TestAccBackupReportPlan_tags_EmptyMap(t *testing.T) {
 	rName := randomReportPlanName()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Backup) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.BackupServiceID),
 		CheckDestroy:             testAccCheckReportPlanDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -323,7 +323,7 @@ func TestAccBackupReportPlan_tags_AddOnUpdate(t *testing.T) {
 	rName := randomReportPlanName()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Backup) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.BackupServiceID),
 		CheckDestroy:             testAccCheckReportPlanDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -404,7 +404,7 @@ func TestAccBackupReportPlan_tags_EmptyTag_OnCreate(t *testing.T) {
 	rName := randomReportPlanName()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Backup) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.BackupServiceID),
 		CheckDestroy:             testAccCheckReportPlanDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -493,7 +493,7 @@ func TestAccBackupReportPlan_tags_EmptyTag_OnUpdate_Add(t *testing.T) {
 	rName := randomReportPlanName()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Backup) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.BackupServiceID),
 		CheckDestroy:             testAccCheckReportPlanDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -630,7 +630,7 @@ func TestAccBackupReportPlan_tags_EmptyTag_OnUpdate_Replace(t *testing.T) {
 	rName := randomReportPlanName()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Backup) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.BackupServiceID),
 		CheckDestroy:             testAccCheckReportPlanDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -719,7 +719,7 @@ func TestAccBackupReportPlan_tags_DefaultTags_providerOnly(t *testing.T) {
 	rName := randomReportPlanName()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Backup) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.BackupServiceID),
 		CheckDestroy: testAccCheckReportPlanDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -900,7 +900,7 @@ func TestAccBackupReportPlan_tags_DefaultTags_nonOverlapping(t *testing.T) {
 	rName := randomReportPlanName()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Backup) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.BackupServiceID),
 		CheckDestroy: testAccCheckReportPlanDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1060,7 +1060,7 @@ func TestAccBackupReportPlan_tags_DefaultTags_overlapping(t *testing.T) {
 	rName := randomReportPlanName()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Backup) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.BackupServiceID),
 		CheckDestroy: testAccCheckReportPlanDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1236,7 +1236,7 @@ func TestAccBackupReportPlan_tags_DefaultTags_updateToProviderOnly(t *testing.T)
 	rName := randomReportPlanName()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Backup) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.BackupServiceID),
 		CheckDestroy: testAccCheckReportPlanDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1326,7 +1326,7 @@ func TestAccBackupReportPlan_tags_DefaultTags_updateToResourceOnly(t *testing.T)
 	rName := randomReportPlanName()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Backup) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.BackupServiceID),
 		CheckDestroy: testAccCheckReportPlanDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1415,7 +1415,7 @@ func TestAccBackupReportPlan_tags_DefaultTags_emptyResourceTag(t *testing.T) {
 	rName := randomReportPlanName()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Backup) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.BackupServiceID),
 		CheckDestroy: testAccCheckReportPlanDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1480,7 +1480,7 @@ func TestAccBackupReportPlan_tags_DefaultTags_emptyProviderOnlyTag(t *testing.T)
 	rName := randomReportPlanName()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Backup) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.BackupServiceID),
 		CheckDestroy: testAccCheckReportPlanDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1537,7 +1537,7 @@ func TestAccBackupReportPlan_tags_DefaultTags_nullOverlappingResourceTag(t *test
 	rName := randomReportPlanName()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Backup) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.BackupServiceID),
 		CheckDestroy: testAccCheckReportPlanDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1599,7 +1599,7 @@ func TestAccBackupReportPlan_tags_DefaultTags_nullNonOverlappingResourceTag(t *t
 	rName := randomReportPlanName()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Backup) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.BackupServiceID),
 		CheckDestroy: testAccCheckReportPlanDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1661,7 +1661,7 @@ func TestAccBackupReportPlan_tags_ComputedTag_OnCreate(t *testing.T) {
 	rName := randomReportPlanName()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Backup) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.BackupServiceID),
 		CheckDestroy: testAccCheckReportPlanDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1716,7 +1716,7 @@ func TestAccBackupReportPlan_tags_ComputedTag_OnUpdate_Add(t *testing.T) {
 	rName := randomReportPlanName()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Backup) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.BackupServiceID),
 		CheckDestroy: testAccCheckReportPlanDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1813,7 +1813,7 @@ func TestAccBackupReportPlan_tags_ComputedTag_OnUpdate_Replace(t *testing.T) {
 	rName := randomReportPlanName()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Backup) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.BackupServiceID),
 		CheckDestroy: testAccCheckReportPlanDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1900,7 +1900,7 @@ func TestAccBackupReportPlan_tags_IgnoreTags_Overlap_DefaultTag(t *testing.T) {
 	rName := randomReportPlanName()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Backup) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.BackupServiceID),
 		CheckDestroy: testAccCheckReportPlanDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -2062,7 +2062,7 @@ func TestAccBackupReportPlan_tags_IgnoreTags_Overlap_ResourceTag(t *testing.T) {
 	rName := randomReportPlanName()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.Backup) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.BackupServiceID),
 		CheckDestroy: testAccCheckReportPlanDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -33,6 +33,7 @@ import (
 )
 
 // @FrameworkResource("aws_bcmdataexports_export",name="Export")
+// @Region(global=true)
 // @Tags(identifierAttribute="id")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/bcmdataexports;bcmdataexports.GetExportOutput")
 // @Testing(skipEmptyTags=true, skipNullTags=true)
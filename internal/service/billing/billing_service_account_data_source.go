@@ -16,6 +16,7 @@ import (
 )
 
 // @FrameworkDataSource("aws_billing_service_account", name="Service Account")
+// @Region(global=true)
 func newServiceAccountDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
 	d := &billingServiceAccountDataSource{}
 
@@ -34,6 +34,7 @@ import (
 )
 
 // @SDKResource("aws_budgets_budget", name="Budget")
+// @Region(global=true)
 // @Tags(identifierAttribute="arn")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/budgets/types;awstypes;awstypes.Budget")
 func ResourceBudget() *schema.Resource {
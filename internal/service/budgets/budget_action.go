@@ -31,6 +31,7 @@ import (
 )
 
 // @SDKResource("aws_budgets_budget_action", name="Budget Action")
+// @Region(global=true)
 // @Tags(identifierAttribute="arn")
 // @Testing(tagsTest=false)
 func ResourceBudgetAction() *schema.Resource {
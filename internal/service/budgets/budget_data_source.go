@@ -21,6 +21,7 @@ import (
 )
 
 // @SDKDataSource("aws_budgets_budget", name="Budget")
+// @Region(global=true)
 // @Tags(identifierAttribute="arn")
 func DataSourceBudget() *schema.Resource {
 	return &schema.Resource{
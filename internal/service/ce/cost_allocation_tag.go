@@ -21,6 +21,7 @@ import (
 )
 
 // @SDKResource("aws_ce_cost_allocation_tag", name="Cost Allocation Tag")
+// @Region(global=true)
 func resourceCostAllocationTag() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceCostAllocationTagUpdate,
@@ -18,6 +18,7 @@ import (
 )
 
 // @SDKDataSource("aws_ce_cost_category", name="Cost Category")
+// @Region(global=true)
 func dataSourceCostCategory() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceCostCategoryRead,
@@ -23,6 +23,7 @@ import (
 )
 
 // @SDKResource("aws_cloudfront_cache_policy", name="Cache Policy")
+// @Region(global=true)
 func resourceCachePolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceCachePolicyCreate,
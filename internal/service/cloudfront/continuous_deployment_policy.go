@@ -28,6 +28,7 @@ import (
 )
 
 // @FrameworkResource("aws_cloudfront_continuous_deployment_policy", name="Continuous Deployment Policy")
+// @Region(global=true)
 func newContinuousDeploymentPolicyResource(context.Context) (resource.ResourceWithConfigure, error) {
 	return &continuousDeploymentPolicyResource{}, nil
 }
@@ -23,6 +23,7 @@ import (
 )
 
 // @SDKResource("aws_cloudfront_field_level_encryption_profile", name="Field-level Encryption Profile")
+// @Region(global=true)
 func resourceFieldLevelEncryptionProfile() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceFieldLevelEncryptionProfileCreate,
@@ -24,6 +24,7 @@ import (
 )
 
 // @SDKResource("aws_cloudfront_function", name="Function")
+// @Region(global=true)
 func resourceFunction() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceFunctionCreate,
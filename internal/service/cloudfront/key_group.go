@@ -22,6 +22,7 @@ import (
 )
 
 // @SDKResource("aws_cloudfront_key_group", name="Key Group")
+// @Region(global=true)
 func resourceKeyGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceKeyGroupCreate,
@@ -21,6 +21,7 @@ import (
 )
 
 // @SDKResource("aws_cloudfront_monitoring_subscription", name="Monitoring Subscription")
+// @Region(global=true)
 func resourceMonitoringSubscription() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceMonitoringSubscriptionCreate,
@@ -23,6 +23,7 @@ import (
 )
 
 // @SDKResource("aws_cloudfront_origin_access_control", name="Origin Access Control")
+// @Region(global=true)
 func resourceOriginAccessControl() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceOriginAccessControlCreate,
@@ -16,6 +16,7 @@ import (
 )
 
 // @FrameworkDataSource("aws_cloudfront_origin_access_control", name="Origin Access Control")
+// @Region(global=true)
 func newDataSourceOriginAccessControl(_ context.Context) (datasource.DataSourceWithConfigure, error) {
 	d := &dataSourceOriginAccessControl{}
 
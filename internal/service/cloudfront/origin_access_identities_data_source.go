@@ -18,6 +18,7 @@ import (
 )
 
 // @SDKDataSource("aws_cloudfront_origin_access_identities", name="Origin Access Identities")
+// @Region(global=true)
 func dataSourceOriginAccessIdentities() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceOriginAccessIdentitiesRead,
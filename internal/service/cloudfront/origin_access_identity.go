@@ -22,6 +22,7 @@ import (
 )
 
 // @SDKResource("aws_cloudfront_origin_access_identity", name="Origin Access Identity")
+// @Region(global=true)
 func resourceOriginAccessIdentity() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceOriginAccessIdentityCreate,
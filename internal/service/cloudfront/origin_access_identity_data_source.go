@@ -15,6 +15,7 @@ import (
 )
 
 // @SDKDataSource("aws_cloudfront_origin_access_identity", name="Origin Access Identity")
+// @Region(global=true)
 func dataSourceOriginAccessIdentity() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceOriginAccessIdentityRead,
@@ -25,6 +25,7 @@ import (
 )
 
 // @SDKResource("aws_cloudfront_public_key", name="Public Key")
+// @Region(global=true)
 func resourcePublicKey() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourcePublicKeyCreate,
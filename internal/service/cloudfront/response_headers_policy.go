@@ -24,6 +24,7 @@ import (
 )
 
 // @SDKResource("aws_cloudfront_response_headers_policy", name="Response Headers Policy")
+// @Region(global=true)
 func resourceResponseHeadersPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceResponseHeadersPolicyCreate,
@@ -30,6 +30,7 @@ import (
 )
 
 // @FrameworkResource("aws_cloudfront_vpc_origin", name="VPC Origin")
+// @Region(global=true)
 // @Tags(identifierAttribute="arn")
 func newVPCOriginResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &vpcOriginResource{}
@@ -26,6 +26,7 @@ import (
 )
 
 // @FrameworkResource("aws_costoptimizationhub_enrollment_status", name="Enrollment Status")
+// @Region(global=true)
 func newResourceEnrollmentStatus(_ context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourceEnrollmentStatus{}
 
@@ -27,6 +27,7 @@ import (
 )
 
 // @FrameworkResource("aws_costoptimizationhub_preferences", name="Preferences")
+// @Region(global=true)
 func newResourcePreferences(_ context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourcePreferences{}
 
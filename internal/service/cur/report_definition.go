@@ -29,6 +29,7 @@ import (
 )
 
 // @SDKResource("aws_cur_report_definition", name="Report Definition")
+// @Region(global=true)
 // @Tags(identifierAttribute="report_name")
 func resourceReportDefinition() *schema.Resource {
 	return &schema.Resource{
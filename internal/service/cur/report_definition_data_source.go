@@ -16,6 +16,7 @@ import (
 )
 
 // @SDKDataSource("aws_cur_report_definition", name="Report Definition")
+// @Region(global=true)
 // @Tags(identifierAttribute="report_name")
 func dataSourceReportDefinition() *schema.Resource {
 	return &schema.Resource{
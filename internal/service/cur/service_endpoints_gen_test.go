@@ -69,6 +69,7 @@ const (
 	baseEnvvarEndpoint        = "https://base-envvar.endpoint.test/"
 	serviceConfigFileEndpoint = "https://service-configfile.endpoint.test/"
 	baseConfigFileEndpoint    = "https://base-configfile.endpoint.test/"
+	localEndpoint             = "https://local.endpoint.test/"
 
 	aliasName0ConfigEndpoint = "https://aliasname0-config.endpoint.test/"
 )
@@ -288,6 +289,47 @@ func TestEndpointConfiguration(t *testing.T) { //nolint:paralleltest // uses t.S
 			},
 			expected: expectPackageNameConfigEndpoint(),
 		},
+
+		// Local AWS API emulator endpoint
+
+		"local endpoint envvar": {
+			with: []setupFunc{
+				withLocalEndpointEnvVar,
+			},
+			expected: expectLocalEndpoint(),
+		},
+
+		"package name endpoint config overrides local endpoint envvar": {
+			with: []setupFunc{
+				withPackageNameEndpointInConfig,
+				withLocalEndpointEnvVar,
+			},
+			expected: expectPackageNameConfigEndpoint(),
+		},
+
+		"aws service envvar overrides local endpoint envvar": {
+			with: []setupFunc{
+				withLocalEndpointEnvVar,
+				withAwsEnvVar,
+			},
+			expected: expectAwsEnvVarEndpoint(),
+		},
+
+		"local endpoint envvar overrides base config file": {
+			with: []setupFunc{
+				withLocalEndpointEnvVar,
+				withBaseEndpointInConfigFile,
+			},
+			expected: expectLocalEndpoint(),
+		},
+
+		"local endpoint envvar overrides service config file": {
+			with: []setupFunc{
+				withLocalEndpointEnvVar,
+				withServiceEndpointInConfigFile,
+			},
+			expected: expectLocalEndpoint(),
+		},
 	}
 
 	for name, testcase := range testcases { //nolint:paralleltest // uses t.Setenv
@@ -411,6 +453,10 @@ func withUseFIPSInConfig(setup *caseSetup) {
 	setup.config["use_fips_endpoint"] = true
 }
 
+func withLocalEndpointEnvVar(setup *caseSetup) {
+	setup.environmentVariables[conns.LocalEndpointEnvVar] = localEndpoint
+}
+
 func expectDefaultEndpoint(t *testing.T, region string) caseExpectations {
 	t.Helper()
 
@@ -489,6 +535,13 @@ func expectBaseConfigFileEndpoint() caseExpectations {
 	}
 }
 
+func expectLocalEndpoint() caseExpectations {
+	return caseExpectations{
+		endpoint: localEndpoint,
+		region:   expectedCallRegion,
+	}
+}
+
 func testEndpointCase(t *testing.T, region string, testcase endpointTestCase, callF callFunc) {
 	t.Helper()
 
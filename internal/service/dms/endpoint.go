@@ -33,7 +33,7 @@ import (
 
 // @SDKResource("aws_dms_endpoint", name="Endpoint")
 // @Tags(identifierAttribute="endpoint_arn")
-// @Testing(importIgnore="password")
+// @Testing(importIgnore="password", lifecycleTest=true)
 func resourceEndpoint() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceEndpointCreate,
@@ -22,7 +22,7 @@ func TestAccDMSEndpoint_tags(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.DMS) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.DMSServiceID),
 		CheckDestroy:             testAccCheckEndpointDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -215,7 +215,7 @@ func TestAccDMSEndpoint_tags_null(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.DMS) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.DMSServiceID),
 		CheckDestroy:             testAccCheckEndpointDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -278,7 +278,7 @@ func TestAccDMSEndpoint_tags_EmptyMap(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.DMS) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.DMSServiceID),
 		CheckDestroy:             testAccCheckEndpointDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -337,7 +337,7 @@ func TestAccDMSEndpoint_tags_AddOnUpdate(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.DMS) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.DMSServiceID),
 		CheckDestroy:             testAccCheckEndpointDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -420,7 +420,7 @@ func TestAccDMSEndpoint_tags_EmptyTag_OnCreate(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.DMS) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.DMSServiceID),
 		CheckDestroy:             testAccCheckEndpointDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -514,7 +514,7 @@ func TestAccDMSEndpoint_tags_EmptyTag_OnUpdate_Add(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.DMS) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.DMSServiceID),
 		CheckDestroy:             testAccCheckEndpointDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -656,7 +656,7 @@ func TestAccDMSEndpoint_tags_EmptyTag_OnUpdate_Replace(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.DMS) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.DMSServiceID),
 		CheckDestroy:             testAccCheckEndpointDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -747,7 +747,7 @@ func TestAccDMSEndpoint_tags_DefaultTags_providerOnly(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.DMS) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.DMSServiceID),
 		CheckDestroy: testAccCheckEndpointDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -939,7 +939,7 @@ func TestAccDMSEndpoint_tags_DefaultTags_nonOverlapping(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.DMS) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.DMSServiceID),
 		CheckDestroy: testAccCheckEndpointDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1107,7 +1107,7 @@ func TestAccDMSEndpoint_tags_DefaultTags_overlapping(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.DMS) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.DMSServiceID),
 		CheckDestroy: testAccCheckEndpointDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1291,7 +1291,7 @@ func TestAccDMSEndpoint_tags_DefaultTags_updateToProviderOnly(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.DMS) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.DMSServiceID),
 		CheckDestroy: testAccCheckEndpointDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1383,7 +1383,7 @@ func TestAccDMSEndpoint_tags_DefaultTags_updateToResourceOnly(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.DMS) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.DMSServiceID),
 		CheckDestroy: testAccCheckEndpointDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1474,7 +1474,7 @@ func TestAccDMSEndpoint_tags_DefaultTags_emptyResourceTag(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.DMS) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.DMSServiceID),
 		CheckDestroy: testAccCheckEndpointDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1541,7 +1541,7 @@ func TestAccDMSEndpoint_tags_DefaultTags_emptyProviderOnlyTag(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.DMS) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.DMSServiceID),
 		CheckDestroy: testAccCheckEndpointDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1600,7 +1600,7 @@ func TestAccDMSEndpoint_tags_DefaultTags_nullOverlappingResourceTag(t *testing.T
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.DMS) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.DMSServiceID),
 		CheckDestroy: testAccCheckEndpointDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1664,7 +1664,7 @@ func TestAccDMSEndpoint_tags_DefaultTags_nullNonOverlappingResourceTag(t *testin
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.DMS) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.DMSServiceID),
 		CheckDestroy: testAccCheckEndpointDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1728,7 +1728,7 @@ func TestAccDMSEndpoint_tags_ComputedTag_OnCreate(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.DMS) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.DMSServiceID),
 		CheckDestroy: testAccCheckEndpointDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1785,7 +1785,7 @@ func TestAccDMSEndpoint_tags_ComputedTag_OnUpdate_Add(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.DMS) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.DMSServiceID),
 		CheckDestroy: testAccCheckEndpointDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1884,7 +1884,7 @@ func TestAccDMSEndpoint_tags_ComputedTag_OnUpdate_Replace(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.DMS) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.DMSServiceID),
 		CheckDestroy: testAccCheckEndpointDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1973,7 +1973,7 @@ func TestAccDMSEndpoint_tags_IgnoreTags_Overlap_DefaultTag(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.DMS) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.DMSServiceID),
 		CheckDestroy: testAccCheckEndpointDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -2134,7 +2134,7 @@ func TestAccDMSEndpoint_tags_IgnoreTags_Overlap_ResourceTag(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.DMS) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.DMSServiceID),
 		CheckDestroy: testAccCheckEndpointDestroy(ctx),
 		Steps: []resource.TestStep{
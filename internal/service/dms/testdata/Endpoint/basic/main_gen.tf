@@ -0,0 +1,20 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_dms_endpoint" "test" {
+  database_name = "tf-test-dms-db"
+  endpoint_id   = var.rName
+  endpoint_type = "source"
+  engine_name   = "aurora"
+  password      = "tftest"
+  port          = 3306
+  server_name   = "tftest"
+  ssl_mode      = "none"
+  username      = "tftest"
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -68,10 +69,15 @@ func (r *resourceCluster) Schema(ctx context.Context, _ resource.SchemaRequest,
 				},
 			},
 			"admin_user_password": schema.StringAttribute{
-				Required:  true,
+				Optional:  true,
 				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("admin_user_password_wo")),
+				},
 			},
-			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"admin_user_password_wo":         framework.WriteOnlyStringAttribute("admin_user_password"),
+			"admin_user_password_wo_version": framework.WriteOnlyVersionAttribute("admin_user_password"),
+			names.AttrARN:                    framework.ARNAttributeComputedOnly(),
 			"auth_type": schema.StringAttribute{
 				CustomType: fwtypes.StringEnumType[awstypes.Auth](),
 				Required:   true,
@@ -169,7 +175,7 @@ func (r *resourceCluster) Schema(ctx context.Context, _ resource.SchemaRequest,
 
 func (r *resourceCluster) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
 	conn := r.Meta().DocDBElasticClient(ctx)
-	var plan resourceClusterData
+	var plan, config resourceClusterData
 
 	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
 
@@ -177,6 +183,13 @@ func (r *resourceCluster) Create(ctx context.Context, request resource.CreateReq
 		return
 	}
 
+	// Write-only values are only available in configuration.
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	optionPrefix := fwflex.WithFieldNamePrefix("Cluster")
 	input := docdbelastic.CreateClusterInput{}
 	response.Diagnostics.Append(fwflex.Expand(ctx, plan, &input, optionPrefix)...)
@@ -186,6 +199,9 @@ func (r *resourceCluster) Create(ctx context.Context, request resource.CreateReq
 	}
 	input.ClientToken = aws.String(id.UniqueId())
 	input.Tags = getTagsIn(ctx)
+	if !config.AdminUserPasswordWO.IsNull() {
+		input.AdminUserPassword = config.AdminUserPasswordWO.ValueStringPointer()
+	}
 
 	createOut, err := conn.CreateCluster(ctx, &input)
 
@@ -264,7 +280,7 @@ func (r *resourceCluster) Read(ctx context.Context, request resource.ReadRequest
 
 func (r *resourceCluster) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
 	conn := r.Meta().DocDBElasticClient(ctx)
-	var state, plan resourceClusterData
+	var state, plan, config resourceClusterData
 
 	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
 
@@ -278,6 +294,12 @@ func (r *resourceCluster) Update(ctx context.Context, request resource.UpdateReq
 		return
 	}
 
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	diff, d := fwflex.Diff(ctx, plan, state)
 	response.Diagnostics.Append(d...)
 	if response.Diagnostics.HasError() {
@@ -292,6 +314,9 @@ func (r *resourceCluster) Update(ctx context.Context, request resource.UpdateReq
 		}
 		input.ClientToken = aws.String(id.UniqueId())
 		input.ClusterArn = plan.ID.ValueStringPointer()
+		if framework.HasWriteOnlyChange(plan.AdminUserPasswordWOVersion, state.AdminUserPasswordWOVersion) {
+			input.AdminUserPassword = config.AdminUserPasswordWO.ValueStringPointer()
+		}
 
 		_, err := conn.UpdateCluster(ctx, &input)
 
@@ -373,6 +398,8 @@ func (r *resourceCluster) Delete(ctx context.Context, request resource.DeleteReq
 type resourceClusterData struct {
 	AdminUserName              types.String                      `tfsdk:"admin_user_name"`
 	AdminUserPassword          types.String                      `tfsdk:"admin_user_password"`
+	AdminUserPasswordWO        types.String                      `tfsdk:"admin_user_password_wo"`
+	AdminUserPasswordWOVersion types.Int64                       `tfsdk:"admin_user_password_wo_version"`
 	ARN                        types.String                      `tfsdk:"arn"`
 	AuthType                   fwtypes.StringEnum[awstypes.Auth] `tfsdk:"auth_type"`
 	BackupRetentionPeriod      types.Int32                       `tfsdk:"backup_retention_period"`
@@ -53,7 +53,7 @@ func testAccDRSReplicationConfigurationTemplate_tags(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.DRS) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.DRSServiceID),
 		CheckDestroy:             testAccCheckReplicationConfigurationTemplateDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -235,7 +235,7 @@ func testAccDRSReplicationConfigurationTemplate_tags_null(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.DRS) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.DRSServiceID),
 		CheckDestroy:             testAccCheckReplicationConfigurationTemplateDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -297,7 +297,7 @@ func testAccDRSReplicationConfigurationTemplate_tags_EmptyMap(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.DRS) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.DRSServiceID),
 		CheckDestroy:             testAccCheckReplicationConfigurationTemplateDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -347,7 +347,7 @@ func testAccDRSReplicationConfigurationTemplate_tags_AddOnUpdate(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.DRS) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.DRSServiceID),
 		CheckDestroy:             testAccCheckReplicationConfigurationTemplateDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -427,7 +427,7 @@ func testAccDRSReplicationConfigurationTemplate_tags_EmptyTag_OnCreate(t *testin
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.DRS) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.DRSServiceID),
 		CheckDestroy:             testAccCheckReplicationConfigurationTemplateDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -517,7 +517,7 @@ func testAccDRSReplicationConfigurationTemplate_tags_EmptyTag_OnUpdate_Add(t *te
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.DRS) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.DRSServiceID),
 		CheckDestroy:             testAccCheckReplicationConfigurationTemplateDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -656,7 +656,7 @@ func testAccDRSReplicationConfigurationTemplate_tags_EmptyTag_OnUpdate_Replace(t
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.DRS) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.DRSServiceID),
 		CheckDestroy:             testAccCheckReplicationConfigurationTemplateDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -746,7 +746,7 @@ func testAccDRSReplicationConfigurationTemplate_tags_DefaultTags_providerOnly(t
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.DRS) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.DRSServiceID),
 		CheckDestroy: testAccCheckReplicationConfigurationTemplateDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -927,7 +927,7 @@ func testAccDRSReplicationConfigurationTemplate_tags_DefaultTags_nonOverlapping(
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.DRS) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.DRSServiceID),
 		CheckDestroy: testAccCheckReplicationConfigurationTemplateDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1087,7 +1087,7 @@ func testAccDRSReplicationConfigurationTemplate_tags_DefaultTags_overlapping(t *
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.DRS) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.DRSServiceID),
 		CheckDestroy: testAccCheckReplicationConfigurationTemplateDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1263,7 +1263,7 @@ func testAccDRSReplicationConfigurationTemplate_tags_DefaultTags_updateToProvide
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.DRS) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.DRSServiceID),
 		CheckDestroy: testAccCheckReplicationConfigurationTemplateDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1353,7 +1353,7 @@ func testAccDRSReplicationConfigurationTemplate_tags_DefaultTags_updateToResourc
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.DRS) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.DRSServiceID),
 		CheckDestroy: testAccCheckReplicationConfigurationTemplateDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1442,7 +1442,7 @@ func testAccDRSReplicationConfigurationTemplate_tags_DefaultTags_emptyResourceTa
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.DRS) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.DRSServiceID),
 		CheckDestroy: testAccCheckReplicationConfigurationTemplateDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1508,7 +1508,7 @@ func testAccDRSReplicationConfigurationTemplate_tags_DefaultTags_emptyProviderOn
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.DRS) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.DRSServiceID),
 		CheckDestroy: testAccCheckReplicationConfigurationTemplateDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1566,7 +1566,7 @@ func testAccDRSReplicationConfigurationTemplate_tags_DefaultTags_nullOverlapping
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.DRS) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.DRSServiceID),
 		CheckDestroy: testAccCheckReplicationConfigurationTemplateDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1635,7 +1635,7 @@ func testAccDRSReplicationConfigurationTemplate_tags_DefaultTags_nullNonOverlapp
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.DRS) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.DRSServiceID),
 		CheckDestroy: testAccCheckReplicationConfigurationTemplateDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1706,7 +1706,7 @@ func testAccDRSReplicationConfigurationTemplate_tags_ComputedTag_OnCreate(t *tes
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.DRS) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.DRSServiceID),
 		CheckDestroy: testAccCheckReplicationConfigurationTemplateDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1761,7 +1761,7 @@ func testAccDRSReplicationConfigurationTemplate_tags_ComputedTag_OnUpdate_Add(t
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.DRS) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.DRSServiceID),
 		CheckDestroy: testAccCheckReplicationConfigurationTemplateDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1858,7 +1858,7 @@ func testAccDRSReplicationConfigurationTemplate_tags_ComputedTag_OnUpdate_Replac
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.DRS) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.DRSServiceID),
 		CheckDestroy: testAccCheckReplicationConfigurationTemplateDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1945,7 +1945,7 @@ func testAccDRSReplicationConfigurationTemplate_tags_IgnoreTags_Overlap_DefaultT
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.DRS) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.DRSServiceID),
 		CheckDestroy: testAccCheckReplicationConfigurationTemplateDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -2107,7 +2107,7 @@ func testAccDRSReplicationConfigurationTemplate_tags_IgnoreTags_Overlap_Resource
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.DRS) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.DRSServiceID),
 		CheckDestroy: testAccCheckReplicationConfigurationTemplateDestroy(ctx),
 		Steps: []resource.TestStep{
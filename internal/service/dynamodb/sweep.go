@@ -13,28 +13,27 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/metadata"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/sdk"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_dynamodb_table", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dynamodb_table", &sweep.Sweeper{
 		Name: "aws_dynamodb_table",
 		F:    sweepTables,
 	})
 
-	resource.AddTestSweepers("aws_dynamodb_backup", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dynamodb_backup", &sweep.Sweeper{
 		Name: "aws_dynamodb_backup",
 		F:    sweepBackups,
 	})
 }
 
-func sweepTables(region string) error {
-	ctx := sweep.Context(region)
+func sweepTables(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -57,16 +56,6 @@ func sweepTables(region string) error {
 		}
 
 		for _, v := range page.TableNames {
-			input := dynamodb.UpdateTableInput{
-				DeletionProtectionEnabled: aws.Bool(false),
-				TableName:                 aws.String(v),
-			}
-			_, err := conn.UpdateTable(ctx, &input)
-
-			if err != nil {
-				log.Printf("[WARN] DynamoDB Table (%s): %s", v, err)
-			}
-
 			r := resourceTable()
 			d := r.Data(nil)
 			d.SetId(v)
@@ -79,7 +68,11 @@ func sweepTables(region string) error {
 				continue
 			}
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, tableSweeper{
+				conn:      conn,
+				name:      v,
+				sweepable: sweep.NewSweepResource(r, d, client),
+			})
 		}
 	}
 
@@ -92,8 +85,35 @@ func sweepTables(region string) error {
 	return nil
 }
 
-func sweepBackups(region string) error {
-	ctx := sweep.Context(region)
+type tableSweeper struct {
+	conn      *dynamodb.Client
+	name      string
+	sweepable sweep.Sweepable
+}
+
+func (ts tableSweeper) Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error {
+	input := dynamodb.UpdateTableInput{
+		DeletionProtectionEnabled: aws.Bool(false),
+		TableName:                 aws.String(ts.name),
+	}
+	_, err := ts.conn.UpdateTable(ctx, &input)
+
+	if err != nil {
+		log.Printf("[WARN] DynamoDB Table (%s): %s", ts.name, err)
+	}
+
+	return ts.sweepable.Delete(ctx, optFns...)
+}
+
+func (ts tableSweeper) Metadata(ctx context.Context) metadata.Resource {
+	if v, ok := ts.sweepable.(sweep.Describable); ok {
+		return v.Metadata(ctx)
+	}
+
+	return metadata.Resource{ID: ts.name}
+}
+
+func sweepBackups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -178,3 +198,10 @@ func (bs backupSweeper) Delete(ctx context.Context, optFns ...tfresource.Options
 
 	return err
 }
+
+func (bs backupSweeper) Metadata(context.Context) metadata.Resource {
+	return metadata.Resource{
+		ID:       bs.arn,
+		TypeName: "aws_dynamodb_backup",
+	}
+}
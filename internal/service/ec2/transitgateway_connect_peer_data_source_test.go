@@ -10,11 +10,11 @@ import (
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
-	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-func testAccTransitGatewayConnectPeerDataSource_Filter(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayConnectPeerDataSource_Filter(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	dataSourceName := "data.aws_ec2_transit_gateway_connect_peer.test"
 	resourceName := "aws_ec2_transit_gateway_connect_peer.test"
@@ -49,7 +49,7 @@ func testAccTransitGatewayConnectPeerDataSource_Filter(t *testing.T, semaphore t
 	})
 }
 
-func testAccTransitGatewayConnectPeerDataSource_ID(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayConnectPeerDataSource_ID(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	dataSourceName := "data.aws_ec2_transit_gateway_connect_peer.test"
 	resourceName := "aws_ec2_transit_gateway_connect_peer.test"
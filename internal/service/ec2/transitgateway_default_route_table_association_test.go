@@ -16,13 +16,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
 	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-func testAccTransitGatewayDefaultRouteTableAssociation_basic(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayDefaultRouteTableAssociation_basic(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -53,7 +53,7 @@ func testAccTransitGatewayDefaultRouteTableAssociation_basic(t *testing.T, semap
 	})
 }
 
-func testAccTransitGatewayDefaultRouteTableAssociation_disappears(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayDefaultRouteTableAssociation_disappears(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -84,7 +84,7 @@ func testAccTransitGatewayDefaultRouteTableAssociation_disappears(t *testing.T,
 	})
 }
 
-func testAccTransitGatewayDefaultRouteTableAssociation_Disappears_transitGateway(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayDefaultRouteTableAssociation_Disappears_transitGateway(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -14,13 +14,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
 	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-func testAccTransitGatewayMulticastGroupMember_basic(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayMulticastGroupMember_basic(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var v awstypes.TransitGatewayMulticastGroup
 	resourceName := "aws_ec2_transit_gateway_multicast_group_member.test"
@@ -46,7 +46,7 @@ func testAccTransitGatewayMulticastGroupMember_basic(t *testing.T, semaphore tfs
 	})
 }
 
-func testAccTransitGatewayMulticastGroupMember_disappears(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayMulticastGroupMember_disappears(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var v awstypes.TransitGatewayMulticastGroup
 	resourceName := "aws_ec2_transit_gateway_multicast_group_member.test"
@@ -74,7 +74,7 @@ func testAccTransitGatewayMulticastGroupMember_disappears(t *testing.T, semaphor
 	})
 }
 
-func testAccTransitGatewayMulticastGroupMember_Disappears_domain(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayMulticastGroupMember_Disappears_domain(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var v awstypes.TransitGatewayMulticastGroup
 	resourceName := "aws_ec2_transit_gateway_multicast_group_member.test"
@@ -103,7 +103,7 @@ func testAccTransitGatewayMulticastGroupMember_Disappears_domain(t *testing.T, s
 	})
 }
 
-func testAccTransitGatewayMulticastGroupMember_twoMembers(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayMulticastGroupMember_twoMembers(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var v1, v2 awstypes.TransitGatewayMulticastGroup
 	resource1Name := "aws_ec2_transit_gateway_multicast_group_member.test1"
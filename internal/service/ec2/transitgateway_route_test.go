@@ -14,13 +14,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
 	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-func testAccTransitGatewayRoute_basic(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayRoute_basic(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var v awstypes.TransitGatewayRoute
 	resourceName := "aws_ec2_transit_gateway_route.test"
@@ -57,7 +57,7 @@ func testAccTransitGatewayRoute_basic(t *testing.T, semaphore tfsync.Semaphore)
 	})
 }
 
-func testAccTransitGatewayRoute_basic_ipv6(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayRoute_basic_ipv6(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var v awstypes.TransitGatewayRoute
 	resourceName := "aws_ec2_transit_gateway_route.test_ipv6"
@@ -94,7 +94,7 @@ func testAccTransitGatewayRoute_basic_ipv6(t *testing.T, semaphore tfsync.Semaph
 	})
 }
 
-func testAccTransitGatewayRoute_blackhole(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayRoute_blackhole(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var v awstypes.TransitGatewayRoute
 	resourceName := "aws_ec2_transit_gateway_route.test_blackhole"
@@ -130,7 +130,7 @@ func testAccTransitGatewayRoute_blackhole(t *testing.T, semaphore tfsync.Semapho
 	})
 }
 
-func testAccTransitGatewayRoute_disappears(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayRoute_disappears(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var v awstypes.TransitGatewayRoute
 	resourceName := "aws_ec2_transit_gateway_route.test"
@@ -158,7 +158,7 @@ func testAccTransitGatewayRoute_disappears(t *testing.T, semaphore tfsync.Semaph
 	})
 }
 
-func testAccTransitGatewayRoute_disappears_TransitGatewayAttachment(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayRoute_disappears_TransitGatewayAttachment(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var v awstypes.TransitGatewayRoute
 	resourceName := "aws_ec2_transit_gateway_route.test"
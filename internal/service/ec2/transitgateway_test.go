@@ -24,8 +24,8 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	tfstatecheck "github.com/hashicorp/terraform-provider-aws/internal/acctest/statecheck"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
 	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -34,7 +34,7 @@ func TestAccTransitGateway_serial(t *testing.T) {
 	t.Parallel()
 
 	semaphore := tfsync.GetSemaphore("TransitGateway", "AWS_EC2_TRANSIT_GATEWAY_LIMIT", 5)
-	testCases := map[string]map[string]func(*testing.T, tfsync.Semaphore){
+	testCases := map[string]map[string]func(*testing.T, *tfsync.Semaphore){
 		"Connect": {
 			acctest.CtBasic:      testAccTransitGatewayConnect_basic,
 			acctest.CtDisappears: testAccTransitGatewayConnect_disappears,
@@ -177,11 +177,11 @@ func TestAccTransitGateway_serial(t *testing.T) {
 	acctest.RunLimitedConcurrencyTests2Levels(t, semaphore, testCases)
 }
 
-func testAccPreCheckTransitGatewaySynchronize(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccPreCheckTransitGatewaySynchronize(t *testing.T, semaphore *tfsync.Semaphore) {
 	tfsync.TestAccPreCheckSyncronize(t, semaphore, "TransitGateway")
 }
 
-func testAccTransitGateway_basic(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGateway_basic(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var transitGateway1 awstypes.TransitGateway
 	resourceName := "aws_ec2_transit_gateway.test"
@@ -225,7 +225,7 @@ func testAccTransitGateway_basic(t *testing.T, semaphore tfsync.Semaphore) {
 	})
 }
 
-func testAccTransitGateway_disappears(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGateway_disappears(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var transitGateway1 awstypes.TransitGateway
 	resourceName := "aws_ec2_transit_gateway.test"
@@ -252,7 +252,7 @@ func testAccTransitGateway_disappears(t *testing.T, semaphore tfsync.Semaphore)
 	})
 }
 
-func testAccTransitGateway_amazonSideASN(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGateway_amazonSideASN(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var transitGateway1, transitGateway2 awstypes.TransitGateway
 	resourceName := "aws_ec2_transit_gateway.test"
@@ -292,7 +292,7 @@ func testAccTransitGateway_amazonSideASN(t *testing.T, semaphore tfsync.Semaphor
 	})
 }
 
-func testAccTransitGateway_autoAcceptSharedAttachments(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGateway_autoAcceptSharedAttachments(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var transitGateway1, transitGateway2 awstypes.TransitGateway
 	resourceName := "aws_ec2_transit_gateway.test"
@@ -332,7 +332,7 @@ func testAccTransitGateway_autoAcceptSharedAttachments(t *testing.T, semaphore t
 	})
 }
 
-func testAccTransitGateway_cidrBlocks(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGateway_cidrBlocks(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var v1, v2, v3 awstypes.TransitGateway
 	resourceName := "aws_ec2_transit_gateway.test"
@@ -385,7 +385,7 @@ func testAccTransitGateway_cidrBlocks(t *testing.T, semaphore tfsync.Semaphore)
 	})
 }
 
-func testAccTransitGateway_defaultRouteTableAssociationAndPropagationDisabled(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGateway_defaultRouteTableAssociationAndPropagationDisabled(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var transitGateway1 awstypes.TransitGateway
 	resourceName := "aws_ec2_transit_gateway.test"
@@ -418,7 +418,7 @@ func testAccTransitGateway_defaultRouteTableAssociationAndPropagationDisabled(t
 	})
 }
 
-func testAccTransitGateway_defaultRouteTableAssociation(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGateway_defaultRouteTableAssociation(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var transitGateway1, transitGateway2, transitGateway3 awstypes.TransitGateway
 	resourceName := "aws_ec2_transit_gateway.test"
@@ -466,7 +466,7 @@ func testAccTransitGateway_defaultRouteTableAssociation(t *testing.T, semaphore
 	})
 }
 
-func testAccTransitGateway_defaultRouteTablePropagation(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGateway_defaultRouteTablePropagation(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var transitGateway1, transitGateway2, transitGateway3 awstypes.TransitGateway
 	resourceName := "aws_ec2_transit_gateway.test"
@@ -514,7 +514,7 @@ func testAccTransitGateway_defaultRouteTablePropagation(t *testing.T, semaphore
 	})
 }
 
-func testAccTransitGateway_dnsSupport(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGateway_dnsSupport(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var transitGateway1, transitGateway2 awstypes.TransitGateway
 	resourceName := "aws_ec2_transit_gateway.test"
@@ -554,7 +554,7 @@ func testAccTransitGateway_dnsSupport(t *testing.T, semaphore tfsync.Semaphore)
 	})
 }
 
-func testAccTransitGateway_securityGroupReferencingSupport(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGateway_securityGroupReferencingSupport(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var transitGateway1, transitGateway2 awstypes.TransitGateway
 	resourceName := "aws_ec2_transit_gateway.test"
@@ -594,7 +594,7 @@ func testAccTransitGateway_securityGroupReferencingSupport(t *testing.T, semapho
 	})
 }
 
-func testAccTransitGateway_securityGroupReferencingSupportExistingResource(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGateway_securityGroupReferencingSupportExistingResource(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var transitGateway1 awstypes.TransitGateway
 	resourceName := "aws_ec2_transit_gateway.test"
@@ -650,7 +650,7 @@ func testAccTransitGateway_securityGroupReferencingSupportExistingResource(t *te
 	})
 }
 
-func testAccTransitGateway_vpnECMPSupport(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGateway_vpnECMPSupport(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var transitGateway1, transitGateway2 awstypes.TransitGateway
 	resourceName := "aws_ec2_transit_gateway.test"
@@ -690,7 +690,7 @@ func testAccTransitGateway_vpnECMPSupport(t *testing.T, semaphore tfsync.Semapho
 	})
 }
 
-func testAccTransitGateway_description(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGateway_description(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var transitGateway1, transitGateway2 awstypes.TransitGateway
 	resourceName := "aws_ec2_transit_gateway.test"
@@ -730,7 +730,7 @@ func testAccTransitGateway_description(t *testing.T, semaphore tfsync.Semaphore)
 	})
 }
 
-func testAccTransitGateway_tags(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGateway_tags(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var transitGateway1, transitGateway2, transitGateway3 awstypes.TransitGateway
 	resourceName := "aws_ec2_transit_gateway.test"
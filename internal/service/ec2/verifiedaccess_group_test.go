@@ -14,13 +14,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
 	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-func testAccVerifiedAccessGroup_basic(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccVerifiedAccessGroup_basic(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var v types.VerifiedAccessGroup
 	resourceName := "aws_verifiedaccess_group.test"
@@ -63,7 +63,7 @@ func testAccVerifiedAccessGroup_basic(t *testing.T, semaphore tfsync.Semaphore)
 	})
 }
 
-func testAccVerifiedAccessGroup_kms(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccVerifiedAccessGroup_kms(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var v types.VerifiedAccessGroup
 	resourceName := "aws_verifiedaccess_group.test"
@@ -99,7 +99,7 @@ func testAccVerifiedAccessGroup_kms(t *testing.T, semaphore tfsync.Semaphore) {
 	})
 }
 
-func testAccVerifiedAccessGroup_updateKMS(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccVerifiedAccessGroup_updateKMS(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var v types.VerifiedAccessGroup
 	resourceName := "aws_verifiedaccess_group.test"
@@ -159,7 +159,7 @@ func testAccVerifiedAccessGroup_updateKMS(t *testing.T, semaphore tfsync.Semapho
 	})
 }
 
-func testAccVerifiedAccessGroup_disappears(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccVerifiedAccessGroup_disappears(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var v types.VerifiedAccessGroup
 	resourceName := "aws_verifiedaccess_group.test"
@@ -187,7 +187,7 @@ func testAccVerifiedAccessGroup_disappears(t *testing.T, semaphore tfsync.Semaph
 	})
 }
 
-func testAccVerifiedAccessGroup_tags(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccVerifiedAccessGroup_tags(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var v types.VerifiedAccessGroup
 	resourceName := "aws_verifiedaccess_group.test"
@@ -238,7 +238,7 @@ func testAccVerifiedAccessGroup_tags(t *testing.T, semaphore tfsync.Semaphore) {
 	})
 }
 
-func testAccVerifiedAccessGroup_policy(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccVerifiedAccessGroup_policy(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var v types.VerifiedAccessGroup
 	resourceName := "aws_verifiedaccess_group.test"
@@ -274,7 +274,7 @@ func testAccVerifiedAccessGroup_policy(t *testing.T, semaphore tfsync.Semaphore)
 	})
 }
 
-func testAccVerifiedAccessGroup_updatePolicy(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccVerifiedAccessGroup_updatePolicy(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var v types.VerifiedAccessGroup
 	resourceName := "aws_verifiedaccess_group.test"
@@ -324,7 +324,7 @@ func testAccVerifiedAccessGroup_updatePolicy(t *testing.T, semaphore tfsync.Sema
 		},
 	})
 }
-func testAccVerifiedAccessGroup_setPolicy(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccVerifiedAccessGroup_setPolicy(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var v types.VerifiedAccessGroup
 	resourceName := "aws_verifiedaccess_group.test"
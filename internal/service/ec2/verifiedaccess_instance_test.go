@@ -16,13 +16,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
 	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-func testAccVerifiedAccessInstance_basic(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccVerifiedAccessInstance_basic(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var v types.VerifiedAccessInstance
 	resourceName := "aws_verifiedaccess_instance.test"
@@ -56,7 +56,7 @@ func testAccVerifiedAccessInstance_basic(t *testing.T, semaphore tfsync.Semaphor
 	})
 }
 
-func testAccVerifiedAccessInstance_description(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccVerifiedAccessInstance_description(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var v1, v2 types.VerifiedAccessInstance
 	resourceName := "aws_verifiedaccess_instance.test"
@@ -98,7 +98,7 @@ func testAccVerifiedAccessInstance_description(t *testing.T, semaphore tfsync.Se
 	})
 }
 
-func testAccVerifiedAccessInstance_fipsEnabled(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccVerifiedAccessInstance_fipsEnabled(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var v1, v2 types.VerifiedAccessInstance
 	resourceName := "aws_verifiedaccess_instance.test"
@@ -140,7 +140,7 @@ func testAccVerifiedAccessInstance_fipsEnabled(t *testing.T, semaphore tfsync.Se
 	})
 }
 
-func testAccVerifiedAccessInstance_disappears(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccVerifiedAccessInstance_disappears(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var v types.VerifiedAccessInstance
 	resourceName := "aws_verifiedaccess_instance.test"
@@ -167,7 +167,7 @@ func testAccVerifiedAccessInstance_disappears(t *testing.T, semaphore tfsync.Sem
 	})
 }
 
-func testAccVerifiedAccessInstance_tags(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccVerifiedAccessInstance_tags(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var v1, v2, v3 types.VerifiedAccessInstance
 	resourceName := "aws_verifiedaccess_instance.test"
@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/elasticache"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -103,10 +104,16 @@ func resourceUser() *schema.Resource {
 					Type:         schema.TypeString,
 					ValidateFunc: validation.StringLenBetween(16, 128),
 				},
-				Sensitive: true,
+				Sensitive:     true,
+				ConflictsWith: []string{"password_wo"},
 			},
-			names.AttrTags:    tftags.TagsSchema(),
-			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"password_wo": flex.WriteOnlyStringSchema(names.AttrPassword, &schema.Schema{
+				ValidateFunc:  validation.StringLenBetween(16, 128),
+				ConflictsWith: []string{"passwords"},
+			}),
+			"password_wo_version": flex.WriteOnlyVersionSchema(names.AttrPassword),
+			names.AttrTags:        tftags.TagsSchema(),
+			names.AttrTagsAll:     tftags.TagsSchemaComputed(),
 			"user_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -144,6 +151,16 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta any) d
 		input.Passwords = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
+	passwordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("password_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if passwordWO != "" {
+		setUserPasswordWO(input.AuthenticationMode, &input.Passwords, passwordWO)
+	}
+
 	output, err := conn.CreateUser(ctx, input)
 
 	// Some partitions (e.g. ISO) may not support tag-on-create.
@@ -251,6 +268,22 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta any) d
 			input.Passwords = flex.ExpandStringValueSet(d.Get("passwords").(*schema.Set))
 		}
 
+		if flex.HasWriteOnlyChange(d, names.AttrPassword) {
+			passwordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("password_wo"))
+			diags = append(diags, di...)
+			if diags.HasError() {
+				return diags
+			}
+
+			if input.AuthenticationMode == nil {
+				if v, ok := d.GetOk("authentication_mode"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+					input.AuthenticationMode = expandAuthenticationMode(v.([]any)[0].(map[string]any))
+				}
+			}
+
+			setUserPasswordWO(input.AuthenticationMode, &input.Passwords, passwordWO)
+		}
+
 		_, err := conn.ModifyUser(ctx, input)
 
 		if err != nil {
@@ -410,6 +443,16 @@ func waitUserDeleted(ctx context.Context, conn *elasticache.Client, id string, t
 	return nil, err
 }
 
+// setUserPasswordWO sets the write-only password as the authentication mode's password
+// if the authentication type is "password", otherwise as the user's password.
+func setUserPasswordWO(authenticationMode *awstypes.AuthenticationMode, passwords *[]string, password string) {
+	if authenticationMode != nil && authenticationMode.Type == awstypes.InputAuthenticationTypePassword {
+		authenticationMode.Passwords = []string{password}
+	} else {
+		*passwords = []string{password}
+	}
+}
+
 func expandAuthenticationMode(tfMap map[string]any) *awstypes.AuthenticationMode {
 	if tfMap == nil {
 		return nil
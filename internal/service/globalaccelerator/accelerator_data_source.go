@@ -20,6 +20,7 @@ import (
 )
 
 // @FrameworkDataSource("aws_globalaccelerator_accelerator", name="Accelerator")
+// @Region(global=true)
 func newAcceleratorDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
 	d := &acceleratorDataSource{}
 
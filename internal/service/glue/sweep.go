@@ -4,6 +4,7 @@
 package glue
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -12,76 +13,75 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/glue"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/metadata"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_glue_catalog_database", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_catalog_database", &sweep.Sweeper{
 		Name: "aws_glue_catalog_database",
 		F:    sweepCatalogDatabases,
 	})
 
-	resource.AddTestSweepers("aws_glue_classifier", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_classifier", &sweep.Sweeper{
 		Name: "aws_glue_classifier",
 		F:    sweepClassifiers,
 	})
 
-	resource.AddTestSweepers("aws_glue_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_connection", &sweep.Sweeper{
 		Name: "aws_glue_connection",
 		F:    sweepConnections,
 	})
 
-	resource.AddTestSweepers("aws_glue_crawler", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_crawler", &sweep.Sweeper{
 		Name: "aws_glue_crawler",
 		F:    sweepCrawlers,
 	})
 
-	resource.AddTestSweepers("aws_glue_dev_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_dev_endpoint", &sweep.Sweeper{
 		Name: "aws_glue_dev_endpoint",
 		F:    sweepDevEndpoints,
 	})
 
-	resource.AddTestSweepers("aws_glue_job", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_job", &sweep.Sweeper{
 		Name: "aws_glue_job",
 		F:    sweepJobs,
 	})
 
-	resource.AddTestSweepers("aws_glue_ml_transform", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_ml_transform", &sweep.Sweeper{
 		Name: "aws_glue_ml_transform",
 		F:    sweepMLTransforms,
 	})
 
-	resource.AddTestSweepers("aws_glue_registry", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_registry", &sweep.Sweeper{
 		Name: "aws_glue_registry",
 		F:    sweepRegistry,
 	})
 
-	resource.AddTestSweepers("aws_glue_schema", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_schema", &sweep.Sweeper{
 		Name: "aws_glue_schema",
 		F:    sweepSchema,
 	})
 
-	resource.AddTestSweepers("aws_glue_security_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_security_configuration", &sweep.Sweeper{
 		Name: "aws_glue_security_configuration",
 		F:    sweepSecurityConfigurations,
 	})
 
-	resource.AddTestSweepers("aws_glue_trigger", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_trigger", &sweep.Sweeper{
 		Name: "aws_glue_trigger",
 		F:    sweepTriggers,
 	})
 
-	resource.AddTestSweepers("aws_glue_workflow", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_workflow", &sweep.Sweeper{
 		Name: "aws_glue_workflow",
 		F:    sweepWorkflow,
 	})
 }
 
-func sweepCatalogDatabases(region string) error {
-	ctx := sweep.Context(region)
+func sweepCatalogDatabases(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -128,8 +128,7 @@ func sweepCatalogDatabases(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepClassifiers(region string) error {
-	ctx := sweep.Context(region)
+func sweepClassifiers(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -186,8 +185,7 @@ func sweepClassifiers(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepConnections(region string) error {
-	ctx := sweep.Context(region)
+func sweepConnections(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -234,8 +232,7 @@ func sweepConnections(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepCrawlers(region string) error {
-	ctx := sweep.Context(region)
+func sweepCrawlers(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -278,8 +275,7 @@ func sweepCrawlers(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepDevEndpoints(region string) error {
-	ctx := sweep.Context(region)
+func sweepDevEndpoints(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -326,8 +322,7 @@ func sweepDevEndpoints(region string) error {
 	return nil
 }
 
-func sweepJobs(region string) error {
-	ctx := sweep.Context(region)
+func sweepJobs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -368,8 +363,7 @@ func sweepJobs(region string) error {
 	return nil
 }
 
-func sweepMLTransforms(region string) error {
-	ctx := sweep.Context(region)
+func sweepMLTransforms(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -413,8 +407,7 @@ func sweepMLTransforms(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepRegistry(region string) error {
-	ctx := sweep.Context(region)
+func sweepRegistry(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -449,8 +442,7 @@ func sweepRegistry(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepSchema(region string) error {
-	ctx := sweep.Context(region)
+func sweepSchema(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -485,8 +477,7 @@ func sweepSchema(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepSecurityConfigurations(region string) error {
-	ctx := sweep.Context(region)
+func sweepSecurityConfigurations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -510,6 +501,10 @@ func sweepSecurityConfigurations(region string) error {
 		for _, securityConfiguration := range output.SecurityConfigurations {
 			name := aws.ToString(securityConfiguration.Name)
 
+			if !sweep.ShouldDelete(ctx, metadata.Resource{ID: name, Name: name, TypeName: "aws_glue_security_configuration"}) {
+				continue
+			}
+
 			log.Printf("[INFO] Deleting Glue Security Configuration: %s", name)
 			err := DeleteSecurityConfiguration(ctx, conn, name)
 			if err != nil {
@@ -527,8 +522,7 @@ func sweepSecurityConfigurations(region string) error {
 	return nil
 }
 
-func sweepTriggers(region string) error {
-	ctx := sweep.Context(region)
+func sweepTriggers(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -572,8 +566,7 @@ func sweepTriggers(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepWorkflow(region string) error {
-	ctx := sweep.Context(region)
+func sweepWorkflow(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -590,6 +583,10 @@ func sweepWorkflow(region string) error {
 		return fmt.Errorf("Error retrieving Glue Workflow: %s", err)
 	}
 	for _, workflowName := range listOutput.Workflows {
+		if !sweep.ShouldDelete(ctx, metadata.Resource{ID: workflowName, Name: workflowName, TypeName: "aws_glue_workflow"}) {
+			continue
+		}
+
 		err := DeleteWorkflow(ctx, conn, workflowName)
 		if err != nil {
 			log.Printf("[ERROR] Failed to delete Glue Workflow %s: %s", workflowName, err)
@@ -15,6 +15,7 @@ import (
 )
 
 // @SDKResource("aws_iam_account_alias", name="Account Alias")
+// @Region(global=true)
 func resourceAccountAlias() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceAccountAliasCreate,
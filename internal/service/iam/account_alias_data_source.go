@@ -15,6 +15,7 @@ import (
 )
 
 // @SDKDataSource("aws_iam_account_alias", name="Account Alias")
+// @Region(global=true)
 func dataSourceAccountAlias() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceAccountAliasRead,
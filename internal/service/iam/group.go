@@ -24,6 +24,7 @@ import (
 )
 
 // @SDKResource("aws_iam_group", name="Group")
+// @Region(global=true)
 func resourceGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceGroupCreate,
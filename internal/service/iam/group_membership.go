@@ -23,6 +23,7 @@ import (
 )
 
 // @SDKResource("aws_iam_group_membership", name="Group Membership")
+// @Region(global=true)
 func resourceGroupMembership() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceGroupMembershipCreate,
@@ -28,6 +28,7 @@ import (
 )
 
 // @FrameworkResource("aws_iam_group_policies_exclusive", name="Group Policies Exclusive")
+// @Region(global=true)
 func newResourceGroupPoliciesExclusive(_ context.Context) (resource.ResourceWithConfigure, error) {
 	return &resourceGroupPoliciesExclusive{}, nil
 }
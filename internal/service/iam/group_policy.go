@@ -26,6 +26,7 @@ import (
 )
 
 // @SDKResource("aws_iam_group_policy", name="Group Policy")
+// @Region(global=true)
 func resourceGroupPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceGroupPolicyPut,
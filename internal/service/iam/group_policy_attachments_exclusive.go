@@ -28,6 +28,7 @@ import (
 )
 
 // @FrameworkResource("aws_iam_group_policy_attachments_exclusive", name="Group Policy Attachments Exclusive")
+// @Region(global=true)
 func newResourceGroupPolicyAttachmentsExclusive(_ context.Context) (resource.ResourceWithConfigure, error) {
 	return &resourceGroupPolicyAttachmentsExclusive{}, nil
 }
@@ -16,6 +16,7 @@ import (
 )
 
 // @SDKDataSource("aws_iam_instance_profile", name="Instance Profile")
+// @Region(global=true)
 func dataSourceInstanceProfile() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceInstanceProfileRead,
@@ -24,6 +24,7 @@ import (
 )
 
 // @SDKResource("aws_iam_openid_connect_provider", name="OIDC Provider")
+// @Region(global=true)
 // @Tags(identifierAttribute="arn", resourceType="OIDCProvider")
 // @Testing(name="OpenIDConnectProvider")
 func resourceOpenIDConnectProvider() *schema.Resource {
@@ -23,6 +23,7 @@ import (
 )
 
 // @SDKDataSource("aws_iam_openid_connect_provider", name="OIDC Provider")
+// @Region(global=true)
 // @Tags
 // @Testing(tagsIdentifierAttribute="arn", tagsResourceType="OIDCProvider")
 func dataSourceOpenIDConnectProvider() *schema.Resource {
@@ -25,6 +25,7 @@ import (
 )
 
 // @FrameworkResource("aws_iam_organizations_features", name="Organizations Features")
+// @Region(global=true)
 func newOrganizationsFeaturesResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &organizationsFeaturesResource{}
 
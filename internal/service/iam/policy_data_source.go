@@ -20,6 +20,7 @@ import (
 )
 
 // @SDKDataSource("aws_iam_policy", name="Policy")
+// @Region(global=true)
 // @Tags
 // @Testing(tagsIdentifierAttribute="arn", tagsResourceType="Policy")
 func dataSourcePolicy() *schema.Resource {
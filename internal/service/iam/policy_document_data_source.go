@@ -23,6 +23,7 @@ import (
 var dataSourcePolicyDocumentVarReplacer = strings.NewReplacer("&{", "${")
 
 // @SDKDataSource("aws_iam_policy_document", name="Policy Document")
+// @Region(global=true)
 func dataSourcePolicyDocument() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourcePolicyDocumentRead,
@@ -19,6 +19,7 @@ import (
 )
 
 // @SDKDataSource("aws_iam_role", name="Role")
+// @Region(global=true)
 // @Tags
 // @Testing(tagsIdentifierAttribute="name", tagsResourceType="Role")
 func dataSourceRole() *schema.Resource {
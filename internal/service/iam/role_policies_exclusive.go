@@ -28,6 +28,7 @@ import (
 )
 
 // @FrameworkResource("aws_iam_role_policies_exclusive", name="Role Policies Exclusive")
+// @Region(global=true)
 func newResourceRolePoliciesExclusive(_ context.Context) (resource.ResourceWithConfigure, error) {
 	return &resourceRolePoliciesExclusive{}, nil
 }
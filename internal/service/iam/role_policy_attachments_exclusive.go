@@ -28,6 +28,7 @@ import (
 )
 
 // @FrameworkResource("aws_iam_role_policy_attachments_exclusive", name="Role Policy Attachments Exclusive")
+// @Region(global=true)
 func newResourceRolePolicyAttachmentsExclusive(_ context.Context) (resource.ResourceWithConfigure, error) {
 	return &resourceRolePolicyAttachmentsExclusive{}, nil
 }
@@ -20,6 +20,7 @@ import (
 )
 
 // @SDKDataSource("aws_iam_roles", name="Roles")
+// @Region(global=true)
 func dataSourceRoles() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceRolesRead,
@@ -18,6 +18,7 @@ import (
 )
 
 // @SDKDataSource("aws_iam_saml_provider", name="SAML Provider")
+// @Region(global=true)
 func dataSourceSAMLProvider() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceSAMLProviderRead,
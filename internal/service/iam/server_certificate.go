@@ -29,6 +29,7 @@ import (
 )
 
 // @SDKResource("aws_iam_server_certificate", name="Server Certificate")
+// @Region(global=true)
 // @Tags(identifierAttribute="name", resourceType="ServerCertificate")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/iam/types;types.ServerCertificate", tlsKey=true, importStateId="rName", importIgnore="private_key")
 func resourceServerCertificate() *schema.Resource {
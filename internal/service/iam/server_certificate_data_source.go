@@ -23,6 +23,7 @@ import (
 )
 
 // @SDKDataSource("aws_iam_server_certificate", name="Server Certificate")
+// @Region(global=true)
 func dataSourceServerCertificate() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceServerCertificateRead,
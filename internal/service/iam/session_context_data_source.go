@@ -21,6 +21,7 @@ import (
 )
 
 // @SDKDataSource("aws_iam_session_context", name="Session Context")
+// @Region(global=true)
 func dataSourceSessionContext() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceSessionContextRead,
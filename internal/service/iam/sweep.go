@@ -17,18 +17,18 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/metadata"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/sdk"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_iam_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_group", &sweep.Sweeper{
 		Name: "aws_iam_group",
 		F:    sweepGroups,
 		Dependencies: []string{
@@ -42,7 +42,7 @@ func RegisterSweepers() {
 
 	awsv2.Register("aws_iam_openid_connect_provider", sweepOpenIDConnectProvider)
 
-	resource.AddTestSweepers("aws_iam_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_policy", &sweep.Sweeper{
 		Name: "aws_iam_policy",
 		F:    sweepPolicies,
 		Dependencies: []string{
@@ -54,7 +54,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iam_role", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_role", &sweep.Sweeper{
 		Name: "aws_iam_role",
 		Dependencies: []string{
 			"aws_batch_compute_environment",
@@ -91,14 +91,14 @@ func RegisterSweepers() {
 
 	awsv2.Register("aws_iam_signing_certificate", sweepSigningCertificates)
 
-	resource.AddTestSweepers("aws_iam_server_certificate", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_server_certificate", &sweep.Sweeper{
 		Name: "aws_iam_server_certificate",
 		F:    sweepServerCertificates,
 	})
 
 	awsv2.Register("aws_iam_service_linked_role", sweepServiceLinkedRoles)
 
-	resource.AddTestSweepers("aws_iam_user", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_user", &sweep.Sweeper{
 		Name: "aws_iam_user",
 		F:    sweepUsers,
 		Dependencies: []string{
@@ -111,8 +111,7 @@ func RegisterSweepers() {
 	awsv2.Register("aws_iam_virtual_mfa_device", sweepVirtualMFADevice)
 }
 
-func sweepGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -142,6 +141,10 @@ func sweepGroups(region string) error {
 				continue
 			}
 
+			if !sweep.ShouldDelete(ctx, metadata.Resource{CreationTime: aws.ToTime(group.CreateDate), ID: name, Name: name, TypeName: "aws_iam_group"}) {
+				continue
+			}
+
 			log.Printf("[INFO] Deleting IAM Group: %s", name)
 
 			getGroupInput := &iam.GetGroupInput{
@@ -345,8 +348,7 @@ func sweepServiceSpecificCredentials(ctx context.Context, client *conns.AWSClien
 	return sweepResources, nil
 }
 
-func sweepPolicies(region string) error {
-	ctx := sweep.Context(region)
+func sweepPolicies(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -419,8 +421,15 @@ func (ps policySweeper) Delete(ctx context.Context, optFns ...tfresource.Options
 	return nil
 }
 
-func sweepRoles(region string) error {
-	ctx := sweep.Context(region)
+func (ps policySweeper) Metadata(ctx context.Context) metadata.Resource {
+	if v, ok := ps.sweepable.(sweep.Describable); ok {
+		return v.Metadata(ctx)
+	}
+
+	return metadata.Resource{ID: ps.d.Id()}
+}
+
+func sweepRoles(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -458,6 +467,10 @@ func sweepRoles(region string) error {
 	var sweeperErrs *multierror.Error
 
 	for _, roleName := range roles {
+		if !sweep.ShouldDelete(ctx, metadata.Resource{ID: roleName, Name: roleName, TypeName: "aws_iam_role"}) {
+			continue
+		}
+
 		log.Printf("[DEBUG] Deleting IAM Role (%s)", roleName)
 
 		err := deleteRole(ctx, conn, roleName, true, true, true)
@@ -500,8 +513,7 @@ func sweepSAMLProvider(ctx context.Context, client *conns.AWSClient) ([]sweep.Sw
 	return sweepResources, err
 }
 
-func sweepServerCertificates(region string) error {
-	ctx := sweep.Context(region)
+func sweepServerCertificates(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -521,6 +533,12 @@ func sweepServerCertificates(region string) error {
 		}
 
 		for _, sc := range page.ServerCertificateMetadataList {
+			name := aws.ToString(sc.ServerCertificateName)
+
+			if !sweep.ShouldDelete(ctx, metadata.Resource{CreationTime: aws.ToTime(sc.UploadDate), ID: name, Name: name, TypeName: "aws_iam_server_certificate"}) {
+				continue
+			}
+
 			log.Printf("[INFO] Deleting IAM Server Certificate: %s", aws.ToString(sc.ServerCertificateName))
 
 			_, err := conn.DeleteServerCertificate(ctx, &iam.DeleteServerCertificateInput{
@@ -579,8 +597,7 @@ func sweepServiceLinkedRoles(ctx context.Context, client *conns.AWSClient) ([]sw
 	return sweepResources, nil
 }
 
-func sweepUsers(region string) error {
-	ctx := sweep.Context(region)
+func sweepUsers(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -27,6 +27,7 @@ import (
 )
 
 // @SDKResource("aws_iam_user", name="User")
+// @Region(global=true)
 // @Tags(identifierAttribute="name", resourceType="User")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/iam/types;types.User", importIgnore="force_destroy")
 func resourceUser() *schema.Resource {
@@ -18,6 +18,7 @@ import (
 )
 
 // @SDKDataSource("aws_iam_user", name="User")
+// @Region(global=true)
 // @Tags
 // @Testing(tagsIdentifierAttribute="user_name", tagsResourceType="User")
 func dataSourceUser() *schema.Resource {
@@ -25,6 +25,7 @@ import (
 )
 
 // @SDKResource("aws_iam_user_group_membership", name="User Group Membership")
+// @Region(global=true)
 func resourceUserGroupMembership() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceUserGroupMembershipCreate,
@@ -28,6 +28,7 @@ import (
 )
 
 // @FrameworkResource("aws_iam_user_policies_exclusive", name="User Policies Exclusive")
+// @Region(global=true)
 func newResourceUserPoliciesExclusive(_ context.Context) (resource.ResourceWithConfigure, error) {
 	return &resourceUserPoliciesExclusive{}, nil
 }
@@ -26,6 +26,7 @@ import (
 )
 
 // @SDKResource("aws_iam_user_policy", name="User Policy")
+// @Region(global=true)
 func resourceUserPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceUserPolicyPut,
@@ -28,6 +28,7 @@ import (
 )
 
 // @FrameworkResource("aws_iam_user_policy_attachments_exclusive", name="User Policy Attachments Exclusive")
+// @Region(global=true)
 func newResourceUserPolicyAttachmentsExclusive(_ context.Context) (resource.ResourceWithConfigure, error) {
 	return &resourceUserPolicyAttachmentsExclusive{}, nil
 }
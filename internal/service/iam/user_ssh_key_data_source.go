@@ -18,6 +18,7 @@ import (
 )
 
 // @SDKDataSource("aws_iam_user_ssh_key", name="User SSH Key")
+// @Region(global=true)
 func dataSourceUserSSHKey() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceUserSSHKeyRead,
@@ -170,6 +170,12 @@ func (p *servicePackage) UpdateTags(ctx context.Context, meta any, identifier st
 	return updateTags(ctx, meta.(*conns.AWSClient).KMSClient(ctx), identifier, oldTags, newTags)
 }
 
+// WaitTagsPropagated waits for kms service tags to be propagated.
+// It is called from outside this package.
+func (p *servicePackage) WaitTagsPropagated(ctx context.Context, meta any, identifier string, tags any) error {
+	return waitTagsPropagated(ctx, meta.(*conns.AWSClient).KMSClient(ctx), identifier, tftags.New(ctx, tags))
+}
+
 // waitTagsPropagated waits for kms service tags to be propagated.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
@@ -12,12 +12,14 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lightsail"
 	"github.com/aws/aws-sdk-go-v2/service/lightsail/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -123,14 +125,16 @@ func ResourceDatabase() *schema.Resource {
 				Computed: true,
 			},
 			"master_password": {
-				Type:      schema.TypeString,
-				Required:  true,
-				Sensitive: true,
-				ValidateFunc: validation.All(
-					validation.StringLenBetween(8, 128),
-					validation.StringMatch(regexache.MustCompile(`^[ -~][^@\/" ]+$`), "The password can include any printable ASCII character except \"/\", \"\"\", or \"@\". It cannot contain spaces."),
-				),
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"master_password", "master_password_wo"},
+				ValidateFunc: validDatabaseMasterPassword,
 			},
+			"master_password_wo": flex.WriteOnlyStringSchema("master_password", &schema.Schema{
+				ValidateFunc: validDatabaseMasterPassword,
+			}),
+			"master_password_wo_version": flex.WriteOnlyVersionSchema("master_password"),
 			"master_username": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -190,6 +194,11 @@ func ResourceDatabase() *schema.Resource {
 	}
 }
 
+var validDatabaseMasterPassword = validation.All(
+	validation.StringLenBetween(8, 128),
+	validation.StringMatch(regexache.MustCompile(`^[ -~][^@\/" ]+$`), "The password can include any printable ASCII character except \"/\", \"\"\", or \"@\". It cannot contain spaces."),
+)
+
 func resourceDatabaseCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -213,6 +222,16 @@ func resourceDatabaseCreate(ctx context.Context, d *schema.ResourceData, meta an
 		input.MasterUserPassword = aws.String(v.(string))
 	}
 
+	masterPasswordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("master_password_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if masterPasswordWO != "" {
+		input.MasterUserPassword = aws.String(masterPasswordWO)
+	}
+
 	if v, ok := d.GetOk("preferred_backup_window"); ok {
 		input.PreferredBackupWindow = aws.String(v.(string))
 	}
@@ -349,6 +368,16 @@ func resourceDatabaseUpdate(ctx context.Context, d *schema.ResourceData, meta an
 			input.MasterUserPassword = aws.String(d.Get("master_password").(string))
 		}
 
+		if flex.HasWriteOnlyChange(d, "master_password") {
+			masterPasswordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("master_password_wo"))
+			diags = append(diags, di...)
+			if diags.HasError() {
+				return diags
+			}
+
+			input.MasterUserPassword = aws.String(masterPasswordWO)
+		}
+
 		if d.HasChange("preferred_backup_window") {
 			input.PreferredBackupWindow = aws.String(d.Get("preferred_backup_window").(string))
 		}
@@ -21,13 +21,13 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
-	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
 	tflightsail "github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-func testAccDatabase_basic(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccDatabase_basic(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_lightsail_database.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -81,7 +81,7 @@ func testAccDatabase_basic(t *testing.T, semaphore tfsync.Semaphore) {
 	})
 }
 
-func testAccDatabase_relationalDatabaseName(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccDatabase_relationalDatabaseName(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_lightsail_database.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -144,7 +144,7 @@ func testAccDatabase_relationalDatabaseName(t *testing.T, semaphore tfsync.Semap
 	})
 }
 
-func testAccDatabase_masterDatabaseName(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccDatabase_masterDatabaseName(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_lightsail_database.test"
@@ -211,7 +211,7 @@ func testAccDatabase_masterDatabaseName(t *testing.T, semaphore tfsync.Semaphore
 	})
 }
 
-func testAccDatabase_masterUsername(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccDatabase_masterUsername(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_lightsail_database.test"
@@ -283,7 +283,7 @@ func testAccDatabase_masterUsername(t *testing.T, semaphore tfsync.Semaphore) {
 	})
 }
 
-func testAccDatabase_masterPassword(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccDatabase_masterPassword(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	password := "testpassword"
@@ -333,7 +333,7 @@ func testAccDatabase_masterPassword(t *testing.T, semaphore tfsync.Semaphore) {
 	})
 }
 
-func testAccDatabase_preferredBackupWindow(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccDatabase_preferredBackupWindow(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_lightsail_database.test"
@@ -388,7 +388,7 @@ func testAccDatabase_preferredBackupWindow(t *testing.T, semaphore tfsync.Semaph
 	})
 }
 
-func testAccDatabase_preferredMaintenanceWindow(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccDatabase_preferredMaintenanceWindow(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_lightsail_database.test"
@@ -448,7 +448,7 @@ func testAccDatabase_preferredMaintenanceWindow(t *testing.T, semaphore tfsync.S
 	})
 }
 
-func testAccDatabase_publiclyAccessible(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccDatabase_publiclyAccessible(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_lightsail_database.test"
@@ -493,7 +493,7 @@ func testAccDatabase_publiclyAccessible(t *testing.T, semaphore tfsync.Semaphore
 	})
 }
 
-func testAccDatabase_backupRetentionEnabled(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccDatabase_backupRetentionEnabled(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_lightsail_database.test"
@@ -538,7 +538,7 @@ func testAccDatabase_backupRetentionEnabled(t *testing.T, semaphore tfsync.Semap
 	})
 }
 
-func testAccDatabase_finalSnapshotName(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccDatabase_finalSnapshotName(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_lightsail_database.test"
@@ -596,7 +596,7 @@ func testAccDatabase_finalSnapshotName(t *testing.T, semaphore tfsync.Semaphore)
 	})
 }
 
-func testAccDatabase_tags(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccDatabase_tags(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_lightsail_database.test"
@@ -652,7 +652,7 @@ func testAccDatabase_tags(t *testing.T, semaphore tfsync.Semaphore) {
 	})
 }
 
-func testAccDatabase_keyOnlyTags(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccDatabase_keyOnlyTags(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_lightsail_database.test"
@@ -708,7 +708,7 @@ func testAccDatabase_keyOnlyTags(t *testing.T, semaphore tfsync.Semaphore) {
 	})
 }
 
-func testAccDatabase_ha(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccDatabase_ha(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_lightsail_database.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -748,7 +748,7 @@ func testAccDatabase_ha(t *testing.T, semaphore tfsync.Semaphore) {
 	})
 }
 
-func testAccDatabase_disappears(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccDatabase_disappears(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_lightsail_database.test"
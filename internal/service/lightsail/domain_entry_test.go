@@ -18,13 +18,13 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
-	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
 	tflightsail "github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-func testAccDomainEntry_basic(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccDomainEntry_basic(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_lightsail_domain_entry.test"
 	domainName := acctest.RandomDomainName()
@@ -68,7 +68,7 @@ func testAccDomainEntry_basic(t *testing.T, semaphore tfsync.Semaphore) {
 	})
 }
 
-func testAccDomainEntry_underscore(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccDomainEntry_underscore(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_lightsail_domain_entry.test"
 	domainName := acctest.RandomDomainName()
@@ -112,7 +112,7 @@ func testAccDomainEntry_underscore(t *testing.T, semaphore tfsync.Semaphore) {
 	})
 }
 
-func testAccDomainEntry_apex(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccDomainEntry_apex(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_lightsail_domain_entry.test"
 	domainName := acctest.RandomDomainName()
@@ -156,7 +156,7 @@ func testAccDomainEntry_apex(t *testing.T, semaphore tfsync.Semaphore) {
 	})
 }
 
-func testAccDomainEntry_disappears(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccDomainEntry_disappears(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_lightsail_domain_entry.test"
 	domainName := acctest.RandomDomainName()
@@ -184,7 +184,7 @@ func testAccDomainEntry_disappears(t *testing.T, semaphore tfsync.Semaphore) {
 	})
 }
 
-func testAccDomainEntry_typeAAAA(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccDomainEntry_typeAAAA(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_lightsail_domain_entry.test"
 	domainName := acctest.RandomDomainName()
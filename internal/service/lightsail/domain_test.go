@@ -18,11 +18,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
 	tflightsail "github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
 )
 
-func testAccDomain_basic(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccDomain_basic(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	lightsailDomainName := fmt.Sprintf("tf-test-lightsail-%s.com", sdkacctest.RandString(5))
 	resourceName := "aws_lightsail_domain.test"
@@ -47,7 +47,7 @@ func testAccDomain_basic(t *testing.T, semaphore tfsync.Semaphore) {
 	})
 }
 
-func testAccDomain_disappears(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccDomain_disappears(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	lightsailDomainName := fmt.Sprintf("tf-test-lightsail-%s.com", sdkacctest.RandString(5))
 	resourceName := "aws_lightsail_domain.test"
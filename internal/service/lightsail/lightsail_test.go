@@ -7,7 +7,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
-	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -21,7 +21,7 @@ func TestAccLightsail_serial(t *testing.T) {
 
 	semaphore := tfsync.GetSemaphore("Lightsail", "AWS_LIGHTSAIL_LIMIT", 6)
 
-	testCases := map[string]map[string]func(*testing.T, tfsync.Semaphore){
+	testCases := map[string]map[string]func(*testing.T, *tfsync.Semaphore){
 		names.AttrDatabase: {
 			"backupRetentionEnabled":     testAccDatabase_backupRetentionEnabled,
 			acctest.CtBasic:              testAccDatabase_basic,
@@ -54,6 +54,6 @@ func TestAccLightsail_serial(t *testing.T) {
 	acctest.RunLimitedConcurrencyTests2Levels(t, semaphore, testCases)
 }
 
-func testAccPreCheckLightsailSynchronize(t *testing.T, semaphore tfsync.Semaphore) { // nosemgrep: ci.lightsail-in-func-name
+func testAccPreCheckLightsailSynchronize(t *testing.T, semaphore *tfsync.Semaphore) { // nosemgrep: ci.lightsail-in-func-name
 	tfsync.TestAccPreCheckSyncronize(t, semaphore, "Lightsail")
 }
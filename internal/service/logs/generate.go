@@ -5,6 +5,7 @@
 //go:generate go run ../../generate/tags/main.go -ListTags -ServiceTagsMap -UpdateTags -CreateTags -KVTValues
 //go:generate go run ../../generate/servicepackage/main.go
 //go:generate go run ../../generate/tagstests/main.go
+//go:generate go run ../../generate/lifecycletests/main.go
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package logs
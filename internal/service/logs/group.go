@@ -29,6 +29,8 @@ import (
 // @Tags(identifierAttribute="arn")
 // @Testing(destroyTakesT=true)
 // @Testing(existsTakesT=true)
+// @Testing(lifecycleTest=true)
+// @Testing(disappearsTest=false)
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types;awstypes;awstypes.LogGroup")
 func resourceGroup() *schema.Resource {
 	return &schema.Resource{
@@ -6,19 +6,170 @@ package logs_test
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fakeaws"
 	tflogs "github.com/hashicorp/terraform-provider-aws/internal/service/logs"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestLogsGroup_inProcess(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+	s := fakeaws.New()
+	logGroups := make(map[string]map[string]any)
+
+	s.On("CloudWatch Logs", "CreateLogGroup", fakeaws.Throttled(1, func(r *fakeaws.Request) *fakeaws.Response {
+		var input struct {
+			LogGroupName string `json:"logGroupName"`
+		}
+		if err := r.DecodeJSON(&input); err != nil {
+			return fakeaws.Error(http.StatusBadRequest, "InvalidParameterException", err.Error())(r)
+		}
+		if _, ok := logGroups[input.LogGroupName]; ok {
+			return fakeaws.Error(http.StatusBadRequest, "ResourceAlreadyExistsException", "The specified log group already exists")(r)
+		}
+		logGroups[input.LogGroupName] = map[string]any{
+			names.AttrARN:     fmt.Sprintf("arn:aws:logs:%s:%s:log-group:%s:*", fakeaws.Region, fakeaws.AccountID, input.LogGroupName), //lintignore:AWSAT003,AWSAT005
+			"logGroupClass":   "STANDARD",
+			"logGroupName":    input.LogGroupName,
+			"retentionInDays": nil,
+		}
+		return nil
+	}))
+	// IAM eventual consistency for the logs:PutRetentionPolicy action.
+	s.On("CloudWatch Logs", "PutRetentionPolicy", fakeaws.EventuallyConsistent(1,
+		fakeaws.Error(http.StatusBadRequest, "AccessDeniedException", "User is not authorized to perform: logs:PutRetentionPolicy because no identity-based policy allows the logs:PutRetentionPolicy action"),
+		func(r *fakeaws.Request) *fakeaws.Response {
+			var input struct {
+				LogGroupName    string `json:"logGroupName"`
+				RetentionInDays int    `json:"retentionInDays"`
+			}
+			if err := r.DecodeJSON(&input); err != nil {
+				return fakeaws.Error(http.StatusBadRequest, "InvalidParameterException", err.Error())(r)
+			}
+			logGroup, ok := logGroups[input.LogGroupName]
+			if !ok {
+				return fakeaws.Error(http.StatusBadRequest, "ResourceNotFoundException", "The specified log group does not exist")(r)
+			}
+			logGroup["retentionInDays"] = input.RetentionInDays
+			return nil
+		},
+	))
+	s.On("CloudWatch Logs", "DescribeLogGroups", func(r *fakeaws.Request) *fakeaws.Response {
+		var input struct {
+			LogGroupNamePrefix string `json:"logGroupNamePrefix"`
+		}
+		if err := r.DecodeJSON(&input); err != nil {
+			return fakeaws.Error(http.StatusBadRequest, "InvalidParameterException", err.Error())(r)
+		}
+		v := []any{}
+		for name, logGroup := range logGroups {
+			if strings.HasPrefix(name, input.LogGroupNamePrefix) {
+				v = append(v, logGroup)
+			}
+		}
+		return fakeaws.JSONResponse(map[string]any{"logGroups": v})(r)
+	})
+	s.On("CloudWatch Logs", "ListTagsForResource", fakeaws.JSONResponse(map[string]any{names.AttrTags: map[string]string{}}))
+	s.On("CloudWatch Logs", "DeleteLogGroup", fakeaws.Sequence(
+		fakeaws.Error(http.StatusBadRequest, "OperationAbortedException", "A conflicting operation is currently in progress against this resource. Please try again."),
+		func(r *fakeaws.Request) *fakeaws.Response {
+			var input struct {
+				LogGroupName string `json:"logGroupName"`
+			}
+			if err := r.DecodeJSON(&input); err != nil {
+				return fakeaws.Error(http.StatusBadRequest, "InvalidParameterException", err.Error())(r)
+			}
+			if _, ok := logGroups[input.LogGroupName]; !ok {
+				return fakeaws.Error(http.StatusBadRequest, "ResourceNotFoundException", "The specified log group does not exist")(r)
+			}
+			delete(logGroups, input.LogGroupName)
+			return nil
+		},
+	))
+
+	p := acctest.NewInProcessProvider(ctx, t, s)
+	rName := "tf-acc-test-in-process"
+
+	state, err := p.Create(ctx, "aws_cloudwatch_log_group", map[string]any{
+		names.AttrName:      rName,
+		"retention_in_days": 7,
+	})
+	if err != nil {
+		t.Fatalf("creating: %s", err)
+	}
+
+	if got, want := state.Attributes["retention_in_days"], "7"; got != want {
+		t.Errorf("retention_in_days = %q, want %q", got, want)
+	}
+	if got, want := state.Attributes[names.AttrARN], fmt.Sprintf("arn:aws:logs:%s:%s:log-group:%s", fakeaws.Region, fakeaws.AccountID, rName); got != want { //lintignore:AWSAT003,AWSAT005
+		t.Errorf("arn = %q, want %q", got, want)
+	}
+	if got, want := s.Operations(), []string{
+		"CloudWatch Logs.CreateLogGroup",
+		"CloudWatch Logs.CreateLogGroup",
+		"CloudWatch Logs.PutRetentionPolicy",
+		"CloudWatch Logs.PutRetentionPolicy",
+		"CloudWatch Logs.DescribeLogGroups",
+		"CloudWatch Logs.ListTagsForResource",
+	}; !cmp.Equal(got, want) {
+		t.Errorf("unexpected create operations: %s", cmp.Diff(want, got))
+	}
+
+	s.ResetCalls()
+	state, err = p.Update(ctx, state, map[string]any{
+		names.AttrName:      rName,
+		"retention_in_days": 14,
+	})
+	if err != nil {
+		t.Fatalf("updating: %s", err)
+	}
+
+	if got, want := state.Attributes["retention_in_days"], "14"; got != want {
+		t.Errorf("retention_in_days = %q, want %q", got, want)
+	}
+	if got, want := s.Operations(), []string{
+		"CloudWatch Logs.PutRetentionPolicy",
+		"CloudWatch Logs.DescribeLogGroups",
+		"CloudWatch Logs.ListTagsForResource",
+	}; !cmp.Equal(got, want) {
+		t.Errorf("unexpected update operations: %s", cmp.Diff(want, got))
+	}
+
+	s.ResetCalls()
+	if err := p.Delete(ctx, state); err != nil {
+		t.Fatalf("deleting: %s", err)
+	}
+
+	if got, want := s.Operations(), []string{
+		"CloudWatch Logs.DeleteLogGroup",
+		"CloudWatch Logs.DeleteLogGroup",
+	}; !cmp.Equal(got, want) {
+		t.Errorf("unexpected delete operations: %s", cmp.Diff(want, got))
+	}
+
+	state, err = p.Read(ctx, state)
+	if err != nil {
+		t.Fatalf("reading: %s", err)
+	}
+
+	if state != nil {
+		t.Errorf("expected log group to be removed from state, got %v", state.Attributes)
+	}
+}
+
 func TestAccLogsGroup_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v types.LogGroup
@@ -22,7 +22,7 @@ func TestAccMediaLiveInputSecurityGroup_tags(t *testing.T) {
 	resourceName := "aws_medialive_input_security_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.MediaLive) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.MediaLiveServiceID),
 		CheckDestroy:             testAccCheckInputSecurityGroupDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -195,7 +195,7 @@ func TestAccMediaLiveInputSecurityGroup_tags_null(t *testing.T) {
 	resourceName := "aws_medialive_input_security_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.MediaLive) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.MediaLiveServiceID),
 		CheckDestroy:             testAccCheckInputSecurityGroupDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -252,7 +252,7 @@ func TestAccMediaLiveInputSecurityGroup_tags_EmptyMap(t *testing.T) {
 	resourceName := "aws_medialive_input_security_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.MediaLive) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.MediaLiveServiceID),
 		CheckDestroy:             testAccCheckInputSecurityGroupDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -305,7 +305,7 @@ func TestAccMediaLiveInputSecurityGroup_tags_AddOnUpdate(t *testing.T) {
 	resourceName := "aws_medialive_input_security_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.MediaLive) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.MediaLiveServiceID),
 		CheckDestroy:             testAccCheckInputSecurityGroupDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -382,7 +382,7 @@ func TestAccMediaLiveInputSecurityGroup_tags_EmptyTag_OnCreate(t *testing.T) {
 	resourceName := "aws_medialive_input_security_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.MediaLive) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.MediaLiveServiceID),
 		CheckDestroy:             testAccCheckInputSecurityGroupDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -466,7 +466,7 @@ func TestAccMediaLiveInputSecurityGroup_tags_EmptyTag_OnUpdate_Add(t *testing.T)
 	resourceName := "aws_medialive_input_security_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.MediaLive) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.MediaLiveServiceID),
 		CheckDestroy:             testAccCheckInputSecurityGroupDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -597,7 +597,7 @@ func TestAccMediaLiveInputSecurityGroup_tags_EmptyTag_OnUpdate_Replace(t *testin
 	resourceName := "aws_medialive_input_security_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.MediaLive) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.MediaLiveServiceID),
 		CheckDestroy:             testAccCheckInputSecurityGroupDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -682,7 +682,7 @@ func TestAccMediaLiveInputSecurityGroup_tags_DefaultTags_providerOnly(t *testing
 	resourceName := "aws_medialive_input_security_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.MediaLive) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.MediaLiveServiceID),
 		CheckDestroy: testAccCheckInputSecurityGroupDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -854,7 +854,7 @@ func TestAccMediaLiveInputSecurityGroup_tags_DefaultTags_nonOverlapping(t *testi
 	resourceName := "aws_medialive_input_security_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.MediaLive) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.MediaLiveServiceID),
 		CheckDestroy: testAccCheckInputSecurityGroupDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1007,7 +1007,7 @@ func TestAccMediaLiveInputSecurityGroup_tags_DefaultTags_overlapping(t *testing.
 	resourceName := "aws_medialive_input_security_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.MediaLive) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.MediaLiveServiceID),
 		CheckDestroy: testAccCheckInputSecurityGroupDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1176,7 +1176,7 @@ func TestAccMediaLiveInputSecurityGroup_tags_DefaultTags_updateToProviderOnly(t
 	resourceName := "aws_medialive_input_security_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.MediaLive) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.MediaLiveServiceID),
 		CheckDestroy: testAccCheckInputSecurityGroupDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1262,7 +1262,7 @@ func TestAccMediaLiveInputSecurityGroup_tags_DefaultTags_updateToResourceOnly(t
 	resourceName := "aws_medialive_input_security_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.MediaLive) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.MediaLiveServiceID),
 		CheckDestroy: testAccCheckInputSecurityGroupDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1347,7 +1347,7 @@ func TestAccMediaLiveInputSecurityGroup_tags_DefaultTags_emptyResourceTag(t *tes
 	resourceName := "aws_medialive_input_security_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.MediaLive) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.MediaLiveServiceID),
 		CheckDestroy: testAccCheckInputSecurityGroupDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1409,7 +1409,7 @@ func TestAccMediaLiveInputSecurityGroup_tags_DefaultTags_emptyProviderOnlyTag(t
 	resourceName := "aws_medialive_input_security_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.MediaLive) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.MediaLiveServiceID),
 		CheckDestroy: testAccCheckInputSecurityGroupDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1463,7 +1463,7 @@ func TestAccMediaLiveInputSecurityGroup_tags_DefaultTags_nullOverlappingResource
 	resourceName := "aws_medialive_input_security_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.MediaLive) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.MediaLiveServiceID),
 		CheckDestroy: testAccCheckInputSecurityGroupDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1522,7 +1522,7 @@ func TestAccMediaLiveInputSecurityGroup_tags_DefaultTags_nullNonOverlappingResou
 	resourceName := "aws_medialive_input_security_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.MediaLive) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.MediaLiveServiceID),
 		CheckDestroy: testAccCheckInputSecurityGroupDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1581,7 +1581,7 @@ func TestAccMediaLiveInputSecurityGroup_tags_ComputedTag_OnCreate(t *testing.T)
 	resourceName := "aws_medialive_input_security_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.MediaLive) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.MediaLiveServiceID),
 		CheckDestroy: testAccCheckInputSecurityGroupDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1633,7 +1633,7 @@ func TestAccMediaLiveInputSecurityGroup_tags_ComputedTag_OnUpdate_Add(t *testing
 	resourceName := "aws_medialive_input_security_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.MediaLive) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.MediaLiveServiceID),
 		CheckDestroy: testAccCheckInputSecurityGroupDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1726,7 +1726,7 @@ func TestAccMediaLiveInputSecurityGroup_tags_ComputedTag_OnUpdate_Replace(t *tes
 	resourceName := "aws_medialive_input_security_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.MediaLive) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.MediaLiveServiceID),
 		CheckDestroy: testAccCheckInputSecurityGroupDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1809,7 +1809,7 @@ func TestAccMediaLiveInputSecurityGroup_tags_IgnoreTags_Overlap_DefaultTag(t *te
 	resourceName := "aws_medialive_input_security_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.MediaLive) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.MediaLiveServiceID),
 		CheckDestroy: testAccCheckInputSecurityGroupDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1967,7 +1967,7 @@ func TestAccMediaLiveInputSecurityGroup_tags_IgnoreTags_Overlap_ResourceTag(t *t
 	resourceName := "aws_medialive_input_security_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.MediaLive) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.MediaLiveServiceID),
 		CheckDestroy: testAccCheckInputSecurityGroupDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -53,7 +53,7 @@ func testAccMediaPackageV2ChannelGroup_tags(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.MediaPackageV2) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
 		CheckDestroy:             testAccCheckChannelGroupDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -243,7 +243,7 @@ func testAccMediaPackageV2ChannelGroup_tags_null(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.MediaPackageV2) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
 		CheckDestroy:             testAccCheckChannelGroupDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -307,7 +307,7 @@ func testAccMediaPackageV2ChannelGroup_tags_EmptyMap(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.MediaPackageV2) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
 		CheckDestroy:             testAccCheckChannelGroupDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -359,7 +359,7 @@ func testAccMediaPackageV2ChannelGroup_tags_AddOnUpdate(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.MediaPackageV2) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
 		CheckDestroy:             testAccCheckChannelGroupDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -441,7 +441,7 @@ func testAccMediaPackageV2ChannelGroup_tags_EmptyTag_OnCreate(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.MediaPackageV2) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
 		CheckDestroy:             testAccCheckChannelGroupDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -535,7 +535,7 @@ func testAccMediaPackageV2ChannelGroup_tags_EmptyTag_OnUpdate_Add(t *testing.T)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.MediaPackageV2) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
 		CheckDestroy:             testAccCheckChannelGroupDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -678,7 +678,7 @@ func testAccMediaPackageV2ChannelGroup_tags_EmptyTag_OnUpdate_Replace(t *testing
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.MediaPackageV2) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
 		CheckDestroy:             testAccCheckChannelGroupDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -770,7 +770,7 @@ func testAccMediaPackageV2ChannelGroup_tags_DefaultTags_providerOnly(t *testing.
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.MediaPackageV2) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
 		CheckDestroy: testAccCheckChannelGroupDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -959,7 +959,7 @@ func testAccMediaPackageV2ChannelGroup_tags_DefaultTags_nonOverlapping(t *testin
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.MediaPackageV2) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
 		CheckDestroy: testAccCheckChannelGroupDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1125,7 +1125,7 @@ func testAccMediaPackageV2ChannelGroup_tags_DefaultTags_overlapping(t *testing.T
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.MediaPackageV2) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
 		CheckDestroy: testAccCheckChannelGroupDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1307,7 +1307,7 @@ func testAccMediaPackageV2ChannelGroup_tags_DefaultTags_updateToProviderOnly(t *
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.MediaPackageV2) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
 		CheckDestroy: testAccCheckChannelGroupDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1399,7 +1399,7 @@ func testAccMediaPackageV2ChannelGroup_tags_DefaultTags_updateToResourceOnly(t *
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.MediaPackageV2) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
 		CheckDestroy: testAccCheckChannelGroupDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1490,7 +1490,7 @@ func testAccMediaPackageV2ChannelGroup_tags_DefaultTags_emptyResourceTag(t *test
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.MediaPackageV2) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
 		CheckDestroy: testAccCheckChannelGroupDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1558,7 +1558,7 @@ func testAccMediaPackageV2ChannelGroup_tags_DefaultTags_emptyProviderOnlyTag(t *
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.MediaPackageV2) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
 		CheckDestroy: testAccCheckChannelGroupDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1618,7 +1618,7 @@ func testAccMediaPackageV2ChannelGroup_tags_DefaultTags_nullOverlappingResourceT
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.MediaPackageV2) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
 		CheckDestroy: testAccCheckChannelGroupDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1689,7 +1689,7 @@ func testAccMediaPackageV2ChannelGroup_tags_DefaultTags_nullNonOverlappingResour
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.MediaPackageV2) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
 		CheckDestroy: testAccCheckChannelGroupDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1762,7 +1762,7 @@ func testAccMediaPackageV2ChannelGroup_tags_ComputedTag_OnCreate(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.MediaPackageV2) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
 		CheckDestroy: testAccCheckChannelGroupDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1819,7 +1819,7 @@ func testAccMediaPackageV2ChannelGroup_tags_ComputedTag_OnUpdate_Add(t *testing.
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.MediaPackageV2) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
 		CheckDestroy: testAccCheckChannelGroupDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1918,7 +1918,7 @@ func testAccMediaPackageV2ChannelGroup_tags_ComputedTag_OnUpdate_Replace(t *test
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.MediaPackageV2) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
 		CheckDestroy: testAccCheckChannelGroupDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -2007,7 +2007,7 @@ func testAccMediaPackageV2ChannelGroup_tags_IgnoreTags_Overlap_DefaultTag(t *tes
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.MediaPackageV2) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
 		CheckDestroy: testAccCheckChannelGroupDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -2169,7 +2169,7 @@ func testAccMediaPackageV2ChannelGroup_tags_IgnoreTags_Overlap_ResourceTag(t *te
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.MediaPackageV2) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
 		CheckDestroy: testAccCheckChannelGroupDestroy(ctx),
 		Steps: []resource.TestStep{
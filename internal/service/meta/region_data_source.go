@@ -21,6 +21,7 @@ import (
 )
 
 // @FrameworkDataSource("aws_region", name="Region")
+// @Region(global=true)
 func newRegionDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
 	d := &regionDataSource{}
 
@@ -18,6 +18,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/mq"
 	"github.com/aws/aws-sdk-go-v2/service/mq/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
@@ -30,6 +31,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -355,7 +357,7 @@ func resourceBroker() *schema.Resource {
 						},
 						names.AttrPassword: {
 							Type:         schema.TypeString,
-							Required:     true,
+							Optional:     true,
 							Sensitive:    true,
 							ValidateFunc: ValidBrokerPassword,
 						},
@@ -372,6 +374,10 @@ func resourceBroker() *schema.Resource {
 					},
 				},
 			},
+			"user_passwords_wo": flex.WriteOnlyStringSchema("user_passwords", &schema.Schema{
+				ValidateFunc: validation.StringIsJSON,
+			}),
+			"user_passwords_wo_version": flex.WriteOnlyVersionSchema("user_passwords"),
 		},
 
 		CustomizeDiff: customdiff.All(
@@ -386,6 +392,7 @@ func resourceBroker() *schema.Resource {
 
 				return nil
 			},
+			customizeDiffBrokerUserPasswords,
 		),
 	}
 }
@@ -409,6 +416,23 @@ func resourceBrokerCreate(ctx context.Context, d *schema.ResourceData, meta any)
 		Users:                   expandUsers(d.Get("user").(*schema.Set).List()),
 	}
 
+	passwordsWO, di := getBrokerUserPasswordsWO(d)
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	for i, user := range input.Users {
+		username := aws.ToString(user.Username)
+		if v, ok := passwordsWO[username]; ok && aws.ToString(user.Password) == "" {
+			input.Users[i].Password = aws.String(v)
+		}
+
+		if aws.ToString(input.Users[i].Password) == "" {
+			return sdkdiag.AppendErrorf(diags, "creating MQ Broker (%s): user (%s): one of password or user_passwords_wo must be configured", name, username)
+		}
+	}
+
 	if v, ok := d.GetOk("authentication_strategy"); ok {
 		input.AuthenticationStrategy = types.AuthenticationStrategy(v.(string))
 	}
@@ -576,13 +600,19 @@ func resourceBrokerUpdate(ctx context.Context, d *schema.ResourceData, meta any)
 		requiresReboot = true
 	}
 
-	if d.HasChange("user") {
+	if passwordsWOChanged := flex.HasWriteOnlyChange(d, "user_passwords"); d.HasChange("user") || passwordsWOChanged {
 		o, n := d.GetChange("user")
+		passwordsWO, di := getBrokerUserPasswordsWO(d)
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return diags
+		}
+
 		var err error
 		// d.HasChange("user") always reports a change when running resourceBrokerUpdate
 		// updateBrokerUsers needs to be called to know if changes to user are actually made
 		var usersUpdated bool
-		usersUpdated, err = updateBrokerUsers(ctx, conn, d.Id(), o.(*schema.Set).List(), n.(*schema.Set).List())
+		usersUpdated, err = updateBrokerUsers(ctx, conn, d.Id(), o.(*schema.Set).List(), n.(*schema.Set).List(), passwordsWO, passwordsWOChanged)
 
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating MQ Broker (%s) users: %s", d.Id(), err)
@@ -805,7 +835,10 @@ func resourceUserHash(v any) int {
 	return create.StringHashcode(buf.String())
 }
 
-func updateBrokerUsers(ctx context.Context, conn *mq.Client, id string, oldUsers, newUsers []any) (bool, error) {
+// updateBrokerUsers applies the differences between the old and new users.
+// Write-only passwords are used for users without a configured password when the users are created,
+// or for all such users when passwordsWOChanged is true.
+func updateBrokerUsers(ctx context.Context, conn *mq.Client, id string, oldUsers, newUsers []any, passwordsWO map[string]string, passwordsWOChanged bool) (bool, error) {
 	// If there are any user creates/deletes/updates, updatedUsers will be set to true
 	updatedUsers := false
 
@@ -814,6 +847,41 @@ func updateBrokerUsers(ctx context.Context, conn *mq.Client, id string, oldUsers
 		return updatedUsers, err
 	}
 
+	for _, c := range createL {
+		if v, ok := passwordsWO[aws.ToString(c.Username)]; ok && aws.ToString(c.Password) == "" {
+			c.Password = aws.String(v)
+		}
+	}
+	updatedUsernames := make(map[string]bool)
+	for _, u := range updateL {
+		username := aws.ToString(u.Username)
+		updatedUsernames[username] = true
+		if aws.ToString(u.Password) != "" {
+			continue
+		}
+		if v, ok := passwordsWO[username]; ok && passwordsWOChanged {
+			u.Password = aws.String(v)
+		} else {
+			u.Password = nil
+		}
+	}
+	if passwordsWOChanged {
+		for _, c := range createL {
+			updatedUsernames[aws.ToString(c.Username)] = true
+		}
+		for _, nu := range newUsers {
+			tfMap := nu.(map[string]any)
+			username := tfMap[names.AttrUsername].(string)
+			if v, ok := passwordsWO[username]; ok && tfMap[names.AttrPassword].(string) == "" && !updatedUsernames[username] {
+				updateL = append(updateL, &mq.UpdateUserInput{
+					BrokerId: aws.String(id),
+					Password: aws.String(v),
+					Username: aws.String(username),
+				})
+			}
+		}
+	}
+
 	for _, c := range createL {
 		_, err := conn.CreateUser(ctx, c)
 		updatedUsers = true
@@ -1006,6 +1074,58 @@ func ValidBrokerPassword(v any, k string) (ws []string, errors []error) {
 	return
 }
 
+// customizeDiffBrokerUserPasswords checks that each user's password is configured either
+// in the user's password argument or in user_passwords_wo, but not both.
+func customizeDiffBrokerUserPasswords(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+	rawConfig := diff.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+
+	users, passwordsWO := rawConfig.GetAttr("user"), rawConfig.GetAttr("user_passwords_wo")
+	if !users.IsWhollyKnown() || users.IsNull() || !passwordsWO.IsKnown() {
+		return nil
+	}
+
+	var passwords map[string]string
+	if !passwordsWO.IsNull() {
+		if err := tfjson.DecodeFromString(passwordsWO.AsString(), &passwords); err != nil {
+			return fmt.Errorf("user_passwords_wo: %w", err)
+		}
+	}
+
+	for it := users.ElementIterator(); it.Next(); {
+		_, user := it.Element()
+		username := user.GetAttr(names.AttrUsername).AsString()
+		password := user.GetAttr(names.AttrPassword)
+		_, hasPasswordWO := passwords[username]
+
+		switch hasPassword := !password.IsNull() && password.AsString() != ""; {
+		case hasPassword && hasPasswordWO:
+			return fmt.Errorf("user (%s): only one of password or user_passwords_wo can be configured", username)
+		case !hasPassword && !hasPasswordWO:
+			return fmt.Errorf("user (%s): one of password or user_passwords_wo must be configured", username)
+		}
+	}
+
+	return nil
+}
+
+// getBrokerUserPasswordsWO returns the write-only user passwords, a JSON object keyed by username, from the config.
+func getBrokerUserPasswordsWO(d *schema.ResourceData) (map[string]string, diag.Diagnostics) {
+	v, diags := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("user_passwords_wo"))
+	if diags.HasError() || v == "" {
+		return nil, diags
+	}
+
+	var passwords map[string]string
+	if err := tfjson.DecodeFromString(v, &passwords); err != nil {
+		return nil, sdkdiag.AppendErrorf(diags, "user_passwords_wo: %s", err)
+	}
+
+	return passwords, diags
+}
+
 func expandUsers(cfg []any) []types.User {
 	users := make([]types.User, len(cfg))
 	for i, m := range cfg {
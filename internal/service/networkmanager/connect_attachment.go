@@ -26,6 +26,7 @@ import (
 )
 
 // @SDKResource("aws_networkmanager_connect_attachment", name="Connect Attachment")
+// @Region(global=true)
 // @Tags(identifierAttribute="arn")
 func resourceConnectAttachment() *schema.Resource {
 	return &schema.Resource{
@@ -27,6 +27,7 @@ import (
 )
 
 // @SDKResource("aws_networkmanager_connect_peer", name="Connect Peer")
+// @Region(global=true)
 // @Tags(identifierAttribute="arn")
 func resourceConnectPeer() *schema.Resource {
 	return &schema.Resource{
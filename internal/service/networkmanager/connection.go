@@ -28,6 +28,7 @@ import (
 )
 
 // @SDKResource("aws_networkmanager_connection", name="Connection")
+// @Region(global=true)
 // @Tags(identifierAttribute="arn")
 func resourceConnection() *schema.Resource {
 	return &schema.Resource{
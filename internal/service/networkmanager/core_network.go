@@ -42,6 +42,7 @@ const (
 )
 
 // @SDKResource("aws_networkmanager_core_network", name="Core Network")
+// @Region(global=true)
 // @Tags(identifierAttribute="arn")
 func resourceCoreNetwork() *schema.Resource {
 	return &schema.Resource{
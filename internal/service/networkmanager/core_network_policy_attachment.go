@@ -22,6 +22,7 @@ import (
 )
 
 // @SDKResource("aws_networkmanager_core_network_policy_attachment", name="Core Network Policy Attachment")
+// @Region(global=true)
 func resourceCoreNetworkPolicyAttachment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceCoreNetworkPolicyAttachmentCreate,
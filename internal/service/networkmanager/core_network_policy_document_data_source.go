@@ -21,6 +21,7 @@ import (
 )
 
 // @SDKDataSource("aws_networkmanager_core_network_policy_document", name="Core Network Policy Document")
+// @Region(global=true)
 func dataSourceCoreNetworkPolicyDocument() *schema.Resource {
 	setOfString := &schema.Schema{
 		Type:     schema.TypeSet,
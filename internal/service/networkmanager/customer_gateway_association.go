@@ -25,6 +25,7 @@ import (
 )
 
 // @SDKResource("aws_networkmanager_customer_gateway_association", name="Customer Gateway Association")
+// @Region(global=true)
 func resourceCustomerGatewayAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceCustomerGatewayAssociationCreate,
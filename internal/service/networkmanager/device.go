@@ -29,6 +29,7 @@ import (
 )
 
 // @SDKResource("aws_networkmanager_device", name="Device")
+// @Region(global=true)
 // @Tags(identifierAttribute="arn")
 func resourceDevice() *schema.Resource {
 	return &schema.Resource{
@@ -33,6 +33,7 @@ import (
 )
 
 // @FrameworkResource("aws_networkmanager_dx_gateway_attachment", name="Direct Connect Gateway Attachment")
+// @Region(global=true)
 // @Tags(identifierAttribute="arn")
 func newDirectConnectGatewayAttachmentResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &directConnectGatewayAttachmentResource{}
@@ -25,6 +25,7 @@ import (
 )
 
 // @SDKResource("aws_networkmanager_global_network", name="Global Network")
+// @Region(global=true)
 // @Tags(identifierAttribute="arn")
 func resourceGlobalNetwork() *schema.Resource {
 	return &schema.Resource{
@@ -28,6 +28,7 @@ import (
 )
 
 // @SDKResource("aws_networkmanager_link", name="Link")
+// @Region(global=true)
 // @Tags(identifierAttribute="arn")
 func resourceLink() *schema.Resource {
 	return &schema.Resource{
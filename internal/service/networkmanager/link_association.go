@@ -24,6 +24,7 @@ import (
 )
 
 // @SDKResource("aws_networkmanager_link_association", name="Link Association")
+// @Region(global=true)
 func resourceLinkAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceLinkAssociationCreate,
@@ -28,6 +28,7 @@ import (
 )
 
 // @SDKResource("aws_networkmanager_site", name="Site")
+// @Region(global=true)
 // @Tags(identifierAttribute="arn")
 func resourceSite() *schema.Resource {
 	return &schema.Resource{
@@ -25,6 +25,7 @@ import (
 )
 
 // @SDKResource("aws_networkmanager_transit_gateway_connect_peer_association", name="Transit Gateway Connect Peer Association")
+// @Region(global=true)
 func resourceTransitGatewayConnectPeerAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceTransitGatewayConnectPeerAssociationCreate,
@@ -25,6 +25,7 @@ import (
 )
 
 // @SDKResource("aws_networkmanager_transit_gateway_peering", name="Transit Gateway Peering")
+// @Region(global=true)
 // @Tags(identifierAttribute="arn")
 func resourceTransitGatewayPeering() *schema.Resource {
 	return &schema.Resource{
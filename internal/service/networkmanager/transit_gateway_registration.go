@@ -26,6 +26,7 @@ import (
 )
 
 // @SDKResource("aws_networkmanager_transit_gateway_registration", name="Transit Gateway Registration")
+// @Region(global=true)
 func resourceTransitGatewayRegistration() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceTransitGatewayRegistrationCreate,
@@ -25,6 +25,7 @@ import (
 )
 
 // @SDKResource("aws_networkmanager_transit_gateway_route_table_attachment", name="Transit Gateway Route Table Attachment")
+// @Region(global=true)
 // @Tags(identifierAttribute="arn")
 func resourceTransitGatewayRouteTableAttachment() *schema.Resource {
 	return &schema.Resource{
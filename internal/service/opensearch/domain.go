@@ -16,6 +16,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/opensearch"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/opensearch/types"
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -550,6 +551,11 @@ func resourceDomain() *schema.Resource {
 					},
 				},
 			},
+			"master_user_password_wo": flex.WriteOnlyStringSchema("master_user_password", &schema.Schema{
+				ConflictsWith: []string{"advanced_security_options.0.master_user_options.0.master_user_password"},
+				RequiredWith:  []string{"advanced_security_options.0.master_user_options.0.master_user_name"},
+			}),
+			"master_user_password_wo_version": flex.WriteOnlyVersionSchema("master_user_password"),
 			"node_to_node_encryption": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -726,6 +732,16 @@ func resourceDomainCreate(ctx context.Context, d *schema.ResourceData, meta any)
 		input.AdvancedSecurityOptions = expandAdvancedSecurityOptions(v.([]any))
 	}
 
+	masterUserPasswordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("master_user_password_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if masterUserPasswordWO != "" {
+		setMasterUserPasswordWO(input.AdvancedSecurityOptions, masterUserPasswordWO)
+	}
+
 	if v, ok := d.GetOk("auto_tune_options"); ok && len(v.([]any)) > 0 {
 		input.AutoTuneOptions = expandAutoTuneOptionsInput(v.([]any)[0].(map[string]any))
 	}
@@ -1046,6 +1062,19 @@ func resourceDomainUpdate(ctx context.Context, d *schema.ResourceData, meta any)
 			input.AdvancedSecurityOptions = expandAdvancedSecurityOptions(d.Get("advanced_security_options").([]any))
 		}
 
+		if flex.HasWriteOnlyChange(d, "master_user_password") {
+			masterUserPasswordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("master_user_password_wo"))
+			diags = append(diags, di...)
+			if diags.HasError() {
+				return diags
+			}
+
+			if input.AdvancedSecurityOptions == nil {
+				input.AdvancedSecurityOptions = expandAdvancedSecurityOptions(d.Get("advanced_security_options").([]any))
+			}
+			setMasterUserPasswordWO(input.AdvancedSecurityOptions, masterUserPasswordWO)
+		}
+
 		if d.HasChange("auto_tune_options") {
 			input.AutoTuneOptions = expandAutoTuneOptions(d.Get("auto_tune_options").([]any)[0].(map[string]any))
 		}
@@ -54,6 +54,16 @@ func expandAdvancedSecurityOptions(m []any) *awstypes.AdvancedSecurityOptionsInp
 	return &config
 }
 
+// setMasterUserPasswordWO sets the write-only master user password in the advanced security options.
+// The write-only password is only used when the internal user database's master user is configured.
+func setMasterUserPasswordWO(apiObject *awstypes.AdvancedSecurityOptionsInput, password string) {
+	if apiObject == nil || apiObject.MasterUserOptions == nil {
+		return
+	}
+
+	apiObject.MasterUserOptions.MasterUserPassword = aws.String(password)
+}
+
 func expandAutoTuneOptions(tfMap map[string]any) *awstypes.AutoTuneOptions {
 	if tfMap == nil {
 		return nil
@@ -29,6 +29,7 @@ import (
 )
 
 // @SDKResource("aws_organizations_account", name="Account")
+// @Region(global=true)
 // @Tags(identifierAttribute="id")
 func resourceAccount() *schema.Resource {
 	return &schema.Resource{
@@ -26,6 +26,7 @@ import (
 )
 
 // @SDKResource("aws_organizations_delegated_administrator", name="Delegated Administrator")
+// @Region(global=true)
 func resourceDelegatedAdministrator() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDelegatedAdministratorCreate,
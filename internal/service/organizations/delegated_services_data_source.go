@@ -19,6 +19,7 @@ import (
 )
 
 // @SDKDataSource("aws_organizations_delegated_services", name="Delegated Services")
+// @Region(global=true)
 func dataSourceDelegatedServices() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceDelegatedServicesRead,
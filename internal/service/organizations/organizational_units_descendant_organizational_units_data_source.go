@@ -17,6 +17,7 @@ import (
 )
 
 // @SDKDataSource("aws_organizations_organizational_unit_descendant_organizational_units", name="Organizational Unit Descendant Organization Units")
+// @Region(global=true)
 func dataSourceOrganizationalUnitDescendantOrganizationalUnits() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceOrganizationalUnitDescendantOrganizationalUnitsRead,
@@ -18,6 +18,7 @@ import (
 )
 
 // @SDKDataSource("aws_organizations_policies", name="Policies")
+// @Region(global=true)
 func dataSourcePolicies() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourcePoliciesRead,
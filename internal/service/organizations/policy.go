@@ -26,6 +26,7 @@ import (
 )
 
 // @SDKResource("aws_organizations_policy", name="Policy")
+// @Region(global=true)
 // @Tags(identifierAttribute="id")
 func resourcePolicy() *schema.Resource {
 	return &schema.Resource{
@@ -24,6 +24,7 @@ import (
 )
 
 // @SDKResource("aws_organizations_policy_attachment", name="Policy Attachment")
+// @Region(global=true)
 func resourcePolicyAttachment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourcePolicyAttachmentCreate,
@@ -18,6 +18,7 @@ import (
 )
 
 // @SDKDataSource("aws_organizations_resource_tags", name="Resource Tags")
+// @Region(global=true)
 func dataSourceResourceTags() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceResourceTagsRead,
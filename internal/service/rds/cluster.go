@@ -1695,7 +1695,7 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta any
 			}
 		}
 
-		if d.HasChange("master_password_wo_version") {
+		if flex.HasWriteOnlyChange(d, "master_password") {
 			masterPasswordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("master_password_wo"))
 			diags = append(diags, di...)
 			if diags.HasError() {
@@ -2648,7 +2648,7 @@ func dbInstancePopulateModify(input *rds.ModifyDBInstanceInput, d *schema.Resour
 		}
 	}
 
-	if d.HasChange("password_wo_version") {
+	if flex.HasWriteOnlyChange(d, names.AttrPassword) {
 		passwordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("password_wo"))
 		diags = append(diags, di...)
 		if diags.HasError() {
@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/metadata"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/sdk"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -457,6 +458,14 @@ func (s instanceAutomatedBackupSweeper) Delete(ctx context.Context, optFns ...tf
 	return nil
 }
 
+func (s instanceAutomatedBackupSweeper) Metadata(ctx context.Context) metadata.Resource {
+	if v, ok := s.sweepable.(sweep.Describable); ok {
+		return v.Metadata(ctx)
+	}
+
+	return metadata.Resource{ID: s.backupARN}
+}
+
 func sweepShardGroups(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.RDSClient(ctx)
 	var input rds.DescribeDBShardGroupsInput
@@ -534,3 +543,7 @@ func (s blueGreenDeploymentSweeper) Delete(ctx context.Context, optFns ...tfreso
 
 	return nil
 }
+
+func (s blueGreenDeploymentSweeper) Metadata(context.Context) metadata.Resource {
+	return metadata.Resource{ID: s.id}
+}
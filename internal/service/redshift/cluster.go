@@ -838,7 +838,7 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta any
 			input.MasterUserPassword = aws.String(d.Get("master_password").(string))
 		}
 
-		if d.HasChange("master_password_wo_version") {
+		if flex.HasWriteOnlyChange(d, "master_password") {
 			masterPasswordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("master_password_wo"))
 			diags = append(diags, di...)
 			if diags.HasError() {
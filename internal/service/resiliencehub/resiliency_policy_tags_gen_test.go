@@ -24,7 +24,7 @@ func TestAccResilienceHubResiliencyPolicy_tags(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ResilienceHub) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.ResilienceHubServiceID),
 		CheckDestroy:             testAccCheckResiliencyPolicyDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -214,7 +214,7 @@ func TestAccResilienceHubResiliencyPolicy_tags_null(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ResilienceHub) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.ResilienceHubServiceID),
 		CheckDestroy:             testAccCheckResiliencyPolicyDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -278,7 +278,7 @@ func TestAccResilienceHubResiliencyPolicy_tags_EmptyMap(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ResilienceHub) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.ResilienceHubServiceID),
 		CheckDestroy:             testAccCheckResiliencyPolicyDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -330,7 +330,7 @@ func TestAccResilienceHubResiliencyPolicy_tags_AddOnUpdate(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ResilienceHub) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.ResilienceHubServiceID),
 		CheckDestroy:             testAccCheckResiliencyPolicyDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -412,7 +412,7 @@ func TestAccResilienceHubResiliencyPolicy_tags_EmptyTag_OnCreate(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ResilienceHub) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.ResilienceHubServiceID),
 		CheckDestroy:             testAccCheckResiliencyPolicyDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -506,7 +506,7 @@ func TestAccResilienceHubResiliencyPolicy_tags_EmptyTag_OnUpdate_Add(t *testing.
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ResilienceHub) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.ResilienceHubServiceID),
 		CheckDestroy:             testAccCheckResiliencyPolicyDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -649,7 +649,7 @@ func TestAccResilienceHubResiliencyPolicy_tags_EmptyTag_OnUpdate_Replace(t *test
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ResilienceHub) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.ResilienceHubServiceID),
 		CheckDestroy:             testAccCheckResiliencyPolicyDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -741,7 +741,7 @@ func TestAccResilienceHubResiliencyPolicy_tags_DefaultTags_providerOnly(t *testi
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ResilienceHub) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.ResilienceHubServiceID),
 		CheckDestroy: testAccCheckResiliencyPolicyDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -930,7 +930,7 @@ func TestAccResilienceHubResiliencyPolicy_tags_DefaultTags_nonOverlapping(t *tes
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ResilienceHub) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.ResilienceHubServiceID),
 		CheckDestroy: testAccCheckResiliencyPolicyDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1096,7 +1096,7 @@ func TestAccResilienceHubResiliencyPolicy_tags_DefaultTags_overlapping(t *testin
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ResilienceHub) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.ResilienceHubServiceID),
 		CheckDestroy: testAccCheckResiliencyPolicyDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1278,7 +1278,7 @@ func TestAccResilienceHubResiliencyPolicy_tags_DefaultTags_updateToProviderOnly(
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ResilienceHub) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.ResilienceHubServiceID),
 		CheckDestroy: testAccCheckResiliencyPolicyDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1370,7 +1370,7 @@ func TestAccResilienceHubResiliencyPolicy_tags_DefaultTags_updateToResourceOnly(
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ResilienceHub) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.ResilienceHubServiceID),
 		CheckDestroy: testAccCheckResiliencyPolicyDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1461,7 +1461,7 @@ func TestAccResilienceHubResiliencyPolicy_tags_DefaultTags_emptyResourceTag(t *t
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ResilienceHub) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.ResilienceHubServiceID),
 		CheckDestroy: testAccCheckResiliencyPolicyDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1529,7 +1529,7 @@ func TestAccResilienceHubResiliencyPolicy_tags_DefaultTags_emptyProviderOnlyTag(
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ResilienceHub) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.ResilienceHubServiceID),
 		CheckDestroy: testAccCheckResiliencyPolicyDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1589,7 +1589,7 @@ func TestAccResilienceHubResiliencyPolicy_tags_DefaultTags_nullOverlappingResour
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ResilienceHub) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.ResilienceHubServiceID),
 		CheckDestroy: testAccCheckResiliencyPolicyDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1660,7 +1660,7 @@ func TestAccResilienceHubResiliencyPolicy_tags_DefaultTags_nullNonOverlappingRes
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ResilienceHub) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.ResilienceHubServiceID),
 		CheckDestroy: testAccCheckResiliencyPolicyDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1733,7 +1733,7 @@ func TestAccResilienceHubResiliencyPolicy_tags_ComputedTag_OnCreate(t *testing.T
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ResilienceHub) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.ResilienceHubServiceID),
 		CheckDestroy: testAccCheckResiliencyPolicyDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1790,7 +1790,7 @@ func TestAccResilienceHubResiliencyPolicy_tags_ComputedTag_OnUpdate_Add(t *testi
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ResilienceHub) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.ResilienceHubServiceID),
 		CheckDestroy: testAccCheckResiliencyPolicyDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1889,7 +1889,7 @@ func TestAccResilienceHubResiliencyPolicy_tags_ComputedTag_OnUpdate_Replace(t *t
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ResilienceHub) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.ResilienceHubServiceID),
 		CheckDestroy: testAccCheckResiliencyPolicyDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1978,7 +1978,7 @@ func TestAccResilienceHubResiliencyPolicy_tags_IgnoreTags_Overlap_DefaultTag(t *
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ResilienceHub) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.ResilienceHubServiceID),
 		CheckDestroy: testAccCheckResiliencyPolicyDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -2140,7 +2140,7 @@ func TestAccResilienceHubResiliencyPolicy_tags_IgnoreTags_Overlap_ResourceTag(t
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.ResilienceHub) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.ResilienceHubServiceID),
 		CheckDestroy: testAccCheckResiliencyPolicyDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroupstaggingapi
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_resources_by_tag", name="Resources By Tag")
+func newResourcesByTagDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &resourcesByTagDataSource{}, nil
+}
+
+type resourcesByTagDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *resourcesByTagDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"resource_type_prefixes": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			names.AttrResources: schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[resourceByTagModel](ctx),
+				Computed:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"tag_filter": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[tagFilterModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(50),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrKey: schema.StringAttribute{
+							Required: true,
+						},
+						names.AttrValues: schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtMost(20),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *resourcesByTagDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data resourcesByTagDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().ResourceGroupsTaggingAPIClient(ctx)
+
+	var input resourcegroupstaggingapi.GetResourcesInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	prefixes := fwflex.ExpandFrameworkStringValueSet(ctx, data.ResourceTypePrefixes)
+	input.ResourceTypeFilters = resourceTypeFilters(prefixes)
+
+	output, err := findResources(ctx, conn, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading Resource Groups Tagging API Resources", err.Error())
+
+		return
+	}
+
+	ignoreTagsConfig := d.Meta().IgnoreTagsConfig(ctx)
+	var resources []resourceByTagModel
+	for _, v := range output {
+		resourceARN := aws.ToString(v.ResourceARN)
+		parts, err := tffunction.ParseARN(resourceARN)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("parsing ARN (%s)", resourceARN), err.Error())
+
+			return
+		}
+
+		if !matchesResourceTypePrefixes(parts, prefixes) {
+			continue
+		}
+
+		resources = append(resources, resourceByTagModel{
+			AccountID:    fwflex.StringValueToFramework(ctx, parts.AccountID),
+			ARN:          fwflex.StringValueToFramework(ctx, resourceARN),
+			Partition:    fwflex.StringValueToFramework(ctx, parts.Partition),
+			Region:       fwflex.StringValueToFramework(ctx, parts.Region),
+			ResourceID:   fwflex.StringValueToFramework(ctx, parts.ResourceID),
+			ResourceType: fwflex.StringValueToFramework(ctx, parts.ResourceType),
+			Service:      fwflex.StringValueToFramework(ctx, parts.Service),
+			Tags:         tftags.FlattenStringValueMap(ctx, KeyValueTags(ctx, v.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()),
+		})
+	}
+
+	data.ID = fwflex.StringValueToFramework(ctx, d.Meta().Region(ctx))
+	data.Resources = fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, resources)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// resourceTypeFilters returns the GetResources resource type filters that narrow the results to a superset of the resource type prefixes.
+// A prefix that includes a resource type (e.g. "ec2:instance") narrows the results to its service.
+// A prefix that is only part of a service name (e.g. "ec2") matches many services, so no filter is used.
+func resourceTypeFilters(prefixes []string) []string {
+	var filters []string
+
+	for _, prefix := range prefixes {
+		service, _, ok := strings.Cut(prefix, ":")
+		if !ok {
+			return nil
+		}
+
+		if !slices.Contains(filters, service) {
+			filters = append(filters, service)
+		}
+	}
+
+	return filters
+}
+
+// matchesResourceTypePrefixes returns whether the resource's "service:resource-type" matches any of the prefixes.
+// No prefixes match all resources.
+func matchesResourceTypePrefixes(parts tffunction.ARNParts, prefixes []string) bool {
+	if len(prefixes) == 0 {
+		return true
+	}
+
+	resourceType := parts.Service + ":" + parts.ResourceType
+
+	return slices.ContainsFunc(prefixes, func(prefix string) bool {
+		return strings.HasPrefix(resourceType, prefix)
+	})
+}
+
+func findResources(ctx context.Context, conn *resourcegroupstaggingapi.Client, input *resourcegroupstaggingapi.GetResourcesInput) ([]awstypes.ResourceTagMapping, error) {
+	var output []awstypes.ResourceTagMapping
+
+	pages := resourcegroupstaggingapi.NewGetResourcesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ResourceTagMappingList...)
+	}
+
+	return output, nil
+}
+
+type resourcesByTagDataSourceModel struct {
+	ID                   types.String                                        `tfsdk:"id"`
+	ResourceTypePrefixes fwtypes.SetValueOf[types.String]                    `tfsdk:"resource_type_prefixes"`
+	Resources            fwtypes.ListNestedObjectValueOf[resourceByTagModel] `tfsdk:"resources"`
+	TagFilters           fwtypes.ListNestedObjectValueOf[tagFilterModel]     `tfsdk:"tag_filter"`
+}
+
+type resourceByTagModel struct {
+	AccountID    types.String `tfsdk:"account_id"`
+	ARN          types.String `tfsdk:"arn"`
+	Partition    types.String `tfsdk:"partition"`
+	Region       types.String `tfsdk:"region"`
+	ResourceID   types.String `tfsdk:"resource_id"`
+	ResourceType types.String `tfsdk:"resource_type"`
+	Service      types.String `tfsdk:"service"`
+	Tags         tftags.Map   `tfsdk:"tags"`
+}
+
+type tagFilterModel struct {
+	Key    types.String                     `tfsdk:"key"`
+	Values fwtypes.SetValueOf[types.String] `tfsdk:"values"`
+}
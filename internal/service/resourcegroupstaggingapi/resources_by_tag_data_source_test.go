@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroupstaggingapi_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
+	tfresourcegroupstaggingapi "github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroupstaggingapi"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestResourceTypeFilters(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName string
+		Prefixes []string
+		Expected []string
+	}{
+		{
+			TestName: "no prefixes",
+		},
+		{
+			TestName: "resource types",
+			Prefixes: []string{"ec2:instance", "ec2:vpc", "rds:"},
+			Expected: []string{"ec2", "rds"},
+		},
+		{
+			TestName: "partial service name",
+			Prefixes: []string{"ec2:instance", "ec"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfresourcegroupstaggingapi.ResourceTypeFilters(testCase.Prefixes), testCase.Expected; !slices.Equal(got, want) {
+				t.Errorf("ResourceTypeFilters(%v) = %v, want %v", testCase.Prefixes, got, want)
+			}
+		})
+	}
+}
+
+func TestMatchesResourceTypePrefixes(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName string
+		ARN      string
+		Prefixes []string
+		Expected bool
+	}{
+		{
+			TestName: "no prefixes",
+			ARN:      "arn:aws:ec2:us-west-2:123456789012:vpc/vpc-12345678", //lintignore:AWSAT003,AWSAT005
+			Expected: true,
+		},
+		{
+			TestName: "resource type",
+			ARN:      "arn:aws:ec2:us-west-2:123456789012:vpc/vpc-12345678", //lintignore:AWSAT003,AWSAT005
+			Prefixes: []string{"ec2:instance", "ec2:vpc"},
+			Expected: true,
+		},
+		{
+			TestName: "other resource type",
+			ARN:      "arn:aws:ec2:us-west-2:123456789012:vpc/vpc-12345678", //lintignore:AWSAT003,AWSAT005
+			Prefixes: []string{"ec2:instance"},
+		},
+		{
+			TestName: "service",
+			ARN:      "arn:aws:ecs:us-west-2:123456789012:cluster/example", //lintignore:AWSAT003,AWSAT005
+			Prefixes: []string{"ec"},
+			Expected: true,
+		},
+		{
+			TestName: "no resource type",
+			ARN:      "arn:aws:s3:::example", //lintignore:AWSAT005
+			Prefixes: []string{"s3:"},
+			Expected: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			parts, err := tffunction.ParseARN(testCase.ARN)
+			if err != nil {
+				t.Fatalf("parsing ARN: %s", err)
+			}
+
+			if got, want := tfresourcegroupstaggingapi.MatchesResourceTypePrefixes(parts, testCase.Prefixes), testCase.Expected; got != want {
+				t.Errorf("MatchesResourceTypePrefixes(%s, %v) = %t, want %t", testCase.ARN, testCase.Prefixes, got, want)
+			}
+		})
+	}
+}
+
+func TestAccResourceGroupsTaggingAPIResourcesByTagDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_resources_by_tag.test"
+	resourceName := "aws_vpc.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceGroupsTaggingAPIServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcesByTagDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resources.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "resources.0.account_id", resourceName, names.AttrOwnerID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "resources.0.arn", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "resources.0.resource_id", resourceName, names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.resource_type", "vpc"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.service", "ec2"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.tags.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.tags.Key", rName),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourcesByTagDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Key = %[1]q
+  }
+}
+
+data "aws_resources_by_tag" "test" {
+  resource_type_prefixes = ["ec2:vpc"]
+
+  tag_filter {
+    key    = "Key"
+    values = [aws_vpc.test.tags["Key"]]
+  }
+}
+`, rName)
+}
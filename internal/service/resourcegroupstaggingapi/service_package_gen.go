@@ -15,7 +15,18 @@ import (
 type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
-	return []*types.ServicePackageFrameworkDataSource{}
+	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory:  newResourcesByTagDataSource,
+			TypeName: "aws_resources_by_tag",
+			Name:     "Resources By Tag",
+		},
+		{
+			Factory:  newTagsDataSource,
+			TypeName: "aws_tags",
+			Name:     "Tags",
+		},
+	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
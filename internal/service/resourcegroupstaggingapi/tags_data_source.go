@@ -0,0 +1,222 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroupstaggingapi
+
+import (
+	"context"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// tagValuesMaxKeys is the maximum number of tag keys whose values are listed one key at a time.
+	tagValuesMaxKeys = 10
+)
+
+// @FrameworkDataSource("aws_tags", name="Tags")
+func newTagsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &tagsDataSource{}, nil
+}
+
+type tagsDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *tagsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"keys": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"tag": schema.ListNestedAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[tagKeyValuesModel](ctx),
+				Computed:   true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrKey: schema.StringAttribute{
+							Computed: true,
+						},
+						names.AttrValues: schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *tagsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data tagsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().ResourceGroupsTaggingAPIClient(ctx)
+
+	var keys []string
+	if data.Keys.IsNull() {
+		output, err := findTagKeys(ctx, conn, &resourcegroupstaggingapi.GetTagKeysInput{})
+
+		if err != nil {
+			response.Diagnostics.AddError("reading Resource Groups Tagging API Tag Keys", err.Error())
+
+			return
+		}
+
+		keys = output
+	} else {
+		keys = fwflex.ExpandFrameworkStringValueSet(ctx, data.Keys)
+	}
+
+	// Remove AWS and any provider configured ignore_tags keys.
+	keys = tftags.New(ctx, keys).IgnoreAWS().IgnoreConfig(d.Meta().IgnoreTagsConfig(ctx)).Keys()
+	slices.Sort(keys)
+
+	var valuesByKey map[string][]string
+	if len(keys) > tagValuesMaxKeys {
+		// Rather than listing each key's values separately, collect all keys' values in a single pass over the tagged resources.
+		var err error
+		valuesByKey, err = findTagValuesByKey(ctx, conn, func(key string) bool {
+			_, ok := slices.BinarySearch(keys, key)
+			return ok
+		})
+
+		if err != nil {
+			response.Diagnostics.AddError("reading Resource Groups Tagging API Resources", err.Error())
+
+			return
+		}
+	}
+
+	var tags []tagKeyValuesModel
+	for _, key := range keys {
+		// GetResources doesn't return every resource type that GetTagKeys does,
+		// so the values of any key not seen in the single pass are listed separately.
+		values, ok := valuesByKey[key]
+		if !ok {
+			input := resourcegroupstaggingapi.GetTagValuesInput{
+				Key: aws.String(key),
+			}
+			var err error
+			values, err = findTagValues(ctx, conn, &input)
+
+			if err != nil {
+				response.Diagnostics.AddError("reading Resource Groups Tagging API Tag Values ("+key+")", err.Error())
+
+				return
+			}
+		}
+
+		if len(values) == 0 {
+			continue
+		}
+
+		tags = append(tags, tagKeyValuesModel{
+			Key:    fwflex.StringValueToFramework(ctx, key),
+			Values: fwtypes.SetValueOf[types.String]{SetValue: fwflex.FlattenFrameworkStringValueSet(ctx, values)},
+		})
+	}
+
+	data.ID = fwflex.StringValueToFramework(ctx, d.Meta().Region(ctx))
+	data.Tags = fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findTagKeys(ctx context.Context, conn *resourcegroupstaggingapi.Client, input *resourcegroupstaggingapi.GetTagKeysInput) ([]string, error) {
+	var output []string
+
+	pages := resourcegroupstaggingapi.NewGetTagKeysPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.TagKeys...)
+	}
+
+	return output, nil
+}
+
+// findTagValuesByKey returns the distinct values of each filtered tag key used by any resource returned by GetResources.
+func findTagValuesByKey(ctx context.Context, conn *resourcegroupstaggingapi.Client, filter func(string) bool) (map[string][]string, error) {
+	output := make(map[string][]string)
+	seen := make(map[string]struct{})
+
+	input := resourcegroupstaggingapi.GetResourcesInput{
+		ResourcesPerPage: aws.Int32(100),
+	}
+	pages := resourcegroupstaggingapi.NewGetResourcesPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.ResourceTagMappingList {
+			for _, v := range v.Tags {
+				key, value := aws.ToString(v.Key), aws.ToString(v.Value)
+
+				if !filter(key) {
+					continue
+				}
+
+				if _, ok := seen[key+"\x00"+value]; !ok {
+					seen[key+"\x00"+value] = struct{}{}
+					output[key] = append(output[key], value)
+				}
+			}
+		}
+	}
+
+	return output, nil
+}
+
+func findTagValues(ctx context.Context, conn *resourcegroupstaggingapi.Client, input *resourcegroupstaggingapi.GetTagValuesInput) ([]string, error) {
+	var output []string
+
+	pages := resourcegroupstaggingapi.NewGetTagValuesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.TagValues...)
+	}
+
+	return output, nil
+}
+
+type tagsDataSourceModel struct {
+	ID   types.String                                       `tfsdk:"id"`
+	Keys fwtypes.SetValueOf[types.String]                   `tfsdk:"keys"`
+	Tags fwtypes.ListNestedObjectValueOf[tagKeyValuesModel] `tfsdk:"tag"`
+}
+
+type tagKeyValuesModel struct {
+	Key    types.String                     `tfsdk:"key"`
+	Values fwtypes.SetValueOf[types.String] `tfsdk:"values"`
+}
@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroupstaggingapi_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccResourceGroupsTaggingAPITagsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_tags.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceGroupsTaggingAPIServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tag.0.key", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "tag.0.values.#", "2"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "tag.0.values.*", "value1"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "tag.0.values.*", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceGroupsTaggingAPITagsDataSource_manyKeys(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_tags.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceGroupsTaggingAPIServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagsDataSourceConfig_manyKeys(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tag.0.key", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "tag.0.values.#", "2"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "tag.0.values.*", "value1"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "tag.0.values.*", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTagsDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  count = 2
+
+  cidr_block = "10.${count.index}.0.0/16"
+
+  tags = {
+    %[1]q = "value${count.index + 1}"
+  }
+}
+
+data "aws_tags" "test" {
+  keys = [keys(aws_vpc.test[0].tags)[0]]
+
+  depends_on = [aws_vpc.test]
+}
+`, rName)
+}
+
+func testAccTagsDataSourceConfig_manyKeys(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  count = 2
+
+  cidr_block = "10.${count.index}.0.0/16"
+
+  tags = {
+    %[1]q = "value${count.index + 1}"
+  }
+}
+
+# More keys than are looked up one at a time.
+data "aws_tags" "test" {
+  keys = concat([keys(aws_vpc.test[0].tags)[0]], [for i in range(11) : "%[1]s-missing-${i}"])
+
+  depends_on = [aws_vpc.test]
+}
+`, rName)
+}
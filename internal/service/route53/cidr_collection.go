@@ -31,6 +31,7 @@ import (
 )
 
 // @FrameworkResource("aws_route53_cidr_collection", name="CIDR Collection")
+// @Region(global=true)
 func newCIDRCollectionResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &cidrCollectionResource{}
 
@@ -33,6 +33,7 @@ import (
 )
 
 // @FrameworkResource("aws_route53_cidr_location", name="CIDR Location")
+// @Region(global=true)
 func newCIDRLocationResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &cidrLocationResource{}
 
@@ -24,6 +24,7 @@ import (
 )
 
 // @SDKResource("aws_route53_delegation_set", name="Reusable Delegation Set")
+// @Region(global=true)
 func resourceDelegationSet() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDelegationSetCreate,
@@ -30,6 +30,7 @@ import (
 )
 
 // @SDKResource("aws_route53_health_check", name="Health Check")
+// @Region(global=true)
 // @Tags(identifierAttribute="id", resourceType="healthcheck")
 func resourceHealthCheck() *schema.Resource {
 	return &schema.Resource{
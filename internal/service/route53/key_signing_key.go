@@ -40,6 +40,7 @@ const (
 )
 
 // @SDKResource("aws_route53_key_signing_key", name="Key Signing Key")
+// @Region(global=true)
 func resourceKeySigningKey() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceKeySigningKeyCreate,
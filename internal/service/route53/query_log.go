@@ -22,6 +22,7 @@ import (
 )
 
 // @SDKResource("aws_route53_query_log", name="Query Logging Config")
+// @Region(global=true)
 func resourceQueryLog() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceQueryLogCreate,
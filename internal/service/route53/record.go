@@ -31,6 +31,7 @@ import (
 )
 
 // @SDKResource("aws_route53_record", name="Record")
+// @Region(global=true)
 func resourceRecord() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
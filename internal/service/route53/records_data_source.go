@@ -20,6 +20,7 @@ import (
 )
 
 // @FrameworkDataSource("aws_route53_records", name="Records")
+// @Region(global=true)
 func newRecordsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
 	return &recordsDataSource{}, nil
 }
@@ -35,6 +35,7 @@ import (
 )
 
 // @FrameworkResource("aws_route53_records_exclusive", name="Records Exclusive")
+// @Region(global=true)
 func newResourceRecordsExclusive(_ context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourceRecordsExclusive{}
 
@@ -26,6 +26,7 @@ import (
 )
 
 // @SDKResource("aws_route53_traffic_policy", name="Traffic Policy")
+// @Region(global=true)
 func resourceTrafficPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceTrafficPolicyCreate,
@@ -25,6 +25,7 @@ import (
 )
 
 // @SDKResource("aws_route53_vpc_association_authorization", name="VPC Association Authorization")
+// @Region(global=true)
 func resourceVPCAssociationAuthorization() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceVPCAssociationAuthorizationCreate,
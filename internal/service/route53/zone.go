@@ -31,6 +31,7 @@ import (
 )
 
 // @SDKResource("aws_route53_zone", name="Hosted Zone")
+// @Region(global=true)
 // @Tags(identifierAttribute="zone_id", resourceType="hostedzone")
 func resourceZone() *schema.Resource {
 	return &schema.Resource{
@@ -27,6 +27,7 @@ import (
 )
 
 // @SDKResource("aws_route53_zone_association", name="Zone Association")
+// @Region(global=true)
 func resourceZoneAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceZoneAssociationCreate,
@@ -21,6 +21,7 @@ import (
 )
 
 // @SDKDataSource("aws_route53_zone", name="Hosted Zone")
+// @Region(global=true)
 func dataSourceZone() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceZoneRead,
@@ -19,6 +19,7 @@ import (
 )
 
 // @FrameworkDataSource("aws_route53_zones", name="Zones")
+// @Region(global=true)
 func newZonesDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
 	return &zonesDataSource{}, nil
 }
@@ -33,6 +33,7 @@ import (
 )
 
 // @FrameworkResource("aws_route53domains_delegation_signer_record", name="Delegation Signer Record")
+// @Region(global=true)
 func newDelegationSignerRecordResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &delegationSignerRecordResource{}
 
@@ -39,6 +39,7 @@ import (
 )
 
 // @FrameworkResource("aws_route53domains_domain", name="Domain")
+// @Region(global=true)
 // @Tags(identifierAttribute="domain_name")
 func newDomainResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &domainResource{}
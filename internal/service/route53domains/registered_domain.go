@@ -30,6 +30,7 @@ import (
 )
 
 // @SDKResource("aws_route53domains_registered_domain", name="Registered Domain")
+// @Region(global=true)
 // @Tags(identifierAttribute="id")
 func resourceRegisteredDomain() *schema.Resource {
 	return &schema.Resource{
@@ -22,6 +22,7 @@ import (
 )
 
 // @SDKResource("aws_route53recoverycontrolconfig_cluster", name="Cluster")
+// @Region(global=true)
 func resourceCluster() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceClusterCreate,
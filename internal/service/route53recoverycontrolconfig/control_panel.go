@@ -22,6 +22,7 @@ import (
 )
 
 // @SDKResource("aws_route53recoverycontrolconfig_control_panel", name="Control Panel")
+// @Region(global=true)
 func resourceControlPanel() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceControlPanelCreate,
@@ -22,6 +22,7 @@ import (
 )
 
 // @SDKResource("aws_route53recoverycontrolconfig_routing_control", name="Routing Control")
+// @Region(global=true)
 func resourceRoutingControl() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRoutingControlCreate,
@@ -24,6 +24,7 @@ import (
 )
 
 // @SDKResource("aws_route53recoverycontrolconfig_safety_rule", name="Safety Rule")
+// @Region(global=true)
 func resourceSafetyRule() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceSafetyRuleCreate,
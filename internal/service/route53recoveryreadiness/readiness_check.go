@@ -24,6 +24,7 @@ import (
 )
 
 // @SDKResource("aws_route53recoveryreadiness_readiness_check", name="Readiness Check")
+// @Region(global=true)
 // @Tags(identifierAttribute="arn")
 func resourceReadinessCheck() *schema.Resource {
 	return &schema.Resource{
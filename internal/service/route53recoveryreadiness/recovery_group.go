@@ -25,6 +25,7 @@ import (
 )
 
 // @SDKResource("aws_route53recoveryreadiness_recovery_group", name="Recovery Group")
+// @Region(global=true)
 // @Tags(identifierAttribute="arn")
 func resourceRecoveryGroup() *schema.Resource {
 	return &schema.Resource{
@@ -21,7 +21,10 @@ func TestAccServiceCatalogAppRegistryApplicationDataSource_tags(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckLocalEndpoint(ctx, t, names.ServiceCatalogAppRegistry)
+		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceCatalogAppRegistryServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
 		Steps: []resource.TestStep{
@@ -49,7 +52,10 @@ func TestAccServiceCatalogAppRegistryApplicationDataSource_tags_NullMap(t *testi
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckLocalEndpoint(ctx, t, names.ServiceCatalogAppRegistry)
+		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceCatalogAppRegistryServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
 		Steps: []resource.TestStep{
@@ -73,7 +79,10 @@ func TestAccServiceCatalogAppRegistryApplicationDataSource_tags_EmptyMap(t *test
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckLocalEndpoint(ctx, t, names.ServiceCatalogAppRegistry)
+		},
 		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceCatalogAppRegistryServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
 		Steps: []resource.TestStep{
@@ -97,7 +106,10 @@ func TestAccServiceCatalogAppRegistryApplicationDataSource_tags_DefaultTags_nonO
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckLocalEndpoint(ctx, t, names.ServiceCatalogAppRegistry)
+		},
 		ErrorCheck: acctest.ErrorCheck(t, names.ServiceCatalogAppRegistryServiceID),
 		Steps: []resource.TestStep{
 			{
@@ -129,7 +141,10 @@ func TestAccServiceCatalogAppRegistryApplicationDataSource_tags_IgnoreTags_Overl
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckLocalEndpoint(ctx, t, names.ServiceCatalogAppRegistry)
+		},
 		ErrorCheck: acctest.ErrorCheck(t, names.ServiceCatalogAppRegistryServiceID),
 		Steps: []resource.TestStep{
 			{
@@ -167,7 +182,10 @@ func TestAccServiceCatalogAppRegistryApplicationDataSource_tags_IgnoreTags_Overl
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckLocalEndpoint(ctx, t, names.ServiceCatalogAppRegistry)
+		},
 		ErrorCheck: acctest.ErrorCheck(t, names.ServiceCatalogAppRegistryServiceID),
 		Steps: []resource.TestStep{
 			{
@@ -4,6 +4,7 @@
 package ses
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -12,36 +13,39 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ses/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/metadata"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_ses_configuration_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ses_configuration_set", &sweep.Sweeper{
 		Name: "aws_ses_configuration_set",
 		F:    sweepConfigurationSets,
 	})
 
-	resource.AddTestSweepers("aws_ses_domain_identity", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ses_domain_identity", &sweep.Sweeper{
 		Name: "aws_ses_domain_identity",
-		F:    func(region string) error { return sweepIdentities(region, string(awstypes.IdentityTypeDomain)) },
+		F: func(ctx context.Context, region string) error {
+			return sweepIdentities(ctx, region, string(awstypes.IdentityTypeDomain))
+		},
 	})
 
-	resource.AddTestSweepers("aws_ses_email_identity", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ses_email_identity", &sweep.Sweeper{
 		Name: "aws_ses_email_identity",
-		F:    func(region string) error { return sweepIdentities(region, string(awstypes.IdentityTypeEmailAddress)) },
+		F: func(ctx context.Context, region string) error {
+			return sweepIdentities(ctx, region, string(awstypes.IdentityTypeEmailAddress))
+		},
 	})
 
-	resource.AddTestSweepers("aws_ses_receipt_rule_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ses_receipt_rule_set", &sweep.Sweeper{
 		Name: "aws_ses_receipt_rule_set",
 		F:    sweepReceiptRuleSets,
 	})
 }
 
-func sweepConfigurationSets(region string) error {
-	ctx := sweep.Context(region)
+func sweepConfigurationSets(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -64,6 +68,10 @@ func sweepConfigurationSets(region string) error {
 		for _, configurationSet := range output.ConfigurationSets {
 			name := aws.ToString(configurationSet.Name)
 
+			if !sweep.ShouldDelete(ctx, metadata.Resource{ID: name, Name: name, TypeName: "aws_ses_configuration_set"}) {
+				continue
+			}
+
 			log.Printf("[INFO] Deleting SES Configuration Set: %s", name)
 			_, err := conn.DeleteConfigurationSet(ctx, &ses.DeleteConfigurationSetInput{
 				ConfigurationSetName: aws.String(name),
@@ -88,8 +96,7 @@ func sweepConfigurationSets(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepIdentities(region, identityType string) error {
-	ctx := sweep.Context(region)
+func sweepIdentities(ctx context.Context, region, identityType string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -109,6 +116,10 @@ func sweepIdentities(region, identityType string) error {
 		}
 
 		for _, identity := range output.Identities {
+			if !sweep.ShouldDelete(ctx, metadata.Resource{ID: identity, Name: identity, TypeName: identityTypeName(identityType)}) {
+				continue
+			}
+
 			log.Printf("[INFO] Deleting SES Identity: %s", identity)
 			_, err = conn.DeleteIdentity(ctx, &ses.DeleteIdentityInput{
 				Identity: aws.String(identity),
@@ -133,25 +144,25 @@ func sweepIdentities(region, identityType string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepReceiptRuleSets(region string) error {
-	ctx := sweep.Context(region)
+func sweepReceiptRuleSets(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
 	}
 	conn := client.SESClient(ctx)
 
-	// You cannot delete the receipt rule set that is currently active.
-	// Setting the name of the active receipt rule set to null disables all email receiving.
-	log.Printf("[INFO] Disabling any currently active SES Receipt Rule Set")
-	_, err = conn.SetActiveReceiptRuleSet(ctx, &ses.SetActiveReceiptRuleSetInput{})
+	active, err := conn.DescribeActiveReceiptRuleSet(ctx, &ses.DescribeActiveReceiptRuleSetInput{})
 	// In some regions, this will return "InvalidAction" with no message
 	if awsv2.SkipSweepError(err) || tfawserr.ErrCodeEquals(err, "InvalidAction") {
 		log.Printf("[WARN] Skipping SES Receipt Rule Sets sweep for %s: %s", region, err)
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("disabling any currently active SES Receipt Rule Set: %w", err)
+		return fmt.Errorf("reading currently active SES Receipt Rule Set: %w", err)
+	}
+	var activeName string
+	if active.Metadata != nil {
+		activeName = aws.ToString(active.Metadata.Name)
 	}
 
 	input := &ses.ListReceiptRuleSetsInput{}
@@ -171,6 +182,20 @@ func sweepReceiptRuleSets(region string) error {
 		for _, ruleSet := range output.RuleSets {
 			name := aws.ToString(ruleSet.Name)
 
+			if !sweep.ShouldDelete(ctx, metadata.Resource{CreationTime: aws.ToTime(ruleSet.CreatedTimestamp), ID: name, Name: name, TypeName: "aws_ses_receipt_rule_set"}) {
+				continue
+			}
+
+			// You cannot delete the receipt rule set that is currently active.
+			// Setting the name of the active receipt rule set to null disables all email receiving.
+			if name == activeName {
+				log.Printf("[INFO] Disabling currently active SES Receipt Rule Set: %s", name)
+				_, err := conn.SetActiveReceiptRuleSet(ctx, &ses.SetActiveReceiptRuleSetInput{})
+				if err != nil {
+					return fmt.Errorf("disabling currently active SES Receipt Rule Set (%s): %w", name, err)
+				}
+			}
+
 			log.Printf("[INFO] Deleting SES Receipt Rule Set: %s", name)
 			_, err := conn.DeleteReceiptRuleSet(ctx, &ses.DeleteReceiptRuleSetInput{
 				RuleSetName: aws.String(name),
@@ -194,3 +219,12 @@ func sweepReceiptRuleSets(region string) error {
 
 	return sweeperErrs.ErrorOrNil()
 }
+
+// identityTypeName returns the type name of the resource for SES identities of the specified type.
+func identityTypeName(identityType string) string {
+	if identityType == string(awstypes.IdentityTypeDomain) {
+		return "aws_ses_domain_identity"
+	}
+
+	return "aws_ses_email_identity"
+}
@@ -51,7 +51,7 @@ func testAccSESV2ContactList_tags(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SESV2) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.SESV2ServiceID),
 		CheckDestroy:             testAccCheckContactListDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -232,7 +232,7 @@ func testAccSESV2ContactList_tags_null(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SESV2) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.SESV2ServiceID),
 		CheckDestroy:             testAccCheckContactListDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -292,7 +292,7 @@ func testAccSESV2ContactList_tags_EmptyMap(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SESV2) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.SESV2ServiceID),
 		CheckDestroy:             testAccCheckContactListDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -348,7 +348,7 @@ func testAccSESV2ContactList_tags_AddOnUpdate(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SESV2) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.SESV2ServiceID),
 		CheckDestroy:             testAccCheckContactListDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -428,7 +428,7 @@ func testAccSESV2ContactList_tags_EmptyTag_OnCreate(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SESV2) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.SESV2ServiceID),
 		CheckDestroy:             testAccCheckContactListDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -516,7 +516,7 @@ func testAccSESV2ContactList_tags_EmptyTag_OnUpdate_Add(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SESV2) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.SESV2ServiceID),
 		CheckDestroy:             testAccCheckContactListDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -652,7 +652,7 @@ func testAccSESV2ContactList_tags_EmptyTag_OnUpdate_Replace(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SESV2) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.SESV2ServiceID),
 		CheckDestroy:             testAccCheckContactListDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -740,7 +740,7 @@ func testAccSESV2ContactList_tags_DefaultTags_providerOnly(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SESV2) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.SESV2ServiceID),
 		CheckDestroy: testAccCheckContactListDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -920,7 +920,7 @@ func testAccSESV2ContactList_tags_DefaultTags_nonOverlapping(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SESV2) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.SESV2ServiceID),
 		CheckDestroy: testAccCheckContactListDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1079,7 +1079,7 @@ func testAccSESV2ContactList_tags_DefaultTags_overlapping(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SESV2) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.SESV2ServiceID),
 		CheckDestroy: testAccCheckContactListDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1254,7 +1254,7 @@ func testAccSESV2ContactList_tags_DefaultTags_updateToProviderOnly(t *testing.T)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SESV2) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.SESV2ServiceID),
 		CheckDestroy: testAccCheckContactListDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1343,7 +1343,7 @@ func testAccSESV2ContactList_tags_DefaultTags_updateToResourceOnly(t *testing.T)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SESV2) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.SESV2ServiceID),
 		CheckDestroy: testAccCheckContactListDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1431,7 +1431,7 @@ func testAccSESV2ContactList_tags_DefaultTags_emptyResourceTag(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SESV2) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.SESV2ServiceID),
 		CheckDestroy: testAccCheckContactListDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1495,7 +1495,7 @@ func testAccSESV2ContactList_tags_DefaultTags_emptyProviderOnlyTag(t *testing.T)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SESV2) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.SESV2ServiceID),
 		CheckDestroy: testAccCheckContactListDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1551,7 +1551,7 @@ func testAccSESV2ContactList_tags_DefaultTags_nullOverlappingResourceTag(t *test
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SESV2) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.SESV2ServiceID),
 		CheckDestroy: testAccCheckContactListDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1612,7 +1612,7 @@ func testAccSESV2ContactList_tags_DefaultTags_nullNonOverlappingResourceTag(t *t
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SESV2) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.SESV2ServiceID),
 		CheckDestroy: testAccCheckContactListDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1673,7 +1673,7 @@ func testAccSESV2ContactList_tags_ComputedTag_OnCreate(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SESV2) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.SESV2ServiceID),
 		CheckDestroy: testAccCheckContactListDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1727,7 +1727,7 @@ func testAccSESV2ContactList_tags_ComputedTag_OnUpdate_Add(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SESV2) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.SESV2ServiceID),
 		CheckDestroy: testAccCheckContactListDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1823,7 +1823,7 @@ func testAccSESV2ContactList_tags_ComputedTag_OnUpdate_Replace(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SESV2) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.SESV2ServiceID),
 		CheckDestroy: testAccCheckContactListDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1909,7 +1909,7 @@ func testAccSESV2ContactList_tags_IgnoreTags_Overlap_DefaultTag(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SESV2) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.SESV2ServiceID),
 		CheckDestroy: testAccCheckContactListDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -2070,7 +2070,7 @@ func testAccSESV2ContactList_tags_IgnoreTags_Overlap_ResourceTag(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SESV2) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.SESV2ServiceID),
 		CheckDestroy: testAccCheckContactListDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -29,6 +29,7 @@ import (
 )
 
 // @FrameworkResource("aws_shield_drt_access_log_bucket_association", name="DRT Log Bucket Association")
+// @Region(global=true)
 func newDRTAccessLogBucketAssociationResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &drtAccessLogBucketAssociationResource{}
 
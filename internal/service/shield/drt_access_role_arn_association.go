@@ -25,6 +25,7 @@ import (
 )
 
 // @FrameworkResource("aws_shield_drt_access_role_arn_association", name="DRT Role ARN Association")
+// @Region(global=true)
 func newDRTAccessRoleARNAssociationResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourceDRTAccessRoleARNAssociation{}
 
@@ -28,6 +28,7 @@ import (
 )
 
 // @FrameworkResource("aws_shield_proactive_engagement", name="Proactive Engagement")
+// @Region(global=true)
 func newProactiveEngagementResource(context.Context) (resource.ResourceWithConfigure, error) {
 	return &proactiveEngagementResource{}, nil
 }
@@ -23,6 +23,7 @@ import (
 )
 
 // @SDKResource("aws_shield_protection", name="Protection")
+// @Region(global=true)
 // @Tags(identifierAttribute="arn")
 func resourceProtection() *schema.Resource {
 	return &schema.Resource{
@@ -19,6 +19,7 @@ import (
 )
 
 // @FrameworkDataSource("aws_shield_protection", name="Protection")
+// @Region(global=true)
 func newDataSourceProtection(context.Context) (datasource.DataSourceWithConfigure, error) {
 	return &dataSourceProtection{}, nil
 }
@@ -20,6 +20,7 @@ import (
 )
 
 // @SDKResource("aws_shield_protection_health_check_association", name="Protection Health Check Association")
+// @Region(global=true)
 func ResourceProtectionHealthCheckAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: ResourceProtectionHealthCheckAssociationCreate,
@@ -25,6 +25,7 @@ import (
 )
 
 // @FrameworkResource("aws_shield_subscription", name="Subscription")
+// @Region(global=true)
 func newResourceSubscription(_ context.Context) (resource.ResourceWithConfigure, error) {
 	return &resourceSubscription{}, nil
 }
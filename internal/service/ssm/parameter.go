@@ -160,7 +160,7 @@ func resourceParameter() *schema.Resource {
 				return diff.HasChange(names.AttrValue)
 			}),
 			customdiff.ComputedIf("has_value_wo", func(_ context.Context, diff *schema.ResourceDiff, meta any) bool {
-				return diff.HasChange("value_wo_version")
+				return flex.HasWriteOnlyChange(diff, names.AttrValue)
 			}),
 		),
 	}
@@ -343,7 +343,7 @@ func resourceParameterUpdate(ctx context.Context, d *schema.ResourceData, meta a
 			value = v
 		}
 
-		if d.HasChanges("value_wo_version") {
+		if flex.HasWriteOnlyChange(d, names.AttrValue) {
 			valueWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("value_wo"))
 			diags = append(diags, di...)
 			if diags.HasError() {
@@ -51,7 +51,7 @@ func testAccSSMContactsContact_tags(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SSMContacts) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.SSMContactsServiceID),
 		CheckDestroy:             testAccCheckContactDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -234,7 +234,7 @@ func testAccSSMContactsContact_tags_null(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SSMContacts) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.SSMContactsServiceID),
 		CheckDestroy:             testAccCheckContactDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -294,7 +294,7 @@ func testAccSSMContactsContact_tags_EmptyMap(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SSMContacts) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.SSMContactsServiceID),
 		CheckDestroy:             testAccCheckContactDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -350,7 +350,7 @@ func testAccSSMContactsContact_tags_AddOnUpdate(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SSMContacts) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.SSMContactsServiceID),
 		CheckDestroy:             testAccCheckContactDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -432,7 +432,7 @@ func testAccSSMContactsContact_tags_EmptyTag_OnCreate(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SSMContacts) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.SSMContactsServiceID),
 		CheckDestroy:             testAccCheckContactDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -522,7 +522,7 @@ func testAccSSMContactsContact_tags_EmptyTag_OnUpdate_Add(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SSMContacts) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.SSMContactsServiceID),
 		CheckDestroy:             testAccCheckContactDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -660,7 +660,7 @@ func testAccSSMContactsContact_tags_EmptyTag_OnUpdate_Replace(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SSMContacts) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.SSMContactsServiceID),
 		CheckDestroy:             testAccCheckContactDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -748,7 +748,7 @@ func testAccSSMContactsContact_tags_DefaultTags_providerOnly(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SSMContacts) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.SSMContactsServiceID),
 		CheckDestroy: testAccCheckContactDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -928,7 +928,7 @@ func testAccSSMContactsContact_tags_DefaultTags_nonOverlapping(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SSMContacts) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.SSMContactsServiceID),
 		CheckDestroy: testAccCheckContactDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1087,7 +1087,7 @@ func testAccSSMContactsContact_tags_DefaultTags_overlapping(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SSMContacts) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.SSMContactsServiceID),
 		CheckDestroy: testAccCheckContactDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1262,7 +1262,7 @@ func testAccSSMContactsContact_tags_DefaultTags_updateToProviderOnly(t *testing.
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SSMContacts) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.SSMContactsServiceID),
 		CheckDestroy: testAccCheckContactDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1351,7 +1351,7 @@ func testAccSSMContactsContact_tags_DefaultTags_updateToResourceOnly(t *testing.
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SSMContacts) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.SSMContactsServiceID),
 		CheckDestroy: testAccCheckContactDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1441,7 +1441,7 @@ func testAccSSMContactsContact_tags_DefaultTags_emptyResourceTag(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SSMContacts) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.SSMContactsServiceID),
 		CheckDestroy: testAccCheckContactDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1507,7 +1507,7 @@ func testAccSSMContactsContact_tags_DefaultTags_emptyProviderOnlyTag(t *testing.
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SSMContacts) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.SSMContactsServiceID),
 		CheckDestroy: testAccCheckContactDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1565,7 +1565,7 @@ func testAccSSMContactsContact_tags_DefaultTags_nullOverlappingResourceTag(t *te
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SSMContacts) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.SSMContactsServiceID),
 		CheckDestroy: testAccCheckContactDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1628,7 +1628,7 @@ func testAccSSMContactsContact_tags_DefaultTags_nullNonOverlappingResourceTag(t
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SSMContacts) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.SSMContactsServiceID),
 		CheckDestroy: testAccCheckContactDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1689,7 +1689,7 @@ func testAccSSMContactsContact_tags_ComputedTag_OnCreate(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SSMContacts) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.SSMContactsServiceID),
 		CheckDestroy: testAccCheckContactDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1743,7 +1743,7 @@ func testAccSSMContactsContact_tags_ComputedTag_OnUpdate_Add(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SSMContacts) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.SSMContactsServiceID),
 		CheckDestroy: testAccCheckContactDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1839,7 +1839,7 @@ func testAccSSMContactsContact_tags_ComputedTag_OnUpdate_Replace(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SSMContacts) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.SSMContactsServiceID),
 		CheckDestroy: testAccCheckContactDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1925,7 +1925,7 @@ func testAccSSMContactsContact_tags_IgnoreTags_Overlap_DefaultTag(t *testing.T)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SSMContacts) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.SSMContactsServiceID),
 		CheckDestroy: testAccCheckContactDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -2086,7 +2086,7 @@ func testAccSSMContactsContact_tags_IgnoreTags_Overlap_ResourceTag(t *testing.T)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SSMContacts) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.SSMContactsServiceID),
 		CheckDestroy: testAccCheckContactDestroy(ctx),
 		Steps: []resource.TestStep{
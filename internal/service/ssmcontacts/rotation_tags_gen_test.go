@@ -51,7 +51,7 @@ func testAccSSMContactsRotation_tags(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SSMContacts) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.SSMContactsServiceID),
 		CheckDestroy:             testAccCheckRotationDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -234,7 +234,7 @@ func testAccSSMContactsRotation_tags_null(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SSMContacts) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.SSMContactsServiceID),
 		CheckDestroy:             testAccCheckRotationDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -295,7 +295,7 @@ func testAccSSMContactsRotation_tags_EmptyMap(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SSMContacts) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.SSMContactsServiceID),
 		CheckDestroy:             testAccCheckRotationDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -344,7 +344,7 @@ func testAccSSMContactsRotation_tags_AddOnUpdate(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SSMContacts) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.SSMContactsServiceID),
 		CheckDestroy:             testAccCheckRotationDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -425,7 +425,7 @@ func testAccSSMContactsRotation_tags_EmptyTag_OnCreate(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SSMContacts) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.SSMContactsServiceID),
 		CheckDestroy:             testAccCheckRotationDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -516,7 +516,7 @@ func testAccSSMContactsRotation_tags_EmptyTag_OnUpdate_Add(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SSMContacts) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.SSMContactsServiceID),
 		CheckDestroy:             testAccCheckRotationDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -656,7 +656,7 @@ func testAccSSMContactsRotation_tags_EmptyTag_OnUpdate_Replace(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SSMContacts) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.SSMContactsServiceID),
 		CheckDestroy:             testAccCheckRotationDestroy(ctx),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -745,7 +745,7 @@ func testAccSSMContactsRotation_tags_DefaultTags_providerOnly(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SSMContacts) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.SSMContactsServiceID),
 		CheckDestroy: testAccCheckRotationDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -925,7 +925,7 @@ func testAccSSMContactsRotation_tags_DefaultTags_nonOverlapping(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SSMContacts) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.SSMContactsServiceID),
 		CheckDestroy: testAccCheckRotationDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1084,7 +1084,7 @@ func testAccSSMContactsRotation_tags_DefaultTags_overlapping(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SSMContacts) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.SSMContactsServiceID),
 		CheckDestroy: testAccCheckRotationDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1259,7 +1259,7 @@ func testAccSSMContactsRotation_tags_DefaultTags_updateToProviderOnly(t *testing
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SSMContacts) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.SSMContactsServiceID),
 		CheckDestroy: testAccCheckRotationDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1348,7 +1348,7 @@ func testAccSSMContactsRotation_tags_DefaultTags_updateToResourceOnly(t *testing
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SSMContacts) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.SSMContactsServiceID),
 		CheckDestroy: testAccCheckRotationDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1438,7 +1438,7 @@ func testAccSSMContactsRotation_tags_DefaultTags_emptyResourceTag(t *testing.T)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SSMContacts) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.SSMContactsServiceID),
 		CheckDestroy: testAccCheckRotationDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1505,7 +1505,7 @@ func testAccSSMContactsRotation_tags_DefaultTags_emptyProviderOnlyTag(t *testing
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SSMContacts) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.SSMContactsServiceID),
 		CheckDestroy: testAccCheckRotationDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1564,7 +1564,7 @@ func testAccSSMContactsRotation_tags_DefaultTags_nullOverlappingResourceTag(t *t
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SSMContacts) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.SSMContactsServiceID),
 		CheckDestroy: testAccCheckRotationDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1634,7 +1634,7 @@ func testAccSSMContactsRotation_tags_DefaultTags_nullNonOverlappingResourceTag(t
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SSMContacts) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.SSMContactsServiceID),
 		CheckDestroy: testAccCheckRotationDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1704,7 +1704,7 @@ func testAccSSMContactsRotation_tags_ComputedTag_OnCreate(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SSMContacts) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.SSMContactsServiceID),
 		CheckDestroy: testAccCheckRotationDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1758,7 +1758,7 @@ func testAccSSMContactsRotation_tags_ComputedTag_OnUpdate_Add(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SSMContacts) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.SSMContactsServiceID),
 		CheckDestroy: testAccCheckRotationDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1854,7 +1854,7 @@ func testAccSSMContactsRotation_tags_ComputedTag_OnUpdate_Replace(t *testing.T)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SSMContacts) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.SSMContactsServiceID),
 		CheckDestroy: testAccCheckRotationDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -1940,7 +1940,7 @@ func testAccSSMContactsRotation_tags_IgnoreTags_Overlap_DefaultTag(t *testing.T)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SSMContacts) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.SSMContactsServiceID),
 		CheckDestroy: testAccCheckRotationDestroy(ctx),
 		Steps: []resource.TestStep{
@@ -2101,7 +2101,7 @@ func testAccSSMContactsRotation_tags_IgnoreTags_Overlap_ResourceTag(t *testing.T
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		PreCheck:     func() { acctest.PreCheck(ctx, t); acctest.PreCheckLocalEndpoint(ctx, t, names.SSMContacts) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.SSMContactsServiceID),
 		CheckDestroy: testAccCheckRotationDestroy(ctx),
 		Steps: []resource.TestStep{
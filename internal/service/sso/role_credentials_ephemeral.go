@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sso
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/sso"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ERNameRoleCredentials = "Ephemeral Resource Role Credentials"
+)
+
+// @EphemeralResource(aws_sso_role_credentials, name="Role Credentials")
+func newEphemeralRoleCredentials(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralRoleCredentials{}, nil
+}
+
+type ephemeralRoleCredentials struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *ephemeralRoleCredentials) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"access_key_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"access_token": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+			},
+			names.AttrAccountID: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					fwvalidators.AWSAccountID(),
+				},
+			},
+			"expiration": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"role_name": schema.StringAttribute{
+				Required: true,
+			},
+			"secret_access_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"session_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (e *ephemeralRoleCredentials) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data epRoleCredentialsData
+	conn := e.Meta().SSOClient(ctx)
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := sso.GetRoleCredentialsInput{
+		AccessToken: fwflex.StringFromFramework(ctx, data.AccessToken),
+		AccountId:   fwflex.StringFromFramework(ctx, data.AccountID),
+		RoleName:    fwflex.StringFromFramework(ctx, data.RoleName),
+	}
+
+	output, err := conn.GetRoleCredentials(ctx, &input)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SSO, create.ErrActionReading, ERNameRoleCredentials, data.RoleName.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	if v := output.RoleCredentials; v != nil {
+		data.AccessKeyID = fwflex.StringToFramework(ctx, v.AccessKeyId)
+		// Expiration is in milliseconds since the epoch.
+		data.Expiration = timetypes.NewRFC3339TimeValue(time.UnixMilli(v.Expiration).UTC())
+		data.SecretAccessKey = fwflex.StringToFramework(ctx, v.SecretAccessKey)
+		data.SessionToken = fwflex.StringToFramework(ctx, v.SessionToken)
+	}
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type epRoleCredentialsData struct {
+	AccessKeyID     types.String      `tfsdk:"access_key_id"`
+	AccessToken     types.String      `tfsdk:"access_token"`
+	AccountID       types.String      `tfsdk:"account_id"`
+	Expiration      timetypes.RFC3339 `tfsdk:"expiration"`
+	RoleName        types.String      `tfsdk:"role_name"`
+	SecretAccessKey types.String      `tfsdk:"secret_access_key"`
+	SessionToken    types.String      `tfsdk:"session_token"`
+}
@@ -23,6 +23,7 @@ import (
 )
 
 // @SDKResource("aws_waf_geo_match_set", name="GeoMatchSet")
+// @Region(global=true)
 func resourceGeoMatchSet() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceGeoMatchSetCreate,
@@ -19,6 +19,7 @@ import (
 )
 
 // @SDKDataSource("aws_waf_ipset", name="IPSet")
+// @Region(global=true)
 func dataSourceIPSet() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceIPSetRead,
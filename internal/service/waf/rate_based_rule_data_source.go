@@ -19,6 +19,7 @@ import (
 )
 
 // @SDKDataSource("aws_waf_rate_based_rule", name="Rate Based Rule")
+// @Region(global=true)
 func dataSourceRateBasedRule() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceRateBasedRuleRead,
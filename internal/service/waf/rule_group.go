@@ -24,6 +24,7 @@ import (
 )
 
 // @SDKResource("aws_waf_rule_group", name="Rule Group")
+// @Region(global=true)
 // @Tags(identifierAttribute="arn")
 func resourceRuleGroup() *schema.Resource {
 	return &schema.Resource{
@@ -23,6 +23,7 @@ import (
 )
 
 // @SDKResource("aws_waf_sql_injection_match_set", name="SqlInjectionMatchSet")
+// @Region(global=true)
 func resourceSQLInjectionMatchSet() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceSQLInjectionMatchSetCreate,
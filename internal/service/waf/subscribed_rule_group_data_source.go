@@ -19,6 +19,7 @@ import (
 )
 
 // @SDKDataSource("aws_waf_subscribed_rule_group", name="Subscribed Rule Group")
+// @Region(global=true)
 func dataSourceSubscribedRuleGroup() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceSubscribedRuleGroupRead,
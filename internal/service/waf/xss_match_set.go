@@ -24,6 +24,7 @@ import (
 )
 
 // @SDKResource("aws_waf_xss_match_set", name="XSS Match Set")
+// @Region(global=true)
 func resourceXSSMatchSet() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceXSSMatchSetCreate,
@@ -4,20 +4,25 @@
 package awsv2
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
-	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/log"
 )
 
+// Register registers a sweeper for the named resource type.
+// The resources listed by f are swept using the sweeper options configured using environment variables.
 func Register(name string, f sweep.SweeperFn, dependencies ...string) {
-	resource.AddTestSweepers(name, &resource.Sweeper{
+	sweep.AddTestSweepers(name, &sweep.Sweeper{
 		Name: name,
-		F: func(region string) error {
-			ctx := sweep.Context(region)
-			ctx = log.WithResourceType(ctx, name)
+		F: func(ctx context.Context, region string) error {
+			ctx = sweep.WithResourceType(ctx, name)
+
+			options, err := sweep.OptionsFromEnv()
+			if err != nil {
+				return fmt.Errorf("getting sweeper options: %w", err)
+			}
 
 			client, err := sweep.SharedRegionalSweepClient(ctx, region)
 			if err != nil {
@@ -36,7 +41,7 @@ func Register(name string, f sweep.SweeperFn, dependencies ...string) {
 				return fmt.Errorf("listing %q (%s): %w", name, region, err)
 			}
 
-			err = sweep.SweepOrchestrator(ctx, sweepResources)
+			err = sweep.SweepOrchestratorWithOptions(ctx, sweepResources, options)
 			if err != nil {
 				return fmt.Errorf("sweeping %q (%s): %w", name, region, err)
 			}
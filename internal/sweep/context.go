@@ -10,6 +10,15 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/log"
 )
 
+type contextKeyType int
+
+var (
+	regionContextKey       contextKeyType = 0
+	resourceTypeContextKey contextKeyType = 1
+	roleARNContextKey      contextKeyType = 2
+)
+
+// Context returns a new Context for sweeping the specified Region of the account of the default credentials.
 func Context(region string) context.Context {
 	ctx := context.Background()
 
@@ -17,5 +26,41 @@ func Context(region string) context.Context {
 
 	ctx = log.Logger(ctx, "sweeper", region)
 
+	ctx = context.WithValue(ctx, regionContextKey, region)
+
 	return ctx
 }
+
+// withRoleARN returns a new Context for sweeping the account of the IAM role with the specified ARN.
+// An empty ARN is the account of the default credentials.
+func withRoleARN(ctx context.Context, roleARN string) context.Context {
+	if roleARN == "" {
+		return ctx
+	}
+
+	ctx = log.WithRoleARN(ctx, roleARN)
+
+	return context.WithValue(ctx, roleARNContextKey, roleARN)
+}
+
+// WithResourceType returns a new Context with the type name of the resources being swept.
+func WithResourceType(ctx context.Context, resourceType string) context.Context {
+	ctx = log.WithResourceType(ctx, resourceType)
+
+	return context.WithValue(ctx, resourceTypeContextKey, resourceType)
+}
+
+func regionFromContext(ctx context.Context) string {
+	v, _ := ctx.Value(regionContextKey).(string)
+	return v
+}
+
+func resourceTypeFromContext(ctx context.Context) string {
+	v, _ := ctx.Value(resourceTypeContextKey).(string)
+	return v
+}
+
+func roleARNFromContext(ctx context.Context) string {
+	v, _ := ctx.Value(roleARNContextKey).(string)
+	return v
+}
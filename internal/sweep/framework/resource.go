@@ -5,6 +5,7 @@ package framework
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
@@ -13,7 +14,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/metadata"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type attribute struct {
@@ -29,19 +32,55 @@ func NewAttribute(path string, value any) attribute {
 }
 
 type sweepResource struct {
-	factory    func(context.Context) (fwresource.ResourceWithConfigure, error)
-	meta       *conns.AWSClient
-	attributes []attribute
+	attributes   []attribute
+	creationTime time.Time
+	factory      func(context.Context) (fwresource.ResourceWithConfigure, error)
+	meta         *conns.AWSClient
 }
 
 func NewSweepResource(factory func(context.Context) (fwresource.ResourceWithConfigure, error), meta *conns.AWSClient, attributes ...attribute) *sweepResource {
 	return &sweepResource{
+		attributes: attributes,
 		factory:    factory,
 		meta:       meta,
-		attributes: attributes,
 	}
 }
 
+// WithCreationTime sets the resource's creation time, used to filter resources by age.
+func (sr *sweepResource) WithCreationTime(t time.Time) *sweepResource {
+	sr.creationTime = t
+	return sr
+}
+
+// Metadata returns the resource's creation time and, from its attributes, its ID, name and tags.
+func (sr *sweepResource) Metadata(ctx context.Context) metadata.Resource {
+	r := metadata.Resource{
+		CreationTime: sr.creationTime,
+	}
+
+	for _, attr := range sr.attributes {
+		switch v := attr.value.(type) {
+		case string:
+			switch attr.path {
+			case names.AttrARN:
+				if r.ID == "" {
+					r.ID = v
+				}
+			case names.AttrID:
+				r.ID = v
+			case names.AttrName:
+				r.Name = v
+			}
+		case map[string]string:
+			if attr.path == names.AttrTags {
+				r.Tags = v
+			}
+		}
+	}
+
+	return r
+}
+
 func (sr *sweepResource) Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error {
 	resource, err := sr.factory(ctx)
 	if err != nil {
@@ -12,7 +12,8 @@ import (
 )
 
 const (
-	loggingKeySweeperRegion = "sweeper_region"
+	loggingKeySweeperRegion  = "sweeper_region"
+	loggingKeySweeperRoleARN = "sweeper_role_arn"
 
 	// Copied from:
 	// * https://github.com/hashicorp/terraform-plugin-sdk/blob/ffbf0104398c0aa91aa3a82aff4b67e260677454/internal/logging/keys.go#L29
@@ -31,6 +32,10 @@ func Logger(ctx context.Context, loggerName, region string) context.Context {
 	return ctx
 }
 
+func WithRoleARN(ctx context.Context, roleARN string) context.Context {
+	return tflog.SetField(ctx, loggingKeySweeperRoleARN, roleARN)
+}
+
 func WithResourceType(ctx context.Context, resourceType string) context.Context {
 	return tflog.SetField(ctx, loggingKeyResourceType, resourceType)
 }
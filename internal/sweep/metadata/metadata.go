@@ -0,0 +1,20 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package metadata describes resources to be swept.
+// It has no dependencies on the other sweep packages so that it can be used by both the orchestrator and the Sweepable implementations.
+package metadata
+
+import (
+	"time"
+)
+
+// Resource describes a resource to be swept.
+// Zero values indicate that the information is not known.
+type Resource struct {
+	CreationTime time.Time
+	ID           string
+	Name         string
+	Tags         map[string]string
+	TypeName     string
+}
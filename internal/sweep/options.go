@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/metadata"
+)
+
+// Options control which resources are swept and how.
+type Options struct {
+	// Concurrency is the maximum number of resources deleted concurrently.
+	// Zero means no limit.
+	Concurrency int
+	// CreatedAfter, if non-zero, limits sweeping to resources created after this time.
+	CreatedAfter time.Time
+	// CreatedBefore, if non-zero, limits sweeping to resources created before this time.
+	CreatedBefore time.Time
+	// DryRun lists the resources that would be swept without deleting them.
+	DryRun bool
+	// ExcludeNamePrefixes excludes resources whose name has any of the prefixes.
+	ExcludeNamePrefixes []string
+	// ExcludeTags excludes resources with any of the tags. An empty value matches any value.
+	ExcludeTags map[string]string
+	// IncludeNamePrefixes, if not empty, limits sweeping to resources whose name has one of the prefixes.
+	IncludeNamePrefixes []string
+	// IncludeTags, if not empty, limits sweeping to resources with all of the tags. An empty value matches any value.
+	IncludeTags map[string]string
+	// ReportFile, if set, is the file to which a JSON report of deleted, skipped and failed resources is written.
+	ReportFile string
+}
+
+// OptionsFromEnv returns sweeper options configured using environment variables.
+func OptionsFromEnv() (Options, error) {
+	var options Options
+	now := time.Now()
+
+	if v := os.Getenv(envvar.SweepConcurrency); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			return options, fmt.Errorf("environment variable %s: invalid concurrency %q", envvar.SweepConcurrency, v)
+		}
+		options.Concurrency = n
+	}
+
+	if v := os.Getenv(envvar.SweepCreatedAfter); v != "" {
+		t, err := parseTime(v, now)
+		if err != nil {
+			return options, fmt.Errorf("environment variable %s: %w", envvar.SweepCreatedAfter, err)
+		}
+		options.CreatedAfter = t
+	}
+
+	if v := os.Getenv(envvar.SweepCreatedBefore); v != "" {
+		t, err := parseTime(v, now)
+		if err != nil {
+			return options, fmt.Errorf("environment variable %s: %w", envvar.SweepCreatedBefore, err)
+		}
+		options.CreatedBefore = t
+	}
+
+	if v := os.Getenv(envvar.SweepDryRun); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return options, fmt.Errorf("environment variable %s: %w", envvar.SweepDryRun, err)
+		}
+		options.DryRun = b
+	}
+
+	options.ExcludeNamePrefixes = parseList(os.Getenv(envvar.SweepExcludeNamePrefixes))
+	options.ExcludeTags = parseTags(os.Getenv(envvar.SweepExcludeTags))
+	options.IncludeNamePrefixes = parseList(os.Getenv(envvar.SweepIncludeNamePrefixes))
+	options.IncludeTags = parseTags(os.Getenv(envvar.SweepIncludeTags))
+	options.ReportFile = os.Getenv(envvar.SweepReportFile)
+
+	return options, nil
+}
+
+// skipReason returns the reason the resource is not to be swept, or an empty string if it is to be swept.
+// Filters that cannot be evaluated, e.g. because a sweeper does not provide a resource's tags, cause the resource to be skipped.
+func (o Options) skipReason(r metadata.Resource) string {
+	name := r.Name
+	if name == "" {
+		name = r.ID
+	}
+
+	if len(o.IncludeNamePrefixes) > 0 && !hasAnyPrefix(name, o.IncludeNamePrefixes) {
+		return "name does not have an included prefix"
+	}
+	if hasAnyPrefix(name, o.ExcludeNamePrefixes) {
+		return "name has an excluded prefix"
+	}
+
+	if len(o.IncludeTags) > 0 || len(o.ExcludeTags) > 0 {
+		if r.Tags == nil {
+			return "tags are not known"
+		}
+		for k, v := range o.IncludeTags {
+			if !hasTag(r.Tags, k, v) {
+				return fmt.Sprintf("does not have included tag %q", k)
+			}
+		}
+		for k, v := range o.ExcludeTags {
+			if hasTag(r.Tags, k, v) {
+				return fmt.Sprintf("has excluded tag %q", k)
+			}
+		}
+	}
+
+	if !o.CreatedAfter.IsZero() || !o.CreatedBefore.IsZero() {
+		if r.CreationTime.IsZero() {
+			return "creation time is not known"
+		}
+		if !o.CreatedAfter.IsZero() && !r.CreationTime.After(o.CreatedAfter) {
+			return "created before " + o.CreatedAfter.Format(time.RFC3339)
+		}
+		if !o.CreatedBefore.IsZero() && !r.CreationTime.Before(o.CreatedBefore) {
+			return "created after " + o.CreatedBefore.Format(time.RFC3339)
+		}
+	}
+
+	return ""
+}
+
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasTag(tags map[string]string, key, value string) bool {
+	v, ok := tags[key]
+
+	return ok && (value == "" || v == value)
+}
+
+// parseTime parses an RFC 3339 timestamp or a duration before now.
+func parseTime(s string, now time.Time) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q is neither an RFC 3339 timestamp nor a duration", s)
+	}
+
+	return now.Add(-d), nil
+}
+
+func parseList(s string) []string {
+	var l []string
+
+	for _, v := range strings.Split(s, ",") {
+		if v := strings.TrimSpace(v); v != "" {
+			l = append(l, v)
+		}
+	}
+
+	return l
+}
+
+func parseTags(s string) map[string]string {
+	var tags map[string]string
+
+	for _, v := range parseList(s) {
+		if tags == nil {
+			tags = make(map[string]string)
+		}
+		k, v, _ := strings.Cut(v, "=")
+		tags[k] = v
+	}
+
+	return tags
+}
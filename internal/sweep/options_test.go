@@ -0,0 +1,288 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/metadata"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestOptionsFromEnv(t *testing.T) { //nolint:paralleltest // uses t.Setenv
+	t.Setenv(envvar.SweepConcurrency, "4")
+	t.Setenv(envvar.SweepCreatedBefore, "2026-10-01T00:00:00Z")
+	t.Setenv(envvar.SweepDryRun, "true")
+	t.Setenv(envvar.SweepExcludeTags, "keep, owner=platform")
+	t.Setenv(envvar.SweepIncludeNamePrefixes, "tf-acc-test,tf-test")
+	t.Setenv(envvar.SweepReportFile, "report.json")
+
+	got, err := OptionsFromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := Options{
+		Concurrency:         4,
+		CreatedBefore:       time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+		DryRun:              true,
+		ExcludeTags:         map[string]string{"keep": "", "owner": "platform"},
+		IncludeNamePrefixes: []string{"tf-acc-test", "tf-test"},
+		ReportFile:          "report.json",
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	t.Setenv(envvar.SweepConcurrency, "lots")
+
+	if _, err := OptionsFromEnv(); err == nil {
+		t.Error("expected error for invalid concurrency")
+	}
+}
+
+func TestOptionsSkipReason(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		options  Options
+		resource metadata.Resource
+		skipped  bool
+	}{
+		"no filters": {
+			resource: metadata.Resource{ID: "example"},
+		},
+		"included name prefix": {
+			options:  Options{IncludeNamePrefixes: []string{"tf-acc-test"}},
+			resource: metadata.Resource{ID: "i-123", Name: "tf-acc-test-123"},
+		},
+		"not included name prefix": {
+			options:  Options{IncludeNamePrefixes: []string{"tf-acc-test"}},
+			resource: metadata.Resource{ID: "i-123", Name: "production"},
+			skipped:  true,
+		},
+		"ID used as name": {
+			options:  Options{IncludeNamePrefixes: []string{"tf-acc-test"}},
+			resource: metadata.Resource{ID: "tf-acc-test-123"},
+		},
+		"excluded name prefix": {
+			options:  Options{ExcludeNamePrefixes: []string{"prod-"}},
+			resource: metadata.Resource{ID: "prod-db"},
+			skipped:  true,
+		},
+		"included tag": {
+			options:  Options{IncludeTags: map[string]string{"env": "test"}},
+			resource: metadata.Resource{ID: "example", Tags: map[string]string{"env": "test"}},
+		},
+		"not included tag value": {
+			options:  Options{IncludeTags: map[string]string{"env": "test"}},
+			resource: metadata.Resource{ID: "example", Tags: map[string]string{"env": "prod"}},
+			skipped:  true,
+		},
+		"excluded tag key": {
+			options:  Options{ExcludeTags: map[string]string{"keep": ""}},
+			resource: metadata.Resource{ID: "example", Tags: map[string]string{"keep": "yes"}},
+			skipped:  true,
+		},
+		"unknown tags": {
+			options:  Options{ExcludeTags: map[string]string{"keep": ""}},
+			resource: metadata.Resource{ID: "example"},
+			skipped:  true,
+		},
+		"created before": {
+			options:  Options{CreatedBefore: created.Add(time.Hour)},
+			resource: metadata.Resource{ID: "example", CreationTime: created},
+		},
+		"not created before": {
+			options:  Options{CreatedBefore: created.Add(-time.Hour)},
+			resource: metadata.Resource{ID: "example", CreationTime: created},
+			skipped:  true,
+		},
+		"not created after": {
+			options:  Options{CreatedAfter: created.Add(time.Hour)},
+			resource: metadata.Resource{ID: "example", CreationTime: created},
+			skipped:  true,
+		},
+		"unknown creation time": {
+			options:  Options{CreatedBefore: created},
+			resource: metadata.Resource{ID: "example"},
+			skipped:  true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.options.skipReason(testCase.resource) != "", testCase.skipped; got != want {
+				t.Errorf("skipped = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+type mockSweepable struct {
+	active   *atomic.Int32
+	deleted  *atomic.Int32
+	err      error
+	maxSeen  *atomic.Int32
+	resource metadata.Resource
+}
+
+func (m *mockSweepable) Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error {
+	n := m.active.Add(1)
+	defer m.active.Add(-1)
+
+	for {
+		v := m.maxSeen.Load()
+		if n <= v || m.maxSeen.CompareAndSwap(v, n) {
+			break
+		}
+	}
+	time.Sleep(10 * time.Millisecond)
+
+	if m.err != nil {
+		return m.err
+	}
+	m.deleted.Add(1)
+
+	return nil
+}
+
+func (m *mockSweepable) Metadata(context.Context) metadata.Resource {
+	return m.resource
+}
+
+func TestSweepOrchestratorWithOptions(t *testing.T) {
+	t.Parallel()
+
+	ctx := WithResourceType(Context("us-west-2"), "aws_example_thing") //lintignore:AWSAT003
+
+	var active, deleted, maxSeen atomic.Int32
+	newSweepable := func(name string, err error) Sweepable {
+		return &mockSweepable{
+			active:   &active,
+			deleted:  &deleted,
+			err:      err,
+			maxSeen:  &maxSeen,
+			resource: metadata.Resource{ID: name, Name: name},
+		}
+	}
+	sweepables := []Sweepable{
+		newSweepable("tf-acc-test-1", nil),
+		newSweepable("tf-acc-test-2", nil),
+		newSweepable("tf-acc-test-3", nil),
+		newSweepable("tf-acc-test-4", errors.New("test error")),
+		newSweepable("production", nil),
+	}
+
+	// Entries in an existing report are kept.
+	reportFile := filepath.Join(t.TempDir(), "report.json")
+	if err := os.WriteFile(reportFile, []byte(`{"deleted":[{"id":"tf-acc-test-0"}]}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	options := Options{
+		Concurrency:         2,
+		IncludeNamePrefixes: []string{"tf-acc-test"},
+		ReportFile:          reportFile,
+	}
+
+	if err := SweepOrchestratorWithOptions(ctx, sweepables, options); err == nil {
+		t.Error("expected error")
+	}
+
+	if got, want := deleted.Load(), int32(3); got != want {
+		t.Errorf("deleted = %d, want %d", got, want)
+	}
+	if got, want := maxSeen.Load(), int32(2); got > want {
+		t.Errorf("concurrency = %d, want at most %d", got, want)
+	}
+
+	b, err := os.ReadFile(reportFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got struct {
+		Deleted []reportEntry `json:"deleted"`
+		Failed  []reportEntry `json:"failed"`
+		Skipped []reportEntry `json:"skipped"`
+	}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(got.Deleted), 4; got != want {
+		t.Errorf("report deleted = %d, want %d", got, want)
+	}
+	if got, want := got.Failed, []reportEntry{{Error: "test error", ID: "tf-acc-test-4", Name: "tf-acc-test-4", Region: "us-west-2", Type: "aws_example_thing"}}; !cmp.Equal(got, want) { //lintignore:AWSAT003
+		t.Errorf("report failed = %v, want %v", got, want)
+	}
+	if got, want := len(got.Skipped), 1; got != want {
+		t.Errorf("report skipped = %d, want %d", got, want)
+	}
+
+	// Dry run.
+	deleted.Store(0)
+	options.DryRun = true
+	options.ReportFile = filepath.Join(t.TempDir(), "report.json")
+
+	if err := SweepOrchestratorWithOptions(ctx, sweepables, options); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := deleted.Load(), int32(0); got != want {
+		t.Errorf("dry run deleted = %d, want %d", got, want)
+	}
+	dryRunReport, err := reportFor(options.ReportFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(dryRunReport.WouldDelete), 4; got != want {
+		t.Errorf("dry run would delete = %d, want %d", got, want)
+	}
+}
+
+func TestShouldDelete(t *testing.T) { //nolint:paralleltest // uses t.Setenv
+	ctx := WithResourceType(Context("us-west-2"), "aws_example_thing") //lintignore:AWSAT003
+	t.Setenv(envvar.SweepIncludeNamePrefixes, "tf-acc-test")
+
+	if !ShouldDelete(ctx, metadata.Resource{ID: "tf-acc-test-1"}) {
+		t.Error("ShouldDelete = false, want true")
+	}
+	if ShouldDelete(ctx, metadata.Resource{ID: "production"}) {
+		t.Error("excluded ShouldDelete = true, want false")
+	}
+
+	reportFile := filepath.Join(t.TempDir(), "report.json")
+	t.Setenv(envvar.SweepDryRun, "true")
+	t.Setenv(envvar.SweepReportFile, reportFile)
+
+	if ShouldDelete(ctx, metadata.Resource{ID: "tf-acc-test-1"}) {
+		t.Error("dry run ShouldDelete = true, want false")
+	}
+
+	b, err := os.ReadFile(reportFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got struct {
+		WouldDelete []reportEntry `json:"would_delete"`
+	}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := got.WouldDelete, []reportEntry{{ID: "tf-acc-test-1", Region: "us-west-2", Type: "aws_example_thing"}}; !cmp.Equal(got, want) { //lintignore:AWSAT003
+		t.Errorf("report would delete = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/depgraph"
+)
+
+// Sweeper sweeps the resources of a resource type.
+type Sweeper struct {
+	// Name is the name of the sweeper.
+	Name string
+	// F sweeps the resources in the specified Region of the account being swept.
+	// The Context identifies the Region and account being swept.
+	F func(ctx context.Context, region string) error
+	// Dependencies are the names of the sweepers that must be run before this sweeper.
+	Dependencies []string
+}
+
+// sweepers holds all registered sweepers, keyed by name.
+var sweepers = make(map[string]*Sweeper)
+
+// AddTestSweepers registers a sweeper for the named resource type.
+// The sweeper is also registered with the plugin testing framework, which sweeps the account of the default credentials.
+func AddTestSweepers(name string, s *Sweeper) {
+	sweepers[name] = s
+
+	resource.AddTestSweepers(name, &resource.Sweeper{
+		Name: s.Name,
+		F: func(region string) error {
+			return s.F(Context(region), region)
+		},
+		Dependencies: s.Dependencies,
+	})
+}
+
+// ValidateSweepers returns an error if any registered sweeper depends on a sweeper that is not registered,
+// or if the registered sweepers' dependencies contain a cycle.
+func ValidateSweepers() error {
+	_, err := dependencyGraph(sweepers)
+
+	return err
+}
+
+// dependencyGraph returns the graph of dependencies between the specified sweepers.
+// A sweeper depends on the sweepers that must be run before it.
+func dependencyGraph(sweepers map[string]*Sweeper) (*depgraph.Graph, error) {
+	g := depgraph.New()
+	names := slices.Sorted(maps.Keys(sweepers))
+
+	for _, name := range names {
+		g.AddNode(name)
+	}
+
+	for _, name := range names {
+		for _, dependency := range sweepers[name].Dependencies {
+			if !g.HasNode(dependency) {
+				return nil, fmt.Errorf("sweeper (%s) has dependency (%s), but that sweeper was not found", name, dependency)
+			}
+
+			if err := g.AddDependency(name, dependency); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if _, err := g.OverallOrder(); err != nil {
+		return nil, fmt.Errorf("sweepers: %w", err)
+	}
+
+	return g, nil
+}
@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"sync"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/metadata"
+)
+
+// reportEntry represents a single resource in a sweep report.
+type reportEntry struct {
+	Error   string `json:"error,omitempty"`
+	ID      string `json:"id"`
+	Name    string `json:"name,omitempty"`
+	Reason  string `json:"reason,omitempty"`
+	Region  string `json:"region,omitempty"`
+	RoleARN string `json:"role_arn,omitempty"`
+	Type    string `json:"type,omitempty"`
+}
+
+func newReportEntry(ctx context.Context, r metadata.Resource) reportEntry {
+	return reportEntry{
+		ID:      r.ID,
+		Name:    r.Name,
+		Region:  regionFromContext(ctx),
+		RoleARN: roleARNFromContext(ctx),
+		Type:    r.TypeName,
+	}
+}
+
+// report records the outcome of sweeping each resource.
+// A single report per report file is shared by all sweepers run by the process.
+type report struct {
+	Deleted     []reportEntry `json:"deleted"`
+	DryRun      bool          `json:"dry_run"`
+	Failed      []reportEntry `json:"failed"`
+	Skipped     []reportEntry `json:"skipped"`
+	WouldDelete []reportEntry `json:"would_delete"`
+
+	lock sync.Mutex
+}
+
+var (
+	// reports are the reports of the process, keyed by report file name.
+	reports     = make(map[string]*report)
+	reportsLock sync.Mutex
+)
+
+func newReport() *report {
+	return &report{
+		Deleted:     []reportEntry{},
+		Failed:      []reportEntry{},
+		Skipped:     []reportEntry{},
+		WouldDelete: []reportEntry{},
+	}
+}
+
+// reportFor returns the report written to the specified file, or an unwritten report if the name is empty.
+// The first time a report is returned its entries are read from the file, if it exists,
+// so that reports from earlier processes, e.g. sweeping other Regions, are added to rather than overwritten.
+func reportFor(name string) (*report, error) {
+	reportsLock.Lock()
+	defer reportsLock.Unlock()
+
+	if r, ok := reports[name]; ok {
+		return r, nil
+	}
+
+	r := newReport()
+
+	if name != "" {
+		b, err := os.ReadFile(name)
+
+		switch {
+		case errors.Is(err, fs.ErrNotExist):
+		case err != nil:
+			return nil, err
+		default:
+			if err := json.Unmarshal(b, r); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	reports[name] = r
+
+	return r, nil
+}
+
+func (r *report) deleted(e reportEntry) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.Deleted = append(r.Deleted, e)
+}
+
+func (r *report) failed(e reportEntry, err error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	e.Error = err.Error()
+	r.Failed = append(r.Failed, e)
+}
+
+func (r *report) skipped(e reportEntry, reason string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	e.Reason = reason
+	r.Skipped = append(r.Skipped, e)
+}
+
+func (r *report) wouldDelete(e reportEntry) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.DryRun = true
+	r.WouldDelete = append(r.WouldDelete, e)
+}
+
+// write writes the whole report to the specified file.
+// The report is rewritten after each sweeper runs so that it is complete even if a later sweeper panics or times out.
+func (r *report) write(name string) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	b, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(name, b, 0o600)
+}
@@ -0,0 +1,462 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/depgraph"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	defaultMaxAttempts = 3
+	defaultParallelism = 4
+	defaultRetryDelay  = 30 * time.Second
+)
+
+// Flags registered by the plugin testing framework.
+const (
+	flagSweep              = "sweep"
+	flagSweepAllowFailures = "sweep-allow-failures"
+	flagSweepRun           = "sweep-run"
+)
+
+// TestMain runs the registered sweepers if the -sweep flag is set, otherwise tests are run as normal.
+// The -sweep flag is a comma-separated list of Regions and partitions; a partition is replaced by all of its Regions.
+// Each Region is swept in each account configured using environment variables.
+// Sweepers are run in dependency order, with independent sweepers run in parallel.
+// A failed sweeper is retried, and the sweepers that depend on it are run once it succeeds.
+func TestMain(m interface {
+	Run() int
+}) {
+	flag.Parse()
+
+	if flagValue(flagSweep) == "" {
+		resource.TestMain(m)
+
+		return
+	}
+
+	options, err := runnerOptionsFromEnv()
+	if err != nil {
+		log.Fatalf("[ERROR] getting sweeper runner options: %s", err)
+	}
+
+	r, err := newRunner(sweepers, options, flagValue(flagSweepAllowFailures) == "true")
+	if err != nil {
+		log.Fatalf("[ERROR] %s", err)
+	}
+
+	names, err := r.filter(flagValue(flagSweepRun))
+	if err != nil {
+		log.Fatalf("[ERROR] %s", err)
+	}
+
+	regions := expandRegions(parseList(flagValue(flagSweep)), RegionsInPartition)
+
+	if err := r.run(options.roleARNs, regions, names); err != nil {
+		log.Printf("[ERROR] %s", err)
+		os.Exit(1)
+	}
+}
+
+func flagValue(name string) string {
+	if f := flag.Lookup(name); f != nil {
+		return f.Value.String()
+	}
+
+	return ""
+}
+
+// runnerOptions control how sweepers are run.
+type runnerOptions struct {
+	// maxAttempts is the maximum number of times a failing sweeper is run.
+	maxAttempts int
+	// parallelism is the maximum number of sweepers run concurrently.
+	parallelism int
+	// retryDelay is the time to wait before a failed sweeper is run again.
+	retryDelay time.Duration
+	// roleARNs are the ARNs of the IAM roles assumed in each account to sweep.
+	// If empty, the account of the default credentials is swept.
+	roleARNs []string
+}
+
+// runnerOptionsFromEnv returns sweeper runner options configured using environment variables.
+func runnerOptionsFromEnv() (runnerOptions, error) {
+	options := runnerOptions{
+		maxAttempts: defaultMaxAttempts,
+		parallelism: defaultParallelism,
+		retryDelay:  defaultRetryDelay,
+	}
+
+	if v := os.Getenv(envvar.SweepMaxAttempts); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			return options, fmt.Errorf("environment variable %s: invalid maximum attempts %q", envvar.SweepMaxAttempts, v)
+		}
+		options.maxAttempts = n
+	}
+
+	if v := os.Getenv(envvar.SweepParallelism); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			return options, fmt.Errorf("environment variable %s: invalid parallelism %q", envvar.SweepParallelism, v)
+		}
+		options.parallelism = n
+	}
+
+	if v := os.Getenv(envvar.SweepRetryDelay); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d < 0 {
+			return options, fmt.Errorf("environment variable %s: invalid duration %q", envvar.SweepRetryDelay, v)
+		}
+		options.retryDelay = d
+	}
+
+	for _, v := range parseList(os.Getenv(envvar.SweepRoleARNs)) {
+		if _, err := arn.Parse(v); err != nil {
+			return options, fmt.Errorf("environment variable %s: invalid role ARN %q: %w", envvar.SweepRoleARNs, v, err)
+		}
+		options.roleARNs = append(options.roleARNs, v)
+	}
+
+	return options, nil
+}
+
+// runner runs sweepers in dependency order.
+type runner struct {
+	allowFailures bool
+	graph         *depgraph.Graph
+	options       runnerOptions
+	sweepers      map[string]*Sweeper
+}
+
+// newRunner returns a runner for the specified sweepers.
+// An error is returned if any sweeper depends on a sweeper that is not registered,
+// or if the sweepers' dependencies contain a cycle.
+func newRunner(sweepers map[string]*Sweeper, options runnerOptions, allowFailures bool) (*runner, error) {
+	g, err := dependencyGraph(sweepers)
+	if err != nil {
+		return nil, err
+	}
+
+	return &runner{
+		allowFailures: allowFailures,
+		graph:         g,
+		options:       options,
+		sweepers:      sweepers,
+	}, nil
+}
+
+// filter returns the names of the sweepers matching the comma-separated filter, together with their dependencies.
+// A sweeper matches if its name contains any of the filter's values, ignoring case. An empty filter matches all sweepers.
+func (r *runner) filter(f string) ([]string, error) {
+	values := parseList(strings.ToLower(f))
+	if len(values) == 0 {
+		return r.graph.Nodes(), nil
+	}
+
+	names := make(map[string]struct{})
+
+	for _, name := range r.graph.Nodes() {
+		if !slices.ContainsFunc(values, func(v string) bool {
+			return strings.Contains(strings.ToLower(name), v)
+		}) {
+			continue
+		}
+
+		dependencies, err := r.graph.DependenciesOf(name)
+		if err != nil {
+			return nil, err
+		}
+
+		names[name] = struct{}{}
+		for _, dependency := range dependencies {
+			names[dependency] = struct{}{}
+		}
+	}
+
+	return slices.Sorted(maps.Keys(names)), nil
+}
+
+// target is an account and Region to sweep.
+type target struct {
+	region  string
+	roleARN string
+}
+
+// run runs the named sweepers in each of the Regions of each account.
+// An account is swept by assuming the IAM role with the specified ARN, or using the default credentials if the ARN is empty.
+// Regions not in the partition of an account's role are not swept.
+// Unless failures are allowed, no further sweepers are started once a sweeper has failed.
+func (r *runner) run(roleARNs, regions, names []string) error {
+	if len(roleARNs) == 0 {
+		roleARNs = []string{""}
+	}
+
+	results := make(map[target]map[string]error)
+	var err error
+
+	for _, roleARN := range roleARNs {
+		if err = r.runAccount(roleARN, regions, names, results); err != nil {
+			break
+		}
+	}
+
+	logResults(results)
+
+	if err != nil {
+		return err
+	}
+
+	for _, v := range results {
+		if anyFailed(v) {
+			return errors.New("at least one sweeper failed")
+		}
+	}
+
+	return nil
+}
+
+// runAccount runs the named sweepers in each of the Regions of the account, recording the results.
+func (r *runner) runAccount(roleARN string, regions, names []string, results map[target]map[string]error) error {
+	for _, region := range regions {
+		ctx := withRoleARN(Context(region), roleARN)
+
+		if !inRolePartition(roleARN, region) {
+			tflog.Warn(ctx, "Skipping Region not in the partition of the role")
+			continue
+		}
+
+		start := time.Now()
+		tflog.Info(ctx, "Running sweepers", map[string]any{
+			"count": len(names),
+		})
+
+		t := target{
+			region:  region,
+			roleARN: roleARN,
+		}
+		results[t] = r.runRegion(ctx, region, names)
+
+		tflog.Info(ctx, "Completed sweepers", map[string]any{
+			"duration": time.Since(start).String(),
+		})
+
+		if !r.allowFailures && anyFailed(results[t]) {
+			return fmt.Errorf("sweepers for region (%s) failed", region)
+		}
+	}
+
+	return nil
+}
+
+// anyFailed returns whether any of the sweepers failed.
+func anyFailed(results map[string]error) bool {
+	for _, err := range results {
+		if err != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// logResults logs the number of sweepers that succeeded and failed in each account and Region, and each failure.
+func logResults(results map[target]map[string]error) {
+	targets := slices.SortedFunc(maps.Keys(results), func(a, b target) int {
+		return strings.Compare(a.roleARN+" "+a.region, b.roleARN+" "+b.region)
+	})
+
+	for _, t := range targets {
+		ctx := Context(t.region)
+		var succeeded, failed int
+
+		for _, name := range slices.Sorted(maps.Keys(results[t])) {
+			if err := results[t][name]; err != nil {
+				failed++
+				tflog.Error(ctx, "Sweeper failed", map[string]any{
+					"role_arn": t.roleARN,
+					"sweeper":  name,
+					"error":    err.Error(),
+				})
+			} else {
+				succeeded++
+			}
+		}
+
+		tflog.Info(ctx, "Sweeper results", map[string]any{
+			"role_arn":  t.roleARN,
+			"succeeded": succeeded,
+			"failed":    failed,
+		})
+	}
+}
+
+// expandRegions returns the Regions, with each partition replaced by the Regions in that partition.
+func expandRegions(values []string, regionsInPartition func(partition string) []string) []string {
+	var regions []string
+
+	for _, v := range values {
+		expanded := []string{v}
+		if regionsInPartition != nil {
+			if partitionRegions := regionsInPartition(v); len(partitionRegions) > 0 {
+				expanded = partitionRegions
+			}
+		}
+
+		for _, region := range expanded {
+			if !slices.Contains(regions, region) {
+				regions = append(regions, region)
+			}
+		}
+	}
+
+	return regions
+}
+
+// inRolePartition returns whether the Region is in the partition of the IAM role with the specified ARN.
+// Any Region is in the partition of the default credentials.
+func inRolePartition(roleARN, region string) bool {
+	if roleARN == "" {
+		return true
+	}
+
+	v, err := arn.Parse(roleARN)
+	if err != nil {
+		return false
+	}
+
+	return names.PartitionForRegion(region).ID() == v.Partition
+}
+
+// runRegion runs the named sweepers in the specified Region and returns the result of each.
+// A sweeper is started once all of its dependencies have succeeded. A failed sweeper is run again,
+// after a delay, until it succeeds or has been run the maximum number of times.
+// Sweepers that are not run because a dependency did not succeed are reported as failed.
+func (r *runner) runRegion(ctx context.Context, region string, names []string) map[string]error {
+	type result struct {
+		name string
+		err  error
+	}
+
+	selected := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		selected[name] = struct{}{}
+	}
+
+	results := make(map[string]error, len(names))
+	attempts := make(map[string]int, len(names))
+	started := make(map[string]bool, len(names))
+	succeeded := make(map[string]bool, len(names))
+	c := make(chan result)
+	sem := make(chan struct{}, r.options.parallelism)
+	var running int
+	var stopped bool
+
+	start := func(name string) {
+		delay := time.Duration(0)
+		if attempts[name] > 0 {
+			delay = r.options.retryDelay
+		}
+		started[name] = true
+		running++
+
+		go func() {
+			time.Sleep(delay)
+
+			sem <- struct{}{}
+			tflog.Info(ctx, "Running sweeper", map[string]any{
+				"sweeper": name,
+			})
+			err := r.sweepers[name].F(ctx, region)
+			<-sem
+
+			c <- result{name: name, err: err}
+		}()
+	}
+
+	ready := func(name string) bool {
+		dependencies, _ := r.graph.DirectDependenciesOf(name)
+
+		return !slices.ContainsFunc(dependencies, func(dependency string) bool {
+			return !succeeded[dependency]
+		})
+	}
+
+	for _, name := range names {
+		if ready(name) {
+			start(name)
+		}
+	}
+
+	for running > 0 {
+		res := <-c
+		running--
+		attempts[res.name]++
+
+		if res.err == nil {
+			succeeded[res.name] = true
+			results[res.name] = nil
+
+			if stopped {
+				continue
+			}
+
+			dependents, _ := r.graph.DirectDependentsOf(res.name)
+			for _, dependent := range dependents {
+				if _, ok := selected[dependent]; ok && !started[dependent] && ready(dependent) {
+					start(dependent)
+				}
+			}
+
+			continue
+		}
+
+		if attempts[res.name] < r.options.maxAttempts && !stopped {
+			tflog.Warn(ctx, "Sweeper failed, retrying", map[string]any{
+				"sweeper": res.name,
+				"attempt": attempts[res.name],
+				"error":   res.err.Error(),
+			})
+			start(res.name)
+
+			continue
+		}
+
+		results[res.name] = res.err
+		if !r.allowFailures {
+			stopped = true
+		}
+	}
+
+	for _, name := range names {
+		if _, ok := results[name]; ok {
+			continue
+		}
+
+		if ready(name) {
+			results[name] = errors.New("not run: sweeping stopped after a failure")
+		} else {
+			results[name] = errors.New("not run: a dependency did not succeed")
+		}
+	}
+
+	return results
+}
@@ -0,0 +1,453 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+func TestNewRunner(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		dependencies map[string][]string
+		wantErr      bool
+	}{
+		"no dependencies": {
+			dependencies: map[string][]string{
+				"aws_a": nil,
+				"aws_b": nil,
+			},
+		},
+		"dependencies": {
+			dependencies: map[string][]string{
+				"aws_a": {"aws_b", "aws_c"},
+				"aws_b": {"aws_c"},
+				"aws_c": nil,
+			},
+		},
+		"missing dependency": {
+			dependencies: map[string][]string{
+				"aws_a": {"aws_b"},
+			},
+			wantErr: true,
+		},
+		"cycle": {
+			dependencies: map[string][]string{
+				"aws_a": {"aws_b"},
+				"aws_b": {"aws_c"},
+				"aws_c": {"aws_a"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := newRunner(newTestSweepers(testCase.dependencies, nil), runnerOptions{}, false)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Errorf("newRunner error = %v, want error: %t", err, want)
+			}
+		})
+	}
+}
+
+func TestRunnerFilter(t *testing.T) {
+	t.Parallel()
+
+	r := newTestRunner(t, map[string][]string{
+		"aws_ec2_instance":  {"aws_iam_role"},
+		"aws_iam_role":      {"aws_iam_policy"},
+		"aws_iam_policy":    nil,
+		"aws_s3_bucket":     nil,
+		"aws_vpc":           {"aws_ec2_instance"},
+		"aws_vpc_endpoint":  nil,
+		"aws_lambda_layer":  nil,
+		"aws_lambda_alias":  nil,
+		"aws_lambda_invoke": nil,
+	}, nil)
+
+	testCases := map[string][]string{
+		"": {
+			"aws_ec2_instance", "aws_iam_policy", "aws_iam_role", "aws_lambda_alias", "aws_lambda_invoke",
+			"aws_lambda_layer", "aws_s3_bucket", "aws_vpc", "aws_vpc_endpoint",
+		},
+		"aws_s3_bucket":          {"aws_s3_bucket"},
+		"AWS_VPC":                {"aws_ec2_instance", "aws_iam_policy", "aws_iam_role", "aws_vpc", "aws_vpc_endpoint"},
+		"aws_iam_role,aws_s3":    {"aws_iam_policy", "aws_iam_role", "aws_s3_bucket"},
+		"lambda_al, lambda_lay":  {"aws_lambda_alias", "aws_lambda_layer"},
+		"aws_no_such_sweeper":    nil,
+		"aws_iam_policy,aws_iam": {"aws_iam_policy", "aws_iam_role"},
+	}
+
+	for filter, want := range testCases {
+		got, err := r.filter(filter)
+		if err != nil {
+			t.Fatalf("filter(%q): %s", filter, err)
+		}
+
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Errorf("filter(%q) unexpected diff (+wanted, -got): %s", filter, diff)
+		}
+	}
+}
+
+func TestRunnerRunRegion(t *testing.T) {
+	t.Parallel()
+
+	dependencies := map[string][]string{
+		"aws_a": {"aws_b", "aws_c"},
+		"aws_b": {"aws_d"},
+		"aws_c": {"aws_d"},
+		"aws_d": nil,
+		"aws_e": nil,
+	}
+	errFailed := errors.New("DependencyViolation")
+
+	testCases := map[string]struct {
+		// failures is the number of times each sweeper fails before succeeding.
+		failures      map[string]int
+		allowFailures bool
+		wantErrs      []string
+		wantRuns      map[string]int
+	}{
+		"success": {
+			wantRuns: map[string]int{"aws_a": 1, "aws_b": 1, "aws_c": 1, "aws_d": 1, "aws_e": 1},
+		},
+		"retry": {
+			failures: map[string]int{"aws_b": 1, "aws_d": 2},
+			wantRuns: map[string]int{"aws_a": 1, "aws_b": 2, "aws_c": 1, "aws_d": 3, "aws_e": 1},
+		},
+		"dependency fails": {
+			failures:      map[string]int{"aws_c": 3},
+			allowFailures: true,
+			wantErrs:      []string{"aws_a", "aws_c"},
+			wantRuns:      map[string]int{"aws_b": 1, "aws_c": 3, "aws_d": 1, "aws_e": 1},
+		},
+		"stop after failure": {
+			failures: map[string]int{"aws_d": 3},
+			wantErrs: []string{"aws_a", "aws_b", "aws_c", "aws_d"},
+			wantRuns: map[string]int{"aws_d": 3, "aws_e": 1},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var mu sync.Mutex
+			runs := make(map[string]int)
+			var order []string
+
+			r := newTestRunner(t, dependencies, func(name string) error {
+				mu.Lock()
+				defer mu.Unlock()
+
+				runs[name]++
+				if runs[name] <= testCase.failures[name] {
+					return errFailed
+				}
+				order = append(order, name)
+
+				return nil
+			})
+			r.allowFailures = testCase.allowFailures
+
+			results := r.runRegion(context.Background(), "us-west-2", r.graph.Nodes())
+
+			var gotErrs []string
+			for name, err := range results {
+				if err != nil {
+					gotErrs = append(gotErrs, name)
+				}
+			}
+			slices.Sort(gotErrs)
+
+			if diff := cmp.Diff(gotErrs, testCase.wantErrs); diff != "" {
+				t.Errorf("unexpected failed sweepers diff (+wanted, -got): %s", diff)
+			}
+			if diff := cmp.Diff(runs, testCase.wantRuns); diff != "" {
+				t.Errorf("unexpected runs diff (+wanted, -got): %s", diff)
+			}
+
+			// Each sweeper must succeed after all of its dependencies.
+			for i, name := range order {
+				for _, dependency := range dependencies[name] {
+					if !slices.Contains(order[:i], dependency) {
+						t.Errorf("sweeper %s succeeded before its dependency %s", name, dependency)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestRunnerOptionsFromEnv(t *testing.T) { //nolint:paralleltest // uses t.Setenv
+	t.Setenv(envvar.SweepMaxAttempts, "5")
+	t.Setenv(envvar.SweepParallelism, "")
+	t.Setenv(envvar.SweepRetryDelay, "1m")
+	t.Setenv(envvar.SweepRoleARNs, "arn:aws:iam::111111111111:role/sweeper, arn:aws-us-gov:iam::222222222222:role/sweeper")
+
+	got, err := runnerOptionsFromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := runnerOptions{
+		maxAttempts: 5,
+		parallelism: defaultParallelism,
+		retryDelay:  defaultRetryDelay * 2,
+		roleARNs:    []string{"arn:aws:iam::111111111111:role/sweeper", "arn:aws-us-gov:iam::222222222222:role/sweeper"},
+	}
+	if diff := cmp.Diff(got, want, cmp.AllowUnexported(runnerOptions{})); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	t.Setenv(envvar.SweepParallelism, "0")
+
+	if _, err := runnerOptionsFromEnv(); err == nil {
+		t.Error("expected error for invalid parallelism")
+	}
+
+	t.Setenv(envvar.SweepParallelism, "")
+	t.Setenv(envvar.SweepRoleARNs, "sweeper")
+
+	if _, err := runnerOptionsFromEnv(); err == nil {
+		t.Error("expected error for invalid role ARN")
+	}
+}
+
+func TestSweeperAssumeRole(t *testing.T) { //nolint:paralleltest // uses t.Setenv
+	t.Setenv(envvar.AssumeRoleDuration, "7200")
+	t.Setenv(envvar.AssumeRoleExternalID, "external-id")
+	t.Setenv(envvar.AssumeRoleSessionName, "sweeper")
+
+	got, err := sweeperAssumeRole("arn:aws:iam::111111111111:role/shared")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := awsbase.AssumeRole{
+		RoleARN:     "arn:aws:iam::111111111111:role/shared",
+		Duration:    2 * time.Hour,
+		ExternalID:  "external-id",
+		SessionName: "sweeper",
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	got, err = sweeperAccountAssumeRole("arn:aws:iam::222222222222:role/sweeper", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want = awsbase.AssumeRole{
+		RoleARN:  "arn:aws:iam::222222222222:role/sweeper",
+		Duration: 2 * time.Hour,
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	got, err = sweeperAccountAssumeRole("arn:aws:iam::222222222222:role/sweeper", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want = awsbase.AssumeRole{
+		RoleARN:  "arn:aws:iam::222222222222:role/sweeper",
+		Duration: time.Hour,
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	t.Setenv(envvar.AssumeRoleDuration, "an hour")
+
+	if _, err := sweeperAccountAssumeRole("arn:aws:iam::222222222222:role/sweeper", true); err == nil {
+		t.Error("expected error for invalid duration")
+	}
+}
+
+func TestRunnerRun(t *testing.T) {
+	t.Parallel()
+
+	const (
+		roleARNCommercial = "arn:aws:iam::111111111111:role/sweeper"
+		roleARNGovCloud   = "arn:aws-us-gov:iam::222222222222:role/sweeper"
+	)
+
+	testCases := map[string]struct {
+		roleARNs      []string
+		allowFailures bool
+		// failures are the runs, identified by sweeper, role ARN and Region, that fail.
+		failures []string
+		wantErr  bool
+		wantRuns []string
+	}{
+		"default credentials": {
+			wantRuns: []string{
+				"aws_a  us-gov-west-1", "aws_a  us-west-2",
+				"aws_b  us-gov-west-1", "aws_b  us-west-2",
+			},
+		},
+		"accounts": {
+			roleARNs: []string{roleARNCommercial, roleARNGovCloud},
+			wantRuns: []string{
+				"aws_a " + roleARNGovCloud + " us-gov-west-1",
+				"aws_a " + roleARNCommercial + " us-west-2",
+				"aws_b " + roleARNGovCloud + " us-gov-west-1",
+				"aws_b " + roleARNCommercial + " us-west-2",
+			},
+		},
+		"failure": {
+			roleARNs: []string{roleARNCommercial, roleARNGovCloud},
+			failures: []string{"aws_b " + roleARNCommercial + " us-west-2"},
+			wantErr:  true,
+			wantRuns: []string{
+				"aws_b " + roleARNCommercial + " us-west-2",
+			},
+		},
+		"allow failures": {
+			roleARNs:      []string{roleARNCommercial, roleARNGovCloud},
+			allowFailures: true,
+			failures:      []string{"aws_b " + roleARNCommercial + " us-west-2"},
+			wantErr:       true,
+			wantRuns: []string{
+				"aws_a " + roleARNGovCloud + " us-gov-west-1",
+				"aws_b " + roleARNGovCloud + " us-gov-west-1",
+				"aws_b " + roleARNCommercial + " us-west-2",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var mu sync.Mutex
+			var runs []string
+
+			r := newTestRunner(t, map[string][]string{
+				"aws_a": {"aws_b"},
+				"aws_b": nil,
+			}, nil)
+			r.allowFailures = testCase.allowFailures
+			r.options.maxAttempts = 1
+			for sweeperName, s := range r.sweepers {
+				s.F = func(ctx context.Context, region string) error {
+					mu.Lock()
+					defer mu.Unlock()
+
+					run := sweeperName + " " + roleARNFromContext(ctx) + " " + region
+					runs = append(runs, run)
+
+					if slices.Contains(testCase.failures, run) {
+						return errors.New("failed")
+					}
+
+					return nil
+				}
+			}
+
+			err := r.run(testCase.roleARNs, []string{"us-west-2", "us-gov-west-1"}, r.graph.Nodes())
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Errorf("run error = %v, want error: %t", err, want)
+			}
+
+			slices.Sort(runs)
+			if diff := cmp.Diff(runs, testCase.wantRuns); diff != "" {
+				t.Errorf("unexpected runs diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandRegions(t *testing.T) {
+	t.Parallel()
+
+	regionsInPartition := func(partition string) []string {
+		switch partition {
+		case "aws-us-gov":
+			return []string{"us-gov-east-1", "us-gov-west-1"}
+		default:
+			return nil
+		}
+	}
+
+	got := expandRegions([]string{"us-west-2", "aws-us-gov", "us-gov-west-1", "us-east-1", "us-west-2"}, regionsInPartition)
+	want := []string{"us-west-2", "us-gov-east-1", "us-gov-west-1", "us-east-1"}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestInRolePartition(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		roleARN string
+		region  string
+		want    bool
+	}{
+		{"", "us-west-2", true},
+		{"", "us-gov-west-1", true},
+		{"arn:aws:iam::111111111111:role/sweeper", "us-west-2", true},
+		{"arn:aws:iam::111111111111:role/sweeper", "us-gov-west-1", false},
+		{"arn:aws-us-gov:iam::222222222222:role/sweeper", "us-gov-west-1", true},
+		{"arn:aws-cn:iam::333333333333:role/sweeper", "cn-north-1", true},
+	}
+
+	for _, testCase := range testCases {
+		if got := inRolePartition(testCase.roleARN, testCase.region); got != testCase.want {
+			t.Errorf("inRolePartition(%q, %q) = %t, want %t", testCase.roleARN, testCase.region, got, testCase.want)
+		}
+	}
+}
+
+func newTestSweepers(dependencies map[string][]string, f func(string) error) map[string]*Sweeper {
+	sweepers := make(map[string]*Sweeper)
+
+	for name, v := range dependencies {
+		sweepers[name] = &Sweeper{
+			Name:         name,
+			Dependencies: v,
+			F: func(context.Context, string) error {
+				return f(name)
+			},
+		}
+	}
+
+	return sweepers
+}
+
+func newTestRunner(t *testing.T, dependencies map[string][]string, f func(string) error) *runner {
+	t.Helper()
+
+	options := runnerOptions{
+		maxAttempts: 3,
+		parallelism: 2,
+	}
+	r, err := newRunner(newTestSweepers(dependencies, f), options, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return r
+}
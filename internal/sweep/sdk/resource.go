@@ -5,19 +5,24 @@ package sdk
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/metadata"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type sweepResource struct {
-	d        *schema.ResourceData
-	meta     *conns.AWSClient
-	resource *schema.Resource
+	creationTime time.Time
+	d            *schema.ResourceData
+	meta         *conns.AWSClient
+	resource     *schema.Resource
 }
 
 func NewSweepResource(resource *schema.Resource, d *schema.ResourceData, meta *conns.AWSClient) *sweepResource {
@@ -33,6 +38,32 @@ func newSweepResource(resource *schema.Resource, d *schema.ResourceData, meta *c
 	}
 }
 
+// WithCreationTime sets the resource's creation time, used to filter resources by age.
+func (sr *sweepResource) WithCreationTime(t time.Time) *sweepResource {
+	sr.creationTime = t
+	return sr
+}
+
+// Metadata returns the resource's ID and creation time and, if set, its name and tags.
+func (sr *sweepResource) Metadata(ctx context.Context) metadata.Resource {
+	r := metadata.Resource{
+		CreationTime: sr.creationTime,
+		ID:           sr.d.Id(),
+	}
+
+	s := sr.resource.SchemaMap()
+	if v, ok := s[names.AttrName]; ok && v.Type == schema.TypeString {
+		r.Name = sr.d.Get(names.AttrName).(string)
+	}
+	if v, ok := s[names.AttrTags]; ok && v.Type == schema.TypeMap {
+		if v, ok := sr.d.GetOk(names.AttrTags); ok {
+			r.Tags = flex.ExpandStringValueMap(v.(map[string]any))
+		}
+	}
+
+	return r
+}
+
 func (sr *sweepResource) Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error {
 	ctx = tflog.SetField(ctx, "id", sr.d.Id())
 
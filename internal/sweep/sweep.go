@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"sync"
 	"time"
 
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
@@ -15,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/metadata"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -22,18 +24,44 @@ const (
 	ResourcePrefix = "tf-acc-test"
 )
 
-const defaultSweeperAssumeRoleDurationSeconds = 3600
+const (
+	defaultSweeperAssumeRoleDurationSeconds = 3600
+	// maxChainedAssumeRoleDurationSeconds is the maximum session duration when a role is assumed using another role's credentials.
+	maxChainedAssumeRoleDurationSeconds = 3600
+)
 
 // ServicePackages is set in TestMain in order to break an import cycle.
 var ServicePackages []conns.ServicePackage
 
+// RegionsInPartition returns the Regions in the specified partition.
+// It is set in TestMain in order to break an import cycle.
+var RegionsInPartition func(partition string) []string
+
+// sweeperClientKey identifies a cached conns.AWSClient.
+type sweeperClientKey struct {
+	region  string
+	roleARN string
+}
+
 // sweeperClients is a shared cache of regional conns.AWSClient
 // This prevents client re-initialization for every resource with no benefit.
-var sweeperClients map[string]*conns.AWSClient = make(map[string]*conns.AWSClient)
+var sweeperClients map[sweeperClientKey]*conns.AWSClient = make(map[sweeperClientKey]*conns.AWSClient)
+
+// sweeperClientsLock serializes access to sweeperClients, as sweepers run concurrently.
+var sweeperClientsLock sync.Mutex
 
 // SharedRegionalSweepClient returns a common conns.AWSClient setup needed for the sweeper functions for a given Region.
+// The client is for the account being swept, identified by the IAM role ARN in the Context, if any.
 func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSClient, error) {
-	if client, ok := sweeperClients[region]; ok {
+	sweeperClientsLock.Lock()
+	defer sweeperClientsLock.Unlock()
+
+	key := sweeperClientKey{
+		region:  region,
+		roleARN: roleARNFromContext(ctx),
+	}
+
+	if client, ok := sweeperClients[key]; ok {
 		return client, nil
 	}
 
@@ -63,29 +91,23 @@ func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSCl
 		SuppressDebugLog: true,
 	}
 
+	// The role in the account being swept is assumed using the role configured for all sweepers, if any.
 	if role := os.Getenv(envvar.AssumeRoleARN); role != "" {
-		ar := awsbase.AssumeRole{
-			RoleARN:  role,
-			Duration: time.Duration(defaultSweeperAssumeRoleDurationSeconds) * time.Second,
-		}
-
-		if v := os.Getenv(envvar.AssumeRoleDuration); v != "" {
-			d, err := strconv.Atoi(v)
-			if err != nil {
-				return nil, fmt.Errorf("environment variable %s: %w", envvar.AssumeRoleDuration, err)
-			}
-			ar.Duration = time.Duration(d) * time.Second
+		ar, err := sweeperAssumeRole(role)
+		if err != nil {
+			return nil, err
 		}
 
-		if v := os.Getenv(envvar.AssumeRoleExternalID); v != "" {
-			ar.ExternalID = v
-		}
+		conf.AssumeRole = append(conf.AssumeRole, ar)
+	}
 
-		if v := os.Getenv(envvar.AssumeRoleSessionName); v != "" {
-			ar.SessionName = v
+	if role := key.roleARN; role != "" {
+		ar, err := sweeperAccountAssumeRole(role, len(conf.AssumeRole) > 0)
+		if err != nil {
+			return nil, err
 		}
 
-		conf.AssumeRole = []awsbase.AssumeRole{ar}
+		conf.AssumeRole = append(conf.AssumeRole, ar)
 	}
 
 	// configures a default client for the region, using the above env vars
@@ -95,29 +117,237 @@ func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSCl
 		return nil, fmt.Errorf("getting AWS client: %#v", diags)
 	}
 
-	sweeperClients[region] = client
+	sweeperClients[key] = client
 
 	return client, nil
 }
 
+// sweeperAssumeRole returns the configuration for assuming the IAM role configured for all sweepers.
+func sweeperAssumeRole(role string) (awsbase.AssumeRole, error) {
+	duration, err := sweeperAssumeRoleDuration()
+	if err != nil {
+		return awsbase.AssumeRole{}, err
+	}
+
+	ar := awsbase.AssumeRole{
+		RoleARN:  role,
+		Duration: duration,
+	}
+
+	if v := os.Getenv(envvar.AssumeRoleExternalID); v != "" {
+		ar.ExternalID = v
+	}
+
+	if v := os.Getenv(envvar.AssumeRoleSessionName); v != "" {
+		ar.SessionName = v
+	}
+
+	return ar, nil
+}
+
+// sweeperAccountAssumeRole returns the configuration for assuming the IAM role in the account being swept.
+// The external ID and session name configured for all sweepers are not used, as they are specific to that role.
+// If the role is assumed using another role's credentials (role chaining), the session duration is at most one hour.
+func sweeperAccountAssumeRole(role string, chained bool) (awsbase.AssumeRole, error) {
+	duration, err := sweeperAssumeRoleDuration()
+	if err != nil {
+		return awsbase.AssumeRole{}, err
+	}
+
+	if maxDuration := time.Duration(maxChainedAssumeRoleDurationSeconds) * time.Second; chained && duration > maxDuration {
+		duration = maxDuration
+	}
+
+	return awsbase.AssumeRole{
+		RoleARN:  role,
+		Duration: duration,
+	}, nil
+}
+
+func sweeperAssumeRoleDuration() (time.Duration, error) {
+	if v := os.Getenv(envvar.AssumeRoleDuration); v != "" {
+		d, err := strconv.Atoi(v)
+		if err != nil {
+			return 0, fmt.Errorf("environment variable %s: %w", envvar.AssumeRoleDuration, err)
+		}
+
+		return time.Duration(d) * time.Second, nil
+	}
+
+	return time.Duration(defaultSweeperAssumeRoleDurationSeconds) * time.Second, nil
+}
+
 type Sweepable interface {
 	Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error
 }
 
+// Describable is implemented by Sweepables that can describe the resource they sweep.
+// Descriptions are used to filter resources and in sweep reports.
+type Describable interface {
+	Metadata(ctx context.Context) metadata.Resource
+}
+
+// SweepOrchestrator sweeps resources using the sweeper options configured using environment variables.
 func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tfresource.OptionsFunc) error {
+	options, err := OptionsFromEnv()
+	if err != nil {
+		return err
+	}
+
+	return SweepOrchestratorWithOptions(ctx, sweepables, options, optFns...)
+}
+
+// SweepOrchestratorWithOptions sweeps resources using the specified sweeper options.
+// Resources are filtered and then deleted by a pool of workers.
+func SweepOrchestratorWithOptions(ctx context.Context, sweepables []Sweepable, options Options, optFns ...tfresource.OptionsFunc) error {
 	if len(sweepables) == 0 {
 		tflog.Info(ctx, "No resources to sweep")
 	}
 
-	var g multierror.Group
+	sweepReport, err := reportFor(options.ReportFile)
+	if err != nil {
+		return fmt.Errorf("reading sweep report (%s): %w", options.ReportFile, err)
+	}
+
+	type work struct {
+		entry     reportEntry
+		sweepable Sweepable
+	}
+	var queue []work
 
 	for _, sweepable := range sweepables {
+		r := describe(ctx, sweepable)
+		entry := newReportEntry(ctx, r)
+
+		if !shouldDelete(ctx, sweepReport, options, entry, r) {
+			continue
+		}
+
+		queue = append(queue, work{
+			entry:     entry,
+			sweepable: sweepable,
+		})
+	}
+
+	workers := options.Concurrency
+	if workers <= 0 || workers > len(queue) {
+		workers = len(queue)
+	}
+
+	c := make(chan work)
+	var g multierror.Group
+
+	for range workers {
 		g.Go(func() error {
-			return sweepable.Delete(ctx, optFns...)
+			var errs *multierror.Error
+
+			for w := range c {
+				if err := w.sweepable.Delete(ctx, optFns...); err != nil {
+					sweepReport.failed(w.entry, err)
+					errs = multierror.Append(errs, err)
+				} else {
+					sweepReport.deleted(w.entry)
+				}
+			}
+
+			return errs.ErrorOrNil()
+		})
+	}
+
+	for _, w := range queue {
+		c <- w
+	}
+	close(c)
+
+	err = g.Wait().ErrorOrNil()
+
+	writeReport(ctx, sweepReport, options)
+
+	return err
+}
+
+// ShouldDelete returns whether a resource that a sweeper deletes directly, rather than by returning a Sweepable to SweepOrchestrator,
+// is to be deleted using the sweeper options configured using environment variables.
+// Resources that are filtered out, or that are not deleted because of a dry run, are recorded in the sweep report.
+// Sweepers must call ShouldDelete before each direct delete.
+func ShouldDelete(ctx context.Context, r metadata.Resource) bool {
+	options, err := OptionsFromEnv()
+	if err != nil {
+		tflog.Error(ctx, "getting sweeper options", map[string]any{
+			"error": err.Error(),
+		})
+		return false
+	}
+
+	sweepReport, err := reportFor(options.ReportFile)
+	if err != nil {
+		tflog.Error(ctx, "reading sweep report", map[string]any{
+			"error": err.Error(),
+		})
+		return false
+	}
+
+	if r.TypeName == "" {
+		r.TypeName = resourceTypeFromContext(ctx)
+	}
+
+	if shouldDelete(ctx, sweepReport, options, newReportEntry(ctx, r), r) {
+		return true
+	}
+
+	writeReport(ctx, sweepReport, options)
+
+	return false
+}
+
+// shouldDelete returns whether a resource is to be deleted, recording it in the sweep report if not.
+func shouldDelete(ctx context.Context, sweepReport *report, options Options, entry reportEntry, r metadata.Resource) bool {
+	ctx = tflog.SetField(ctx, "id", r.ID)
+
+	if reason := options.skipReason(r); reason != "" {
+		tflog.Info(ctx, "Skipping resource", map[string]any{
+			"reason": reason,
 		})
+		sweepReport.skipped(entry, reason)
+		return false
+	}
+
+	if options.DryRun {
+		tflog.Info(ctx, "Dry run: would sweep resource", map[string]any{
+			"name": r.Name,
+			"type": r.TypeName,
+		})
+		sweepReport.wouldDelete(entry)
+		return false
+	}
+
+	return true
+}
+
+func writeReport(ctx context.Context, sweepReport *report, options Options) {
+	if options.ReportFile == "" {
+		return
+	}
+
+	if err := sweepReport.write(options.ReportFile); err != nil {
+		tflog.Warn(ctx, "writing sweep report", map[string]any{
+			"error": err.Error(),
+		})
+	}
+}
+
+// describe returns the metadata of the resource swept by a Sweepable.
+func describe(ctx context.Context, sweepable Sweepable) metadata.Resource {
+	var r metadata.Resource
+
+	if v, ok := sweepable.(Describable); ok {
+		r = v.Metadata(ctx)
+	}
+	if r.TypeName == "" {
+		r.TypeName = resourceTypeFromContext(ctx)
 	}
 
-	return g.Wait().ErrorOrNil()
+	return r
 }
 
 type SweeperFn func(ctx context.Context, client *conns.AWSClient) ([]Sweepable, error)
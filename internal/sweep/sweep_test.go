@@ -7,7 +7,7 @@ import (
 	"context"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
@@ -15,8 +15,17 @@ func TestMain(m *testing.M) {
 	ctx := context.Background()
 
 	sweep.ServicePackages = servicePackages(ctx)
+	sweep.RegionsInPartition = acctest.RegionsInPartition
 
 	registerSweepers()
 
-	resource.TestMain(m)
+	sweep.TestMain(m)
+}
+
+func TestValidateSweepers(t *testing.T) {
+	t.Parallel()
+
+	if err := sweep.ValidateSweepers(); err != nil {
+		t.Fatal(err)
+	}
 }
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sync
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"sync"
+
+	testing "github.com/mitchellh/go-testing-interface"
+)
+
+var namedSemaphores = struct {
+	sync.Mutex
+	store map[string]*Semaphore
+}{
+	store: make(map[string]*Semaphore),
+}
+
+// GetSemaphore returns the named Semaphore, creating it on first use.
+// Its capacity is the value of the environment variable, if set, otherwise the default.
+func GetSemaphore(key, envvar string, defaultLimit int) *Semaphore {
+	namedSemaphores.Lock()
+	defer namedSemaphores.Unlock()
+
+	semaphore, ok := namedSemaphores.store[key]
+	if !ok {
+		limit := defaultLimit
+		if v := os.Getenv(envvar); v != "" {
+			if v, err := strconv.Atoi(v); err == nil {
+				limit = v
+			}
+		}
+
+		semaphore = NewSemaphore(limit)
+		namedSemaphores.store[key] = semaphore
+	}
+
+	return semaphore
+}
+
+// TestAccPreCheckSyncronize acquires the Semaphore for the duration of the test.
+// The test is skipped if the Semaphore has no capacity.
+func TestAccPreCheckSyncronize(t testing.T, semaphore *Semaphore, resource string) {
+	if semaphore.Capacity() == 0 {
+		t.Skipf("concurrency for %s testing set to 0", resource)
+	}
+
+	if err := semaphore.Acquire(context.Background()); err != nil {
+		t.Fatalf("acquiring %s concurrency: %s", resource, err)
+	}
+	t.Cleanup(semaphore.Release)
+}
@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sync
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RateLimiter is a token bucket rate limiter.
+// Tokens are added at a fixed rate up to the bucket capacity (burst).
+// A RateLimiter with a zero rate does not limit.
+type RateLimiter struct {
+	lock     sync.Mutex
+	interval time.Duration // Time to add one token.
+	burst    float64
+	tokens   float64
+	last     time.Time
+	now      func() time.Time
+}
+
+// NewRateLimiter returns a new RateLimiter allowing the specified number of events per second with the specified burst.
+// The burst is at least 1.
+func NewRateLimiter(rate float64, burst int) *RateLimiter {
+	l := &RateLimiter{
+		burst: float64(max(burst, 1)),
+		now:   time.Now,
+	}
+
+	if rate > 0 {
+		l.interval = time.Duration(float64(time.Second) / rate)
+	}
+	l.tokens = l.burst
+
+	return l
+}
+
+// Wait blocks until an event is allowed, returning an error if the context is done first.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	if l.interval == 0 {
+		return nil
+	}
+
+	delay := l.reserve()
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		l.cancel()
+		return ctx.Err()
+	}
+}
+
+// Allow takes a token and returns true if one is available now, otherwise it returns false. It never waits.
+func (l *RateLimiter) Allow() bool {
+	if l.interval == 0 {
+		return true
+	}
+
+	if l.reserve() <= 0 {
+		return true
+	}
+
+	l.cancel()
+
+	return false
+}
+
+// reserve takes a token, returning how long the caller must wait before it is available.
+func (l *RateLimiter) reserve() time.Duration {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := l.now()
+	if !l.last.IsZero() {
+		l.tokens = min(l.burst, l.tokens+float64(now.Sub(l.last))/float64(l.interval))
+	}
+	l.last = now
+	l.tokens--
+
+	if l.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-l.tokens * float64(l.interval))
+}
+
+// cancel returns a reserved token.
+func (l *RateLimiter) cancel() {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.tokens = min(l.burst, l.tokens+1)
+}
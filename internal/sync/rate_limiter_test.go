@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sync
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterReserve(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := NewRateLimiter(10, 2) // 1 token every 100ms.
+	l.now = func() time.Time { return now }
+
+	for i, want := range []time.Duration{0, 0, 100 * time.Millisecond, 200 * time.Millisecond} {
+		if got := l.reserve(); got != want {
+			t.Errorf("reserve() #%d = %s, want %s", i, got, want)
+		}
+	}
+
+	// Tokens are replenished over time.
+	now = now.Add(time.Second)
+	for i, want := range []time.Duration{0, 0, 100 * time.Millisecond} {
+		if got := l.reserve(); got != want {
+			t.Errorf("reserve() after 1s #%d = %s, want %s", i, got, want)
+		}
+	}
+}
+
+func TestRateLimiterWait(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	l := NewRateLimiter(1, 1)
+
+	if err := l.Wait(ctx); err != nil {
+		t.Fatalf("Wait: %s", err)
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if err := l.Wait(ctx2); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Wait: got %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestRateLimiterWait_unlimited(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	l := NewRateLimiter(0, 0)
+
+	for range 100 {
+		if err := l.Wait(ctx); err != nil {
+			t.Fatalf("Wait: %s", err)
+		}
+	}
+}
+
+func TestRateLimiterAllow(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := NewRateLimiter(2, 1) // 1 token every 500ms.
+	l.now = func() time.Time { return now }
+
+	for i, want := range []bool{true, false, false} {
+		if got := l.Allow(); got != want {
+			t.Errorf("Allow() #%d = %t, want %t", i, got, want)
+		}
+	}
+
+	// A denied call doesn't consume a token.
+	now = now.Add(500 * time.Millisecond)
+	for i, want := range []bool{true, false} {
+		if got := l.Allow(); got != want {
+			t.Errorf("Allow() after 500ms #%d = %t, want %t", i, got, want)
+		}
+	}
+
+	unlimited := NewRateLimiter(0, 0)
+	for range 100 {
+		if !unlimited.Allow() {
+			t.Fatal("Allow() = false, want true")
+		}
+	}
+}
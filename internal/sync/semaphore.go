@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sync
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// Semaphore limits the number of concurrent executions.
+// A Semaphore with zero capacity does not limit concurrency.
+type Semaphore struct {
+	c    chan struct{}
+	held atomic.Int64
+}
+
+// NewSemaphore returns a new Semaphore with the specified capacity.
+func NewSemaphore(capacity int) *Semaphore {
+	s := &Semaphore{}
+
+	if capacity > 0 {
+		s.c = make(chan struct{}, capacity)
+	}
+
+	return s
+}
+
+// Acquire waits for capacity, returning an error if the context is done first.
+// Every successful Acquire must be matched by a Release.
+func (s *Semaphore) Acquire(ctx context.Context) error {
+	if s.c != nil {
+		select {
+		case s.c <- struct{}{}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	s.held.Add(1)
+
+	return nil
+}
+
+// Release releases capacity acquired by Acquire.
+// Release panics if there is no matching Acquire.
+func (s *Semaphore) Release() {
+	if s.held.Add(-1) < 0 {
+		s.held.Add(1)
+		panic("sync: Release without matching Acquire")
+	}
+
+	if s.c != nil {
+		<-s.c
+	}
+}
+
+// Capacity returns the Semaphore's capacity.
+func (s *Semaphore) Capacity() int {
+	return cap(s.c)
+}
@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sync
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestSemaphore(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := NewSemaphore(2)
+
+	if got, want := s.Capacity(), 2; got != want {
+		t.Errorf("Capacity() = %d, want %d", got, want)
+	}
+
+	for range 2 {
+		if err := s.Acquire(ctx); err != nil {
+			t.Fatalf("Acquire: %s", err)
+		}
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if err := s.Acquire(ctx2); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Acquire at capacity: got %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	s.Release()
+	if err := s.Acquire(ctx); err != nil {
+		t.Errorf("Acquire after Release: %s", err)
+	}
+
+	for range 2 {
+		s.Release()
+	}
+
+	assertPanics(t, "Release without Acquire", s.Release)
+}
+
+func TestSemaphore_unlimited(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := NewSemaphore(0)
+
+	for range 100 {
+		if err := s.Acquire(ctx); err != nil {
+			t.Fatalf("Acquire: %s", err)
+		}
+	}
+	for range 100 {
+		s.Release()
+	}
+
+	assertPanics(t, "Release without Acquire", s.Release)
+}
+
+func assertPanics(t *testing.T, name string, f func()) {
+	t.Helper()
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("%s: expected panic", name)
+		}
+	}()
+
+	f()
+}
@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+const (
+	// PolicyPrecedenceProvider means a key's value in the provider `default_tags` takes precedence.
+	PolicyPrecedenceProvider = "provider"
+	// PolicyPrecedenceResource means a key's value in the resource `tags` takes precedence.
+	PolicyPrecedenceResource = "resource"
+)
+
+// PolicyPrecedence_Values returns all valid tag policy precedence values.
+func PolicyPrecedence_Values() []string {
+	return []string{
+		PolicyPrecedenceProvider,
+		PolicyPrecedenceResource,
+	}
+}
+
+// PolicyConfig contains the provider-wide tag policy.
+type PolicyConfig struct {
+	CaseSensitive bool
+	Tags          []PolicyTag
+}
+
+// PolicyTag contains the policy rules for a single tag key.
+type PolicyTag struct {
+	// AllowedValuePatterns, if any, constrain the tag's value.
+	// The value must match at least one pattern.
+	// Patterns are compiled using PolicyConfig.CompileValuePattern.
+	AllowedValuePatterns []*regexp.Regexp
+	Key                  string
+	Precedence           string
+	Required             bool
+}
+
+// CompileValuePattern compiles an allowed value pattern, honoring the policy's case-sensitivity.
+func (pc *PolicyConfig) CompileValuePattern(pattern string) (*regexp.Regexp, error) {
+	if !pc.CaseSensitive {
+		pattern = `(?i)` + pattern
+	}
+
+	return regexp.Compile(pattern)
+}
+
+// MergeTags returns the given resource tags merged with any provider default tags.
+// A resource's value overrides the default unless the policy gives precedence to the default,
+// in which case any resource tag matching the key is replaced by the default.
+func (pc *PolicyConfig) MergeTags(tags KeyValueTags, dc *DefaultConfig) KeyValueTags {
+	allTags := dc.MergeTags(tags)
+
+	if pc == nil {
+		return allTags
+	}
+
+	for _, policyTag := range pc.Tags {
+		if policyTag.Precedence != PolicyPrecedenceProvider {
+			continue
+		}
+
+		defaultKey, _, ok := pc.lookup(dc.GetTags(), policyTag.Key)
+		if !ok {
+			continue
+		}
+
+		result := make(KeyValueTags, len(allTags))
+		for k, v := range allTags {
+			if !pc.keyEqual(k, policyTag.Key) {
+				result[k] = v
+			}
+		}
+		result[defaultKey] = dc.GetTags()[defaultKey]
+		allTags = result
+	}
+
+	return allTags
+}
+
+// Validate checks the given resource tags, merged with any provider default tags,
+// against the tag policy and returns all policy violations.
+func (pc *PolicyConfig) Validate(tags KeyValueTags, dc *DefaultConfig) []error {
+	if pc == nil {
+		return nil
+	}
+
+	var errs []error
+	allTags := pc.MergeTags(tags, dc)
+
+	for _, policyTag := range pc.Tags {
+		key, value, ok := pc.lookup(allTags, policyTag.Key)
+
+		if !ok {
+			if policyTag.Required {
+				errs = append(errs, fmt.Errorf("required tag %q is missing", policyTag.Key))
+			}
+			continue
+		}
+
+		if len(policyTag.AllowedValuePatterns) > 0 && !slices.ContainsFunc(policyTag.AllowedValuePatterns, func(re *regexp.Regexp) bool {
+			return re.MatchString(value)
+		}) {
+			errs = append(errs, fmt.Errorf("tag %q value (%s) does not match any allowed pattern", key, value))
+		}
+	}
+
+	return errs
+}
+
+// lookup returns the key and value of the tag matching key, honoring the policy's case-sensitivity.
+func (pc *PolicyConfig) lookup(tags KeyValueTags, key string) (string, string, bool) {
+	for k, v := range tags.Map() {
+		if pc.keyEqual(k, key) {
+			return k, v, true
+		}
+	}
+
+	return "", "", false
+}
+
+func (pc *PolicyConfig) keyEqual(k1, k2 string) bool {
+	if pc.CaseSensitive {
+		return k1 == k2
+	}
+
+	return strings.EqualFold(k1, k2)
+}
@@ -0,0 +1,298 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"regexp"
+	"testing"
+)
+
+func TestPolicyConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		Description   string
+		PolicyConfig  *PolicyConfig
+		DefaultConfig *DefaultConfig
+		Tags          KeyValueTags
+		ExpectedErrs  int
+	}{
+		{
+			Description: "nil policy",
+			Tags:        New(ctx, map[string]string{"key1": "value1"}),
+		},
+		{
+			Description: "required tag present",
+			PolicyConfig: &PolicyConfig{
+				CaseSensitive: true,
+				Tags:          []PolicyTag{{Key: "Owner", Required: true}},
+			},
+			Tags: New(ctx, map[string]string{"Owner": "team"}),
+		},
+		{
+			Description: "required tag from default tags",
+			PolicyConfig: &PolicyConfig{
+				CaseSensitive: true,
+				Tags:          []PolicyTag{{Key: "Owner", Required: true}},
+			},
+			DefaultConfig: &DefaultConfig{Tags: New(ctx, map[string]string{"Owner": "team"})},
+			Tags:          New(ctx, map[string]string{}),
+		},
+		{
+			Description: "required tag missing",
+			PolicyConfig: &PolicyConfig{
+				CaseSensitive: true,
+				Tags:          []PolicyTag{{Key: "Owner", Required: true}},
+			},
+			Tags:         New(ctx, map[string]string{"owner": "team"}),
+			ExpectedErrs: 1,
+		},
+		{
+			Description: "required tag case-insensitive",
+			PolicyConfig: &PolicyConfig{
+				Tags: []PolicyTag{{Key: "Owner", Required: true}},
+			},
+			Tags: New(ctx, map[string]string{"owner": "team"}),
+		},
+		{
+			Description: "allowed value",
+			PolicyConfig: &PolicyConfig{
+				CaseSensitive: true,
+				Tags: []PolicyTag{{
+					AllowedValuePatterns: []*regexp.Regexp{regexp.MustCompile(`^dev$`), regexp.MustCompile(`^prod$`)},
+					Key:                  "Env",
+				}},
+			},
+			Tags: New(ctx, map[string]string{"Env": "prod"}),
+		},
+		{
+			Description: "disallowed value",
+			PolicyConfig: &PolicyConfig{
+				CaseSensitive: true,
+				Tags: []PolicyTag{{
+					AllowedValuePatterns: []*regexp.Regexp{regexp.MustCompile(`^dev$`), regexp.MustCompile(`^prod$`)},
+					Key:                  "Env",
+				}},
+			},
+			Tags:         New(ctx, map[string]string{"Env": "PROD"}),
+			ExpectedErrs: 1,
+		},
+		{
+			Description: "allowed value case-insensitive",
+			PolicyConfig: &PolicyConfig{
+				Tags: []PolicyTag{{
+					AllowedValuePatterns: []*regexp.Regexp{regexp.MustCompile(`(?i)^prod$`)},
+					Key:                  "Env",
+				}},
+			},
+			Tags: New(ctx, map[string]string{"env": "PROD"}),
+		},
+		{
+			Description: "resource wins",
+			PolicyConfig: &PolicyConfig{
+				CaseSensitive: true,
+				Tags:          []PolicyTag{{Key: "Env", Precedence: PolicyPrecedenceResource}},
+			},
+			DefaultConfig: &DefaultConfig{Tags: New(ctx, map[string]string{"Env": "prod"})},
+			Tags:          New(ctx, map[string]string{"Env": "dev"}),
+		},
+		{
+			Description: "provider wins same value",
+			PolicyConfig: &PolicyConfig{
+				CaseSensitive: true,
+				Tags:          []PolicyTag{{Key: "Env", Precedence: PolicyPrecedenceProvider}},
+			},
+			DefaultConfig: &DefaultConfig{Tags: New(ctx, map[string]string{"Env": "prod"})},
+			Tags:          New(ctx, map[string]string{"Env": "prod"}),
+		},
+		{
+			Description: "provider wins overridden",
+			PolicyConfig: &PolicyConfig{
+				CaseSensitive: true,
+				Tags:          []PolicyTag{{Key: "Env", Precedence: PolicyPrecedenceProvider}},
+			},
+			DefaultConfig: &DefaultConfig{Tags: New(ctx, map[string]string{"Env": "prod"})},
+			Tags:          New(ctx, map[string]string{"Env": "dev"}),
+		},
+		{
+			Description: "provider wins overridden disallowed value",
+			PolicyConfig: &PolicyConfig{
+				CaseSensitive: true,
+				Tags: []PolicyTag{{
+					AllowedValuePatterns: []*regexp.Regexp{regexp.MustCompile(`^prod$`)},
+					Key:                  "Env",
+					Precedence:           PolicyPrecedenceProvider,
+				}},
+			},
+			DefaultConfig: &DefaultConfig{Tags: New(ctx, map[string]string{"Env": "prod"})},
+			Tags:          New(ctx, map[string]string{"Env": "dev"}),
+		},
+		{
+			Description: "provider wins overridden case-insensitive",
+			PolicyConfig: &PolicyConfig{
+				Tags: []PolicyTag{{Key: "Env", Precedence: PolicyPrecedenceProvider}},
+			},
+			DefaultConfig: &DefaultConfig{Tags: New(ctx, map[string]string{"Env": "prod"})},
+			Tags:          New(ctx, map[string]string{"env": "prod"}),
+		},
+		{
+			Description: "provider wins not in default tags",
+			PolicyConfig: &PolicyConfig{
+				CaseSensitive: true,
+				Tags:          []PolicyTag{{Key: "Env", Precedence: PolicyPrecedenceProvider}},
+			},
+			Tags: New(ctx, map[string]string{"Env": "dev"}),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Description, func(t *testing.T) {
+			t.Parallel()
+
+			errs := testCase.PolicyConfig.Validate(testCase.Tags, testCase.DefaultConfig)
+
+			if got, want := len(errs), testCase.ExpectedErrs; got != want {
+				t.Errorf("got %d errors, expected %d: %v", got, want, errs)
+			}
+		})
+	}
+}
+
+func TestPolicyConfigMergeTags(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		Description   string
+		PolicyConfig  *PolicyConfig
+		DefaultConfig *DefaultConfig
+		Tags          KeyValueTags
+		Expected      map[string]string
+	}{
+		{
+			Description:   "nil policy",
+			DefaultConfig: &DefaultConfig{Tags: New(ctx, map[string]string{"Env": "prod", "Owner": "team"})},
+			Tags:          New(ctx, map[string]string{"Env": "dev"}),
+			Expected:      map[string]string{"Env": "dev", "Owner": "team"},
+		},
+		{
+			Description: "resource wins",
+			PolicyConfig: &PolicyConfig{
+				CaseSensitive: true,
+				Tags:          []PolicyTag{{Key: "Env", Precedence: PolicyPrecedenceResource}},
+			},
+			DefaultConfig: &DefaultConfig{Tags: New(ctx, map[string]string{"Env": "prod"})},
+			Tags:          New(ctx, map[string]string{"Env": "dev"}),
+			Expected:      map[string]string{"Env": "dev"},
+		},
+		{
+			Description: "provider wins",
+			PolicyConfig: &PolicyConfig{
+				CaseSensitive: true,
+				Tags:          []PolicyTag{{Key: "Env", Precedence: PolicyPrecedenceProvider}},
+			},
+			DefaultConfig: &DefaultConfig{Tags: New(ctx, map[string]string{"Env": "prod"})},
+			Tags:          New(ctx, map[string]string{"Env": "dev", "Name": "test"}),
+			Expected:      map[string]string{"Env": "prod", "Name": "test"},
+		},
+		{
+			Description: "provider wins case-sensitive",
+			PolicyConfig: &PolicyConfig{
+				CaseSensitive: true,
+				Tags:          []PolicyTag{{Key: "Env", Precedence: PolicyPrecedenceProvider}},
+			},
+			DefaultConfig: &DefaultConfig{Tags: New(ctx, map[string]string{"Env": "prod"})},
+			Tags:          New(ctx, map[string]string{"env": "dev"}),
+			Expected:      map[string]string{"Env": "prod", "env": "dev"},
+		},
+		{
+			Description: "provider wins case-insensitive",
+			PolicyConfig: &PolicyConfig{
+				Tags: []PolicyTag{{Key: "Env", Precedence: PolicyPrecedenceProvider}},
+			},
+			DefaultConfig: &DefaultConfig{Tags: New(ctx, map[string]string{"Env": "prod"})},
+			Tags:          New(ctx, map[string]string{"env": "dev"}),
+			Expected:      map[string]string{"Env": "prod"},
+		},
+		{
+			Description: "provider wins not in default tags",
+			PolicyConfig: &PolicyConfig{
+				CaseSensitive: true,
+				Tags:          []PolicyTag{{Key: "Env", Precedence: PolicyPrecedenceProvider}},
+			},
+			Tags:     New(ctx, map[string]string{"Env": "dev"}),
+			Expected: map[string]string{"Env": "dev"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Description, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.PolicyConfig.MergeTags(testCase.Tags, testCase.DefaultConfig)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.Expected)
+		})
+	}
+}
+
+func TestPolicyConfigCompileValuePattern(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Description   string
+		CaseSensitive bool
+		Pattern       string
+		Value         string
+		ExpectedMatch bool
+		ExpectedErr   bool
+	}{
+		{
+			Description:   "case-sensitive match",
+			CaseSensitive: true,
+			Pattern:       `^prod$`,
+			Value:         "prod",
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "case-sensitive no match",
+			CaseSensitive: true,
+			Pattern:       `^prod$`,
+			Value:         "PROD",
+		},
+		{
+			Description:   "case-insensitive match",
+			Pattern:       `^prod$`,
+			Value:         "PROD",
+			ExpectedMatch: true,
+		},
+		{
+			Description: "invalid pattern",
+			Pattern:     `^prod(`,
+			ExpectedErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Description, func(t *testing.T) {
+			t.Parallel()
+
+			pc := &PolicyConfig{CaseSensitive: testCase.CaseSensitive}
+			re, err := pc.CompileValuePattern(testCase.Pattern)
+
+			if got, want := err != nil, testCase.ExpectedErr; got != want {
+				t.Fatalf("got error %v, expected error %t", err, want)
+			}
+			if err != nil {
+				return
+			}
+
+			if got, want := re.MatchString(testCase.Value), testCase.ExpectedMatch; got != want {
+				t.Errorf("got match %t, expected %t", got, want)
+			}
+		})
+	}
+}
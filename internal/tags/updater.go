@@ -12,3 +12,8 @@ type ServiceTagUpdater interface {
 type ResourceTypeTagUpdater interface {
 	UpdateTags(ctx context.Context, meta any, identifier, resourceType string, oldTags, newTags any) error
 }
+
+// ServiceTagsPropagationWaiter is implemented by service packages whose tag updates are eventually consistent.
+type ServiceTagsPropagationWaiter interface {
+	WaitTagsPropagated(ctx context.Context, meta any, identifier string, tags any) error
+}
@@ -4,14 +4,21 @@
 package tfresource_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	logstypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fakeaws"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -393,3 +400,85 @@ func TestOptionsApply(t *testing.T) {
 		})
 	}
 }
+
+func TestRetryWhen_eventualConsistency(t *testing.T) {
+	t.Parallel()
+
+	notFound := fakeaws.Error(http.StatusBadRequest, "ResourceNotFoundException", "The specified log group does not exist.")
+	testCases := []struct {
+		Name          string
+		Handler       fakeaws.Handler
+		Retry         func(context.Context, func() (any, error)) (any, error)
+		ExpectedCalls int
+		ExpectError   bool
+	}{
+		{
+			Name:    "error code",
+			Handler: fakeaws.EventuallyConsistent(2, notFound, fakeaws.EmptyResponse()),
+			Retry: func(ctx context.Context, f func() (any, error)) (any, error) {
+				return tfresource.RetryWhenAWSErrCodeEquals(ctx, 1*time.Minute, f, "ResourceNotFoundException")
+			},
+			ExpectedCalls: 3,
+		},
+		{
+			Name:    "error type and message",
+			Handler: fakeaws.EventuallyConsistent(1, fakeaws.Error(http.StatusBadRequest, "OperationAbortedException", "Please try again."), fakeaws.EmptyResponse()),
+			Retry: func(ctx context.Context, f func() (any, error)) (any, error) {
+				return tfresource.RetryWhenIsAErrorMessageContains[*logstypes.OperationAbortedException](ctx, 1*time.Minute, f, "try again")
+			},
+			ExpectedCalls: 2,
+		},
+		{
+			Name:    "non-retryable",
+			Handler: fakeaws.EventuallyConsistent(1, fakeaws.Error(http.StatusBadRequest, "InvalidParameterException", "1 validation error detected"), fakeaws.EmptyResponse()),
+			Retry: func(ctx context.Context, f func() (any, error)) (any, error) {
+				return tfresource.RetryWhenAWSErrCodeEquals(ctx, 1*time.Minute, f, "ResourceNotFoundException")
+			},
+			ExpectedCalls: 1,
+			ExpectError:   true,
+		},
+		{
+			Name:    "timeout",
+			Handler: notFound,
+			Retry: func(ctx context.Context, f func() (any, error)) (any, error) {
+				return tfresource.RetryWhenAWSErrCodeEquals(ctx, 100*time.Millisecond, f, "ResourceNotFoundException")
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := acctest.Context(t)
+			s := fakeaws.New()
+			s.On("CloudWatch Logs", "DeleteLogGroup", testCase.Handler)
+			conn := newLogsClient(s)
+
+			_, err := testCase.Retry(ctx, func() (any, error) {
+				return conn.DeleteLogGroup(ctx, &cloudwatchlogs.DeleteLogGroupInput{LogGroupName: aws.String("test")})
+			})
+
+			if testCase.ExpectError && err == nil {
+				t.Fatal("expected error")
+			} else if !testCase.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.ExpectedCalls > 0 {
+				if got, want := len(s.Calls()), testCase.ExpectedCalls; got != want {
+					t.Errorf("calls = %d, want %d", got, want)
+				}
+			}
+		})
+	}
+}
+
+func newLogsClient(s *fakeaws.Server) *cloudwatchlogs.Client {
+	return cloudwatchlogs.New(cloudwatchlogs.Options{
+		Credentials: credentials.NewStaticCredentialsProvider("AKIAFAKEAWS", "secret", ""),
+		HTTPClient:  s.HTTPClient(),
+		Region:      fakeaws.Region,
+	})
+}
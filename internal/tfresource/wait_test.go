@@ -9,7 +9,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fakeaws"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -70,3 +73,37 @@ func TestWaitUntil(t *testing.T) { //nolint:tparallel
 		})
 	}
 }
+
+func TestWaitUntil_eventualConsistency(t *testing.T) {
+	ctx := acctest.Context(t)
+	t.Parallel()
+
+	s := fakeaws.New()
+	s.On("CloudWatch Logs", "DescribeLogGroups", fakeaws.EventuallyConsistent(2,
+		fakeaws.JSONResponse(map[string]any{"logGroups": []any{}}),
+		fakeaws.JSONResponse(map[string]any{"logGroups": []any{map[string]any{"logGroupName": "test"}}}),
+	))
+	conn := newLogsClient(s)
+
+	err := tfresource.WaitUntil(ctx, 1*time.Minute, func() (bool, error) {
+		output, err := conn.DescribeLogGroups(ctx, &cloudwatchlogs.DescribeLogGroupsInput{LogGroupNamePrefix: aws.String("test")})
+
+		if err != nil {
+			return false, err
+		}
+
+		return len(output.LogGroups) == 1, nil
+	}, tfresource.WaitOpts{
+		ContinuousTargetOccurence: 2,
+		MinTimeout:                10 * time.Millisecond,
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// 2 stale reads followed by 2 consecutive consistent reads.
+	if got, want := len(s.Calls()), 4; got != want {
+		t.Errorf("calls = %d, want %d", got, want)
+	}
+}
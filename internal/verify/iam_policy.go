@@ -0,0 +1,593 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verify
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// IAM policy grammar reference:
+// https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_grammar.html
+
+const (
+	iamPolicyEffectAllow = "Allow"
+	iamPolicyEffectDeny  = "Deny"
+
+	iamPolicyVersion2008 = "2008-10-17"
+	iamPolicyVersion2012 = "2012-10-17"
+
+	iamPolicyPrincipalTypeAWS           = "AWS"
+	iamPolicyPrincipalTypeCanonicalUser = "CanonicalUser"
+	iamPolicyPrincipalTypeFederated     = "Federated"
+	iamPolicyPrincipalTypeService       = "Service"
+
+	iamPolicyWildcard = "*"
+)
+
+var (
+	iamPolicyActionRegexp        = regexache.MustCompile(`^[^\s:]+:[^\s:]*$`)
+	iamPolicyAWSPrincipalRegexp  = regexache.MustCompile(`^(\d{12}|A[0-9A-Z]{15,127})$`)
+	iamPolicyCanonicalUserRegexp = regexache.MustCompile(`^[0-9a-f]{64}$`)
+
+	// https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_elements_condition_operators.html
+	iamPolicyConditionOperators = []string{
+		"ArnEquals",
+		"ArnLike",
+		"ArnNotEquals",
+		"ArnNotLike",
+		"BinaryEquals",
+		"Bool",
+		"DateEquals",
+		"DateGreaterThan",
+		"DateGreaterThanEquals",
+		"DateLessThan",
+		"DateLessThanEquals",
+		"DateNotEquals",
+		"IpAddress",
+		"NotIpAddress",
+		"Null",
+		"NumericEquals",
+		"NumericGreaterThan",
+		"NumericGreaterThanEquals",
+		"NumericLessThan",
+		"NumericLessThanEquals",
+		"NumericNotEquals",
+		"StringEquals",
+		"StringEqualsIgnoreCase",
+		"StringLike",
+		"StringNotEquals",
+		"StringNotEqualsIgnoreCase",
+		"StringNotLike",
+	}
+	iamPolicyConditionSetOperatorPrefixes = []string{
+		"ForAllValues:",
+		"ForAnyValue:",
+	}
+	iamPolicyPrincipalTypes = []string{
+		iamPolicyPrincipalTypeAWS,
+		iamPolicyPrincipalTypeCanonicalUser,
+		iamPolicyPrincipalTypeFederated,
+		iamPolicyPrincipalTypeService,
+	}
+)
+
+// IAMPolicyDocument is the typed model of an IAM policy document.
+// Only the elements defined by the IAM policy grammar are modeled.
+type IAMPolicyDocument struct {
+	Version    string
+	ID         string
+	Statements []*IAMPolicyStatement
+}
+
+// IAMPolicyStatement is the typed model of an IAM policy statement.
+// Absent elements are nil.
+type IAMPolicyStatement struct {
+	Sid          string
+	Effect       string
+	Principal    *IAMPolicyPrincipal
+	NotPrincipal *IAMPolicyPrincipal
+	Action       []string
+	NotAction    []string
+	Resource     []string
+	NotResource  []string
+	Condition    map[string]map[string][]string // Operator -> condition key -> values.
+
+	path string // The statement's location in the policy document, e.g. `Statement[1]`.
+}
+
+// IAMPolicyPrincipal is the typed model of an IAM policy `Principal` or `NotPrincipal` element.
+type IAMPolicyPrincipal struct {
+	Wildcard bool                // "*"
+	Values   map[string][]string // Principal type -> principals.
+}
+
+// IAMPolicyIssueSeverity is the severity of an IAM policy lint issue.
+type IAMPolicyIssueSeverity int
+
+const (
+	IAMPolicyIssueError IAMPolicyIssueSeverity = iota
+	IAMPolicyIssueWarning
+)
+
+// IAMPolicyIssue is an issue found when linting an IAM policy document.
+type IAMPolicyIssue struct {
+	Severity IAMPolicyIssueSeverity
+	Path     string // The issue's location in the policy document, e.g. `Statement[0].Action[2]`.
+	Message  string
+}
+
+func (i IAMPolicyIssue) String() string {
+	if i.Path == "" {
+		return i.Message
+	}
+
+	return fmt.Sprintf("%s: %s", i.Path, i.Message)
+}
+
+// LintIAMPolicy parses and lints the specified IAM policy document.
+// The linter is offline and errs on the side of caution: errors are only returned for elements whose
+// structure doesn't match the IAM policy grammar. Other issues, such as unknown condition operators or
+// principal types, are warnings as AWS adds new values over time. Elements that aren't part of the
+// IAM policy grammar are ignored, so that policy-like documents (e.g. IoT policies) aren't flagged.
+// Documents with a Version that isn't an IAM policy language version are not linted.
+// Invalid JSON is not reported; see ValidIAMPolicyJSON.
+func LintIAMPolicy(policy string) []IAMPolicyIssue {
+	doc, issues := ParseIAMPolicyDocument(policy)
+
+	if doc == nil {
+		return issues
+	}
+
+	if v := doc.Version; v != "" && v != iamPolicyVersion2008 && v != iamPolicyVersion2012 {
+		return nil
+	}
+
+	return append(issues, doc.Lint()...)
+}
+
+// ParseIAMPolicyDocument parses the specified IAM policy document into its typed model.
+// Elements of the wrong shape are reported as errors and otherwise ignored.
+// A nil document is returned if the policy isn't a JSON object.
+func ParseIAMPolicyDocument(policy string) (*IAMPolicyDocument, []IAMPolicyIssue) {
+	var issues []IAMPolicyIssue
+	var elements map[string]json.RawMessage
+
+	if err := json.Unmarshal([]byte(policy), &elements); err != nil {
+		return nil, issues
+	}
+
+	doc := &IAMPolicyDocument{}
+
+	if v, ok := elements["Version"]; ok {
+		if err := json.Unmarshal(v, &doc.Version); err != nil {
+			issues = append(issues, iamPolicyError("Version", "must be a string"))
+		}
+	}
+	if v, ok := elements["Id"]; ok {
+		if err := json.Unmarshal(v, &doc.ID); err != nil {
+			issues = append(issues, iamPolicyError("Id", "must be a string"))
+		}
+	}
+
+	if v, ok := elements["Statement"]; ok {
+		var statements []map[string]json.RawMessage
+		var paths []string
+
+		if err := json.Unmarshal(v, &statements); err == nil {
+			for i := range statements {
+				paths = append(paths, fmt.Sprintf("Statement[%d]", i))
+			}
+		} else {
+			var statement map[string]json.RawMessage
+			if err := json.Unmarshal(v, &statement); err != nil {
+				issues = append(issues, iamPolicyError("Statement", "must be an object or an array of objects"))
+			} else {
+				statements = append(statements, statement)
+				paths = append(paths, "Statement")
+			}
+		}
+
+		for i, v := range statements {
+			statement, diags := parseIAMPolicyStatement(paths[i], v)
+			issues = append(issues, diags...)
+			doc.Statements = append(doc.Statements, statement)
+		}
+	}
+
+	return doc, issues
+}
+
+func parseIAMPolicyStatement(path string, elements map[string]json.RawMessage) (*IAMPolicyStatement, []IAMPolicyIssue) {
+	var issues []IAMPolicyIssue
+	statement := &IAMPolicyStatement{
+		path: path,
+	}
+
+	for _, v := range []struct {
+		name   string
+		target *string
+	}{
+		{"Sid", &statement.Sid},
+		{"Effect", &statement.Effect},
+	} {
+		if raw, ok := elements[v.name]; ok {
+			if err := json.Unmarshal(raw, v.target); err != nil {
+				issues = append(issues, iamPolicyError(path+"."+v.name, "must be a string"))
+			}
+		}
+	}
+
+	for _, v := range []struct {
+		name   string
+		target *[]string
+	}{
+		{"Action", &statement.Action},
+		{"NotAction", &statement.NotAction},
+		{"Resource", &statement.Resource},
+		{"NotResource", &statement.NotResource},
+	} {
+		if raw, ok := elements[v.name]; ok {
+			values, ok := unmarshalIAMPolicyStrings(raw)
+			if !ok {
+				issues = append(issues, iamPolicyError(path+"."+v.name, "must be a string or an array of strings"))
+				continue
+			}
+			*v.target = values
+		}
+	}
+
+	for _, v := range []struct {
+		name   string
+		target **IAMPolicyPrincipal
+	}{
+		{"Principal", &statement.Principal},
+		{"NotPrincipal", &statement.NotPrincipal},
+	} {
+		if raw, ok := elements[v.name]; ok {
+			principal, ok := unmarshalIAMPolicyPrincipal(raw)
+			if !ok {
+				issues = append(issues, iamPolicyError(path+"."+v.name, `must be "*" or an object mapping principal types to principals`))
+				continue
+			}
+			*v.target = principal
+		}
+	}
+
+	if raw, ok := elements["Condition"]; ok {
+		var operators map[string]map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &operators); err != nil {
+			issues = append(issues, iamPolicyError(path+".Condition", "must be an object mapping condition operators to condition keys and values"))
+		} else {
+			statement.Condition = make(map[string]map[string][]string, len(operators))
+			for operator, keys := range operators {
+				statement.Condition[operator] = make(map[string][]string, len(keys))
+				for key, raw := range keys {
+					values, ok := unmarshalIAMPolicyConditionValues(raw)
+					if !ok {
+						issues = append(issues, iamPolicyError(fmt.Sprintf("%s.Condition.%s.%s", path, operator, key), "must be a scalar or an array of scalars"))
+						continue
+					}
+					statement.Condition[operator][key] = values
+				}
+			}
+		}
+	}
+
+	return statement, issues
+}
+
+// Lint returns any issues with the IAM policy document.
+func (d *IAMPolicyDocument) Lint() []IAMPolicyIssue {
+	var issues []IAMPolicyIssue
+
+	if d.Version == iamPolicyVersion2008 || d.Version == "" {
+		// Policy variables are only supported in version 2012-10-17.
+		if d.usesPolicyVariables() {
+			issues = append(issues, iamPolicyWarning("Version", fmt.Sprintf("policy variables are treated as literal strings unless Version is %q", iamPolicyVersion2012)))
+		}
+	}
+
+	for _, v := range d.Statements {
+		issues = append(issues, v.lint()...)
+	}
+
+	return issues
+}
+
+func (d *IAMPolicyDocument) usesPolicyVariables() bool {
+	for _, s := range d.Statements {
+		for _, v := range [][]string{s.Resource, s.NotResource} {
+			if slices.ContainsFunc(v, hasIAMPolicyVariable) {
+				return true
+			}
+		}
+		for _, keys := range s.Condition {
+			for _, v := range keys {
+				if slices.ContainsFunc(v, hasIAMPolicyVariable) {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+func (s *IAMPolicyStatement) lint() []IAMPolicyIssue {
+	var issues []IAMPolicyIssue
+
+	if s.Effect != "" && s.Effect != iamPolicyEffectAllow && s.Effect != iamPolicyEffectDeny {
+		issues = append(issues, iamPolicyWarning(s.path+".Effect", fmt.Sprintf("must be %q or %q, got %q", iamPolicyEffectAllow, iamPolicyEffectDeny, s.Effect)))
+	}
+
+	for _, v := range []struct {
+		element, notElement string
+		present, notPresent bool
+	}{
+		{"Action", "NotAction", s.Action != nil, s.NotAction != nil},
+		{"Principal", "NotPrincipal", s.Principal != nil, s.NotPrincipal != nil},
+		{"Resource", "NotResource", s.Resource != nil, s.NotResource != nil},
+	} {
+		if v.present && v.notPresent {
+			issues = append(issues, iamPolicyError(s.path, fmt.Sprintf("only one of %s or %s can be specified", v.element, v.notElement)))
+		}
+
+		// Allow with a Not* element grants more than is usually intended.
+		if v.notPresent && s.Effect == iamPolicyEffectAllow {
+			issues = append(issues, iamPolicyWarning(s.path+"."+v.notElement, fmt.Sprintf("%s with Effect %q applies to everything not listed, including anything added in the future; consider %s instead", v.notElement, iamPolicyEffectAllow, v.element)))
+		}
+	}
+
+	for _, v := range []struct {
+		name   string
+		values []string
+	}{
+		{"Action", s.Action},
+		{"NotAction", s.NotAction},
+	} {
+		for i, action := range v.values {
+			if action != iamPolicyWildcard && !iamPolicyActionRegexp.MatchString(action) {
+				issues = append(issues, iamPolicyWarning(fmt.Sprintf("%s.%s[%d]", s.path, v.name, i), fmt.Sprintf(`must be "*" or of the form "<service>:<action>", got %q`, action)))
+			}
+		}
+	}
+
+	for _, v := range []struct {
+		name   string
+		values []string
+	}{
+		{"Resource", s.Resource},
+		{"NotResource", s.NotResource},
+	} {
+		for i, resource := range v.values {
+			if err := lintIAMPolicyResource(resource); err != "" {
+				issues = append(issues, iamPolicyWarning(fmt.Sprintf("%s.%s[%d]", s.path, v.name, i), err))
+			}
+		}
+	}
+
+	for _, v := range []struct {
+		name      string
+		principal *IAMPolicyPrincipal
+	}{
+		{"Principal", s.Principal},
+		{"NotPrincipal", s.NotPrincipal},
+	} {
+		if v.principal != nil {
+			issues = append(issues, v.principal.lint(s.path+"."+v.name)...)
+		}
+	}
+
+	for operator := range s.Condition {
+		if !isValidIAMPolicyConditionOperator(operator) {
+			issues = append(issues, iamPolicyWarning(fmt.Sprintf("%s.Condition.%s", s.path, operator), fmt.Sprintf("unknown condition operator %q", operator)))
+		}
+	}
+
+	slices.SortFunc(issues, func(a, b IAMPolicyIssue) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	return issues
+}
+
+func (p *IAMPolicyPrincipal) lint(path string) []IAMPolicyIssue {
+	var issues []IAMPolicyIssue
+
+	if p.Wildcard {
+		return issues
+	}
+
+	for typ, principals := range p.Values {
+		if !slices.Contains(iamPolicyPrincipalTypes, typ) {
+			issues = append(issues, iamPolicyWarning(path+"."+typ, fmt.Sprintf("unknown principal type %q, expected one of %s", typ, strings.Join(iamPolicyPrincipalTypes, ", "))))
+			continue
+		}
+
+		for i, principal := range principals {
+			if hasIAMPolicyVariable(principal) {
+				continue
+			}
+
+			var err string
+			switch typ {
+			case iamPolicyPrincipalTypeAWS:
+				if principal != iamPolicyWildcard && !iamPolicyAWSPrincipalRegexp.MatchString(principal) {
+					if !strings.HasPrefix(principal, "arn:") {
+						err = fmt.Sprintf(`must be "*", an AWS account ID or an ARN, got %q`, principal)
+					} else {
+						err = lintIAMPolicyResource(principal)
+					}
+				}
+			case iamPolicyPrincipalTypeCanonicalUser:
+				if !iamPolicyCanonicalUserRegexp.MatchString(principal) {
+					err = fmt.Sprintf("must be a canonical user ID, got %q", principal)
+				}
+			case iamPolicyPrincipalTypeService:
+				if strings.HasPrefix(principal, "arn:") {
+					err = fmt.Sprintf(`must be a service principal, e.g. "ec2.amazonaws.com", got %q`, principal)
+				}
+			}
+
+			if err != "" {
+				issues = append(issues, iamPolicyWarning(fmt.Sprintf("%s.%s[%d]", path, typ, i), err))
+			}
+		}
+	}
+
+	return issues
+}
+
+// lintIAMPolicyResource returns a non-empty message if the specified resource is an ARN with no partition or service.
+// Other resource shapes are accepted as some services (e.g. API Gateway) support shorthand resources,
+// and ARNs may have fewer elements when wildcards are used.
+func lintIAMPolicyResource(resource string) string {
+	if !strings.HasPrefix(resource, "arn:") || hasIAMPolicyVariable(resource) {
+		return ""
+	}
+
+	// arn:partition:service:region:account-id:resource
+	if parts := strings.SplitN(resource, ":", 6); len(parts) < 3 || parts[1] == "" || parts[2] == "" {
+		return fmt.Sprintf("ARN partition and service must not be empty, got %q", resource)
+	}
+
+	return ""
+}
+
+func isValidIAMPolicyConditionOperator(operator string) bool {
+	// Condition operators are matched case-insensitively to avoid false positives.
+	operator = strings.ToLower(operator)
+
+	for _, prefix := range iamPolicyConditionSetOperatorPrefixes {
+		if v, ok := strings.CutPrefix(operator, strings.ToLower(prefix)); ok {
+			operator = v
+			break
+		}
+	}
+
+	// The Null operator has no IfExists variant.
+	if v, ok := strings.CutSuffix(operator, "ifexists"); ok && v != "null" {
+		operator = v
+	}
+
+	return slices.ContainsFunc(iamPolicyConditionOperators, func(v string) bool {
+		return strings.EqualFold(v, operator)
+	})
+}
+
+func hasIAMPolicyVariable(s string) bool {
+	return strings.Contains(s, "${")
+}
+
+func unmarshalIAMPolicyStrings(raw json.RawMessage) ([]string, bool) {
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return []string{s}, true
+	}
+
+	var ss []string
+	if err := json.Unmarshal(raw, &ss); err == nil && ss != nil {
+		return ss, true
+	}
+
+	return nil, false
+}
+
+func unmarshalIAMPolicyPrincipal(raw json.RawMessage) (*IAMPolicyPrincipal, bool) {
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		if s != iamPolicyWildcard {
+			return nil, false
+		}
+		return &IAMPolicyPrincipal{Wildcard: true}, true
+	}
+
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &m); err != nil || m == nil {
+		return nil, false
+	}
+
+	principal := &IAMPolicyPrincipal{
+		Values: make(map[string][]string, len(m)),
+	}
+	for k, v := range m {
+		values, ok := unmarshalIAMPolicyStrings(v)
+		if !ok {
+			return nil, false
+		}
+		principal.Values[k] = values
+	}
+
+	return principal, true
+}
+
+func unmarshalIAMPolicyConditionValues(raw json.RawMessage) ([]string, bool) {
+	var values []any
+	if err := json.Unmarshal(raw, &values); err != nil || values == nil {
+		var value any
+		if err := json.Unmarshal(raw, &value); err != nil {
+			return nil, false
+		}
+		values = []any{value}
+	}
+
+	var ss []string
+	for _, v := range values {
+		switch v := v.(type) {
+		case string:
+			ss = append(ss, v)
+		case bool, float64:
+			ss = append(ss, fmt.Sprint(v))
+		default:
+			return nil, false
+		}
+	}
+
+	return ss, true
+}
+
+func iamPolicyError(path, message string) IAMPolicyIssue {
+	return IAMPolicyIssue{Severity: IAMPolicyIssueError, Path: path, Message: message}
+}
+
+func iamPolicyWarning(path, message string) IAMPolicyIssue {
+	return IAMPolicyIssue{Severity: IAMPolicyIssueWarning, Path: path, Message: message}
+}
+
+// ValidIAMPolicyDocument is a SchemaValidateDiagFunc that lints an IAM policy document.
+// Unparseable documents are ignored; combine with a JSON validator.
+func ValidIAMPolicyDocument(v any, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	value, ok := v.(string)
+	if !ok {
+		return diags
+	}
+
+	for _, issue := range LintIAMPolicy(value) {
+		severity := diag.Error
+		if issue.Severity == IAMPolicyIssueWarning {
+			severity = diag.Warning
+		}
+
+		diags = append(diags, diag.Diagnostic{
+			Severity:      severity,
+			Summary:       "Invalid IAM policy",
+			Detail:        issue.String(),
+			AttributePath: path,
+		})
+	}
+
+	return diags
+}
+
+var _ schema.SchemaValidateDiagFunc = ValidIAMPolicyDocument
@@ -0,0 +1,227 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verify
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func TestLintIAMPolicy(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policy string
+		want   []IAMPolicyIssue
+	}{
+		"invalid JSON": {
+			policy: `{`,
+		},
+		"valid": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Sid": "AllowRead",
+    "Effect": "Allow",
+    "Principal": {"AWS": ["arn:aws:iam::123456789012:root", "123456789012"], "Service": "ec2.amazonaws.com"},
+    "Action": ["s3:GetObject", "s3:List*"],
+    "Resource": ["arn:aws:s3:::example/${aws:username}/*", "*"],
+    "Condition": {
+      "StringEqualsIfExists": {"aws:PrincipalTag/team": "example"},
+      "ForAnyValue:StringLike": {"aws:TagKeys": ["a*", "b*"]},
+      "Bool": {"aws:SecureTransport": false},
+      "Null": {"aws:TokenIssueTime": "true"}
+    }
+  }]
+}`,
+		},
+		"single statement": {
+			policy: `{"Version": "2012-10-17", "Statement": {"Effect": "Allow", "Action": "*", "Resource": "*"}}`,
+		},
+		"not IAM policy grammar": {
+			policy: `{"Name": "example", "Version": "2021-06-01", "Statement": [{"DataDirection": "Inbound", "Principal": ["*"], "Operation": {"Deny": {}}}]}`,
+		},
+		"unknown elements": {
+			policy: `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "iot:Connect", "Resource": "*", "Example": true}]}`,
+		},
+		"invalid Effect": {
+			policy: `{"Statement": [{"Effect": "allow", "Action": "s3:GetObject", "Resource": "*"}]}`,
+			want: []IAMPolicyIssue{
+				{Severity: IAMPolicyIssueWarning, Path: "Statement[0].Effect", Message: `must be "Allow" or "Deny", got "allow"`},
+			},
+		},
+		"invalid Action": {
+			policy: `{"Statement": [{"Effect": "Allow", "Action": ["s3:GetObject", "s3GetObject", "s3:Get Object"], "Resource": "*"}]}`,
+			want: []IAMPolicyIssue{
+				{Severity: IAMPolicyIssueWarning, Path: "Statement[0].Action[1]", Message: `must be "*" or of the form "<service>:<action>", got "s3GetObject"`},
+				{Severity: IAMPolicyIssueWarning, Path: "Statement[0].Action[2]", Message: `must be "*" or of the form "<service>:<action>", got "s3:Get Object"`},
+			},
+		},
+		"Action and NotAction": {
+			policy: `{"Statement": [{"Effect": "Deny", "Action": "s3:GetObject", "NotAction": "s3:PutObject", "Resource": "*"}]}`,
+			want: []IAMPolicyIssue{
+				{Severity: IAMPolicyIssueError, Path: "Statement[0]", Message: "only one of Action or NotAction can be specified"},
+			},
+		},
+		"Allow NotAction": {
+			policy: `{"Statement": [{"Effect": "Allow", "NotAction": "iam:*", "Resource": "*"}]}`,
+			want: []IAMPolicyIssue{
+				{Severity: IAMPolicyIssueWarning, Path: "Statement[0].NotAction", Message: `NotAction with Effect "Allow" applies to everything not listed, including anything added in the future; consider Action instead`},
+			},
+		},
+		"Deny NotAction": {
+			policy: `{"Statement": [{"Effect": "Deny", "NotAction": "iam:*", "Resource": "*"}]}`,
+		},
+		"malformed ARN": {
+			policy: `{"Statement": [{"Effect": "Allow", "Action": "s3:GetObject", "Resource": ["arn::s3:::example/*", "arn:aws"]}]}`,
+			want: []IAMPolicyIssue{
+				{Severity: IAMPolicyIssueWarning, Path: "Statement[0].Resource[0]", Message: `ARN partition and service must not be empty, got "arn::s3:::example/*"`},
+				{Severity: IAMPolicyIssueWarning, Path: "Statement[0].Resource[1]", Message: `ARN partition and service must not be empty, got "arn:aws"`},
+			},
+		},
+		"shorthand resource": {
+			policy: `{"Statement": [{"Effect": "Allow", "Principal": "*", "Action": "execute-api:Invoke", "Resource": "execute-api:/*"}]}`,
+		},
+		"invalid Resource type": {
+			policy: `{"Statement": [{"Effect": "Allow", "Action": "s3:GetObject", "Resource": {"Bucket": "example"}}]}`,
+			want: []IAMPolicyIssue{
+				{Severity: IAMPolicyIssueError, Path: "Statement[0].Resource", Message: "must be a string or an array of strings"},
+			},
+		},
+		"malformed Principal": {
+			policy: `{"Statement": [{"Effect": "Allow", "Principal": {"AWS": "example-role", "Service": "arn:aws:iam::123456789012:role/example", "User": "example"}, "Action": "sts:AssumeRole"}]}`,
+			want: []IAMPolicyIssue{
+				{Severity: IAMPolicyIssueWarning, Path: "Statement[0].Principal.AWS[0]", Message: `must be "*", an AWS account ID or an ARN, got "example-role"`},
+				{Severity: IAMPolicyIssueWarning, Path: "Statement[0].Principal.Service[0]", Message: `must be a service principal, e.g. "ec2.amazonaws.com", got "arn:aws:iam::123456789012:role/example"`},
+				{Severity: IAMPolicyIssueWarning, Path: "Statement[0].Principal.User", Message: `unknown principal type "User", expected one of AWS, CanonicalUser, Federated, Service`},
+			},
+		},
+		"service principal without domain": {
+			policy: `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Principal": {"Service": "ec2"}, "Action": "sts:AssumeRole"}]}`,
+		},
+		"ARN with fewer elements": {
+			policy: `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "s3:*", "Resource": ["arn:aws:s3:::*", "arn:aws:s3:*"]}]}`,
+		},
+		"action name formats": {
+			policy: `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": ["s3express:CreateSession", "aws-marketplace:ViewSubscriptions", "example_service:New.Action_Name"], "Resource": "*"}]}`,
+		},
+		"Principal unique ID": {
+			policy: `{"Statement": [{"Effect": "Allow", "Principal": {"AWS": "AROAEXAMPLEEXAMPLE1234"}, "Action": "sts:AssumeRole"}]}`,
+		},
+		"unknown condition operator": {
+			policy: `{"Statement": [{"Effect": "Allow", "Action": "s3:GetObject", "Resource": "*", "Condition": {"StringEqual": {"aws:username": "example"}, "NullIfExists": {"aws:username": "true"}}}]}`,
+			want: []IAMPolicyIssue{
+				{Severity: IAMPolicyIssueWarning, Path: "Statement[0].Condition.NullIfExists", Message: `unknown condition operator "NullIfExists"`},
+				{Severity: IAMPolicyIssueWarning, Path: "Statement[0].Condition.StringEqual", Message: `unknown condition operator "StringEqual"`},
+			},
+		},
+		"policy variables without Version": {
+			policy: `{"Statement": [{"Effect": "Allow", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::example/${aws:username}/*"}]}`,
+			want: []IAMPolicyIssue{
+				{Severity: IAMPolicyIssueWarning, Path: "Version", Message: `policy variables are treated as literal strings unless Version is "2012-10-17"`},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := LintIAMPolicy(testCase.policy)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestParseIAMPolicyDocument(t *testing.T) {
+	t.Parallel()
+
+	policy := `{
+  "Version": "2012-10-17",
+  "Id": "example",
+  "Statement": {
+    "Effect": "Allow",
+    "Principal": "*",
+    "NotAction": ["s3:DeleteObject"],
+    "Resource": "*",
+    "Condition": {"NumericLessThan": {"s3:max-keys": 10}}
+  }
+}`
+
+	got, issues := ParseIAMPolicyDocument(policy)
+	if len(issues) > 0 {
+		t.Fatalf("unexpected issues: %v", issues)
+	}
+
+	want := &IAMPolicyDocument{
+		Version: "2012-10-17",
+		ID:      "example",
+		Statements: []*IAMPolicyStatement{
+			{
+				Effect:    "Allow",
+				Principal: &IAMPolicyPrincipal{Wildcard: true},
+				NotAction: []string{"s3:DeleteObject"},
+				Resource:  []string{"*"},
+				Condition: map[string]map[string][]string{
+					"NumericLessThan": {"s3:max-keys": {"10"}},
+				},
+				path: "Statement",
+			},
+		},
+	}
+
+	if diff := cmp.Diff(got, want, cmp.AllowUnexported(IAMPolicyStatement{})); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestValidIAMPolicyDocument(t *testing.T) {
+	t.Parallel()
+
+	path := cty.GetAttrPath("policy")
+	policy := `{"Statement": [{"Effect": "allow", "NotAction": "iam:*", "Resource": "*"}]}`
+
+	got := ValidIAMPolicyDocument(policy, path)
+	want := diag.Diagnostics{
+		{
+			Severity:      diag.Warning,
+			Summary:       "Invalid IAM policy",
+			Detail:        `Statement[0].Effect: must be "Allow" or "Deny", got "allow"`,
+			AttributePath: path,
+		},
+	}
+
+	if diff := cmp.Diff(got, want, cmp.Comparer(func(a, b cty.Path) bool { return a.Equals(b) })); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestValidIAMPolicyDocument_noErrors(t *testing.T) {
+	t.Parallel()
+
+	// Policies that AWS accepts must not fail validation, even if they are linted.
+	testCases := map[string]string{
+		"service principal without domain": `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Principal": {"Service": "ec2"}, "Action": "sts:AssumeRole"}]}`,
+		"ARN with fewer elements":          `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "s3:*", "Resource": ["arn:aws:s3:*", "arn:aws:iam::123456789012"]}]}`,
+		"new action format":                `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "example_service:New.Action_Name", "Resource": "*"}]}`,
+		"new condition operator":           `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "s3:GetObject", "Resource": "*", "Condition": {"ExampleNewOperator": {"aws:username": "example"}}}]}`,
+		"unknown principal type":           `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Principal": {"ExampleNewType": "example"}, "Action": "sts:AssumeRole"}]}`,
+	}
+
+	for name, policy := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if diags := ValidIAMPolicyDocument(policy, cty.GetAttrPath("policy")); diags.HasError() {
+				t.Errorf("unexpected errors: %v", diags)
+			}
+		})
+	}
+}
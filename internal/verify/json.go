@@ -8,10 +8,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"maps"
 	"reflect"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -228,3 +232,231 @@ func LegacyPolicyToSet(exist, new string) (string, error) {
 
 	return policyToSet, nil
 }
+
+// CanonicalPolicy returns the canonical form of a JSON policy document.
+// Policies that PolicyStringsEquivalent considers equivalent have the same canonical form.
+// Statements and the values of Action, Resource, Principal and Condition elements are sorted,
+// single-element arrays are replaced by their element, root user ARN principals are replaced by the account ID,
+// insignificant whitespace is removed, object keys are sorted and the Version element is placed first.
+func CanonicalPolicy(policy string) (string, error) {
+	// Assume role policies can be a one-element list of JSON documents.
+	policy = strings.TrimSpace(policy)
+	if strings.HasPrefix(policy, "[") && strings.HasSuffix(policy, "]") {
+		policy = strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(policy, "["), "]"))
+	}
+
+	if policy == "" {
+		return "", nil
+	}
+
+	var document map[string]any
+	if err := json.Unmarshal([]byte(policy), &document); err != nil {
+		return "", fmt.Errorf("policy (%s) is invalid JSON: %w", policy, err)
+	}
+
+	switch v := document["Statement"].(type) {
+	case nil:
+		delete(document, "Statement")
+	case map[string]any:
+		document["Statement"] = canonicalPolicyStatements([]any{v})
+	case []any:
+		document["Statement"] = canonicalPolicyStatements(v)
+	}
+
+	keys := slices.Sorted(maps.Keys(document))
+	if i := slices.Index(keys, "Version"); i > 0 {
+		keys = slices.Insert(slices.Delete(keys, i, i+1), 0, "Version")
+	}
+
+	var b bytes.Buffer
+	b.WriteString("{")
+	for i, key := range keys {
+		if i > 0 {
+			b.WriteString(",")
+		}
+
+		k, err := marshalPolicyJSON(key)
+		if err != nil {
+			return "", err
+		}
+		v, err := marshalPolicyJSON(document[key])
+		if err != nil {
+			return "", err
+		}
+
+		b.Write(k)
+		b.WriteString(":")
+		b.Write(v)
+	}
+	b.WriteString("}")
+
+	// The canonical form must be equivalent to the original policy.
+	if equivalent, err := awspolicy.PoliciesAreEquivalent(policy, b.String()); err != nil {
+		return "", fmt.Errorf("checking equivalency of policy (%s) and its canonical form: %w", policy, err)
+	} else if !equivalent {
+		return "", fmt.Errorf("canonical form (%s) of policy (%s) is not equivalent", b.String(), policy)
+	}
+
+	return b.String(), nil
+}
+
+// marshalPolicyJSON returns the JSON encoding of v.
+// Unlike json.Marshal, the characters &, < and > (common in condition values) are not escaped.
+func marshalPolicyJSON(v any) ([]byte, error) {
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+
+	return bytes.TrimSuffix(b.Bytes(), []byte("\n")), nil
+}
+
+// canonicalPolicyStatements returns the canonical form of a policy document's statements, sorted by their JSON.
+func canonicalPolicyStatements(statements []any) []any {
+	type keyed struct {
+		key       string
+		statement any
+	}
+
+	var keyedStatements []keyed
+	for _, v := range statements {
+		if statement, ok := v.(map[string]any); ok {
+			canonicalPolicyStatement(statement)
+		}
+
+		key, _ := marshalPolicyJSON(v)
+		keyedStatements = append(keyedStatements, keyed{key: string(key), statement: v})
+	}
+
+	slices.SortStableFunc(keyedStatements, func(a, b keyed) int {
+		return strings.Compare(a.key, b.key)
+	})
+
+	statements = make([]any, 0, len(keyedStatements))
+	for _, v := range keyedStatements {
+		statements = append(statements, v.statement)
+	}
+
+	return statements
+}
+
+// canonicalPolicyStatement replaces the elements of a policy statement with their canonical form.
+func canonicalPolicyStatement(statement map[string]any) {
+	if v, ok := statement["Effect"].(string); ok {
+		for _, effect := range []string{"Allow", "Deny"} {
+			if strings.EqualFold(v, effect) {
+				statement["Effect"] = effect
+			}
+		}
+	}
+
+	// A missing element is equivalent to an empty one.
+	for _, key := range []string{"Action", "NotAction", "Resource", "NotResource"} {
+		if v, ok := policyStringSet(statement[key]); ok {
+			if len(v) == 0 {
+				delete(statement, key)
+			} else {
+				statement[key] = policyStringSetValue(v)
+			}
+		}
+	}
+
+	for _, key := range []string{"Principal", "NotPrincipal"} {
+		switch v := statement[key].(type) {
+		case string:
+			statement[key] = canonicalPolicyPrincipal(v)
+		case map[string]any:
+			for principalType, principals := range v {
+				if principals, ok := policyStringSet(principals); ok {
+					if len(principals) == 0 {
+						delete(v, principalType)
+						continue
+					}
+
+					for i, principal := range principals {
+						principals[i] = canonicalPolicyPrincipal(principal)
+					}
+					slices.Sort(principals)
+					v[principalType] = policyStringSetValue(principals)
+				}
+			}
+
+			if len(v) == 0 {
+				delete(statement, key)
+			}
+		}
+	}
+
+	if v, ok := statement["Condition"].(map[string]any); ok {
+		for _, condition := range v {
+			if condition, ok := condition.(map[string]any); ok {
+				for conditionKey, values := range condition {
+					if values, ok := policyStringSet(values); ok {
+						condition[conditionKey] = policyStringSetValue(values)
+					}
+				}
+			}
+		}
+	}
+}
+
+// canonicalPolicyPrincipal returns the account ID for an account's root user ARN, otherwise the principal.
+func canonicalPolicyPrincipal(principal string) string {
+	if v, err := arn.Parse(principal); err == nil && v.Service == "iam" && v.Resource == "root" && regexache.MustCompile(`^[0-9]{12}$`).MatchString(v.AccountID) {
+		return v.AccountID
+	}
+
+	return principal
+}
+
+// policyStringSet returns the sorted string values of a policy element that is a string, number, boolean or array of these.
+func policyStringSet(v any) ([]string, bool) {
+	switch v := v.(type) {
+	case nil:
+		return []string{}, true
+	case []any:
+		values := make([]string, 0, len(v))
+		for _, v := range v {
+			value, ok := policyStringSetMember(v)
+			if !ok {
+				return nil, false
+			}
+			values = append(values, value)
+		}
+		slices.Sort(values)
+
+		return values, true
+	default:
+		value, ok := policyStringSetMember(v)
+		if !ok {
+			return nil, false
+		}
+
+		return []string{value}, true
+	}
+}
+
+func policyStringSetMember(v any) (string, bool) {
+	switch v := v.(type) {
+	case string:
+		return v, true
+	case bool:
+		return strconv.FormatBool(v), true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	default:
+		return "", false
+	}
+}
+
+// policyStringSetValue returns the JSON value of a policy element's values. A single value is not wrapped in an array.
+func policyStringSetValue(values []string) any {
+	if len(values) == 1 {
+		return values[0]
+	}
+
+	return values
+}
@@ -851,3 +851,180 @@ func TestLegacyPolicyNormalize(t *testing.T) {
 		})
 	}
 }
+
+func TestCanonicalPolicy(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name     string
+		Input    string
+		Expected string
+		Error    bool
+	}{
+		{
+			Name:     "empty",
+			Input:    "",
+			Expected: "",
+		},
+		{
+			Name:     "empty object",
+			Input:    "{}",
+			Expected: "{}",
+		},
+		{
+			Name: "basic",
+			Input: `{
+  "Statement": [{"Resource": "*", "Effect": "Allow", "Action": "s3:GetObject"}],
+  "Version": "2012-10-17"
+}`,
+			Expected: `{"Version":"2012-10-17","Statement":[{"Action":"s3:GetObject","Effect":"Allow","Resource":"*"}]}`,
+		},
+		{
+			Name:     "single statement",
+			Input:    `{"Statement":{"Action":"*","Effect":"Allow","Resource":"*"},"Version":"2012-10-17"}`,
+			Expected: `{"Version":"2012-10-17","Statement":[{"Action":"*","Effect":"Allow","Resource":"*"}]}`,
+		},
+		{
+			Name:     "sets",
+			Input:    `{"Version":"2012-10-17","Statement":[{"Action":["s3:PutObject","s3:GetObject"],"Effect":"allow","Resource":["*"]}]}`,
+			Expected: `{"Version":"2012-10-17","Statement":[{"Action":["s3:GetObject","s3:PutObject"],"Effect":"Allow","Resource":"*"}]}`,
+		},
+		{
+			Name:     "statement order",
+			Input:    `{"Version":"2012-10-17","Statement":[{"Sid":"b","Effect":"Deny","Action":"*","Resource":"*"},{"Sid":"a","Effect":"Allow","Action":"*","Resource":"*"}]}`,
+			Expected: `{"Version":"2012-10-17","Statement":[{"Action":"*","Effect":"Allow","Resource":"*","Sid":"a"},{"Action":"*","Effect":"Deny","Resource":"*","Sid":"b"}]}`,
+		},
+		{
+			Name:     "principals",
+			Input:    `{"Version":"2012-10-17","Statement":[{"Action":"sts:AssumeRole","Effect":"Allow","Principal":{"AWS":["arn:aws:iam::123456789012:root","arn:aws:iam::123456789012:role/example"],"Service":["ec2.amazonaws.com"],"Federated":[]}}]}`, //lintignore:AWSAT005
+			Expected: `{"Version":"2012-10-17","Statement":[{"Action":"sts:AssumeRole","Effect":"Allow","Principal":{"AWS":["123456789012","arn:aws:iam::123456789012:role/example"],"Service":"ec2.amazonaws.com"}}]}`,                                    //lintignore:AWSAT005
+		},
+		{
+			Name:     "conditions",
+			Input:    `{"Version":"2012-10-17","Statement":[{"Action":"*","Effect":"Allow","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":true},"StringEquals":{"aws:PrincipalTag/team":["b","a"]}}}]}`,
+			Expected: `{"Version":"2012-10-17","Statement":[{"Action":"*","Condition":{"Bool":{"aws:SecureTransport":"true"},"StringEquals":{"aws:PrincipalTag/team":["a","b"]}},"Effect":"Allow","Resource":"*"}]}`,
+		},
+		{
+			Name:     "no HTML escaping",
+			Input:    `{"Version":"2012-10-17","Statement":[{"Action":"*","Effect":"Allow","Resource":"arn:aws:s3:::a&b/<c>","Condition":{"StringLike":{"s3:prefix":"x&y<z>"}}}]}`, //lintignore:AWSAT005
+			Expected: `{"Version":"2012-10-17","Statement":[{"Action":"*","Condition":{"StringLike":{"s3:prefix":"x&y<z>"}},"Effect":"Allow","Resource":"arn:aws:s3:::a&b/<c>"}]}`, //lintignore:AWSAT005
+		},
+		{
+			Name:  "invalid JSON",
+			Input: `{"Version":"2012-10-17",}`,
+			Error: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+
+			p, err := CanonicalPolicy(tc.Input)
+
+			if tc.Error {
+				if err == nil {
+					t.Errorf("expected an error")
+				}
+			} else {
+				if err != nil {
+					t.Errorf("expected no error, got: %s", err)
+				}
+			}
+
+			if p != tc.Expected {
+				t.Errorf("expected %s, got: %s", tc.Expected, p)
+			}
+		})
+	}
+}
+
+func TestCanonicalPolicyAgreesWithPolicyStringsEquivalent(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name    string
+		Policy1 string
+		Policy2 string
+	}{
+		{
+			Name:    "whitespace",
+			Policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*"}]}`,
+			Policy2: "{\n  \"Version\": \"2012-10-17\",\n  \"Statement\": [{\"Effect\": \"Allow\", \"Action\": \"*\", \"Resource\": \"*\"}]\n}",
+		},
+		{
+			Name:    "single element arrays",
+			Policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Policy2: `{"Statement":{"Resource":["*"],"Action":["s3:GetObject"],"Effect":"Allow"},"Version":"2012-10-17"}`,
+		},
+		{
+			Name:    "action order",
+			Policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:PutObject"],"Resource":"*"}]}`,
+			Policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:PutObject","s3:GetObject"],"Resource":"*"}]}`,
+		},
+		{
+			Name:    "statement order",
+			Policy1: `{"Version":"2012-10-17","Statement":[{"Sid":"a","Effect":"Allow","Action":"*","Resource":"*"},{"Sid":"b","Effect":"Deny","Action":"*","Resource":"*"}]}`,
+			Policy2: `{"Version":"2012-10-17","Statement":[{"Sid":"b","Effect":"Deny","Action":"*","Resource":"*"},{"Sid":"a","Effect":"Allow","Action":"*","Resource":"*"}]}`,
+		},
+		{
+			Name:    "effect case",
+			Policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*"}]}`,
+			Policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"allow","Action":"*","Resource":"*"}]}`,
+		},
+		{
+			Name:    "account ID principal",
+			Policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sts:AssumeRole","Principal":{"AWS":"123456789012"}}]}`,
+			Policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sts:AssumeRole","Principal":{"AWS":["arn:aws:iam::123456789012:root"]}}]}`, //lintignore:AWSAT005
+		},
+		{
+			Name:    "condition values",
+			Policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":"true"}}}]}`,
+			Policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":[true]}}}]}`,
+		},
+		{
+			Name:    "different effect",
+			Policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*"}]}`,
+			Policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"*","Resource":"*"}]}`,
+		},
+		{
+			Name:    "different actions",
+			Policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:PutObject"],"Resource":"*"}]}`,
+			Policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+		},
+		{
+			Name:    "different version",
+			Policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*"}]}`,
+			Policy2: `{"Version":"2008-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*"}]}`,
+		},
+		{
+			Name:    "different principal type",
+			Policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sts:AssumeRole","Principal":{"AWS":"*"}}]}`,
+			Policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sts:AssumeRole","Principal":"*"}]}`,
+		},
+		{
+			Name:    "different conditions",
+			Policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"StringEquals":{"aws:RequestedRegion":"us-west-2"}}}]}`, //lintignore:AWSAT003
+			Policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"StringLike":{"aws:RequestedRegion":"us-west-2"}}}]}`,   //lintignore:AWSAT003
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+
+			p1, err := CanonicalPolicy(tc.Policy1)
+			if err != nil {
+				t.Fatalf("canonicalizing policy 1: %s", err)
+			}
+			p2, err := CanonicalPolicy(tc.Policy2)
+			if err != nil {
+				t.Fatalf("canonicalizing policy 2: %s", err)
+			}
+
+			if got, want := p1 == p2, PolicyStringsEquivalent(tc.Policy1, tc.Policy2); got != want {
+				t.Errorf("canonical forms equal = %t, PolicyStringsEquivalent = %t\npolicy 1: %s\npolicy 2: %s", got, want, p1, p2)
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"runtime/debug"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 	"github.com/hashicorp/terraform-provider-aws/version"
 )
@@ -26,7 +27,8 @@ func main() {
 		log.Printf("Starting %s@%s (%s)...", buildInfo.Main.Path, version.ProviderVersion, buildInfo.GoVersion)
 	}
 
-	serverFactory, _, err := provider.ProtoV5ProviderServerFactory(context.Background())
+	ctx := context.Background()
+	serverFactory, primary, err := provider.ProtoV5ProviderServerFactory(ctx)
 
 	if err != nil {
 		log.Fatal(err)
@@ -44,6 +46,12 @@ func main() {
 		serveOpts...,
 	)
 
+	if v, ok := primary.Meta().(*conns.AWSClient); ok {
+		if err := v.Close(ctx); err != nil {
+			log.Printf("[WARN] Closing provider: %s", err)
+		}
+	}
+
 	if err != nil {
 		log.Fatal(err)
 	}
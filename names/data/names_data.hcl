@@ -6637,7 +6637,7 @@ service "resourcegroupstaggingapi" {
   }
 
   provider_package_correct = "resourcegroupstaggingapi"
-  doc_prefix               = ["resourcegroupstaggingapi_"]
+  doc_prefix               = ["resourcegroupstaggingapi_", "resources_by_tag", "tags"]
   brand                    = "AWS"
 }
 
@@ -7794,7 +7794,6 @@ service "sso" {
   provider_package_correct = "sso"
   doc_prefix               = ["sso_"]
   brand                    = "AWS"
-  not_implemented          = true
 }
 
 service "ssoadmin" {
@@ -7917,12 +7916,12 @@ service "sts" {
   }
 
   resource_prefix {
-    actual  = "aws_caller_identity"
+    actual  = "aws_(caller_identity|sts_)"
     correct = "aws_sts_"
   }
 
   provider_package_correct = "sts"
-  doc_prefix               = ["caller_identity"]
+  doc_prefix               = ["caller_identity", "sts_"]
   brand                    = "AWS"
 }
 
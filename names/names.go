@@ -165,6 +165,7 @@ func PartitionForRegion(region string) endpoints.Partition {
 // described in detail in README.md.
 type serviceDatum struct {
 	aliases           []string
+	awsServiceEnvVar  string
 	brand             string
 	humanFriendly     string
 	providerNameUpper string
@@ -208,6 +209,10 @@ func readHCLIntoServiceData() error {
 			providerNameUpper: l.ProviderNameUpper(),
 		}
 
+		if l.SDKID() != "" {
+			sd.awsServiceEnvVar = l.AWSServiceEnvVar()
+		}
+
 		a := []string{p}
 
 		if len(l.Aliases()) > 0 {
@@ -262,6 +267,15 @@ func ProviderNameUpper(service string) (string, error) {
 	return "", fmt.Errorf("no service data found for %s", service)
 }
 
+// AWSServiceEnvVar returns the AWS SDK environment variable used to set the service's endpoint, e.g. "AWS_ENDPOINT_URL_S3".
+func AWSServiceEnvVar(service string) (string, error) {
+	if v, ok := serviceData[service]; ok && v.awsServiceEnvVar != "" {
+		return v.awsServiceEnvVar, nil
+	}
+
+	return "", fmt.Errorf("no AWS service environment variable found for %s", service)
+}
+
 func FullHumanFriendly(service string) (string, error) {
 	if v, ok := serviceData[service]; ok {
 		if v.brand == "" {
@@ -0,0 +1,406 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package apimodel describes the operations and shapes of an AWS SDK for Go v2 service package.
+// The model is built from the package's Go source, which is located using the Go toolchain.
+package apimodel
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// Kind is the kind of a shape.
+type Kind int
+
+const (
+	KindUnsupported Kind = iota
+	KindBool
+	KindEnum
+	KindFloat64
+	KindInt32
+	KindInt64
+	KindList
+	KindMap
+	KindString
+	KindStruct
+	KindTimestamp
+	KindUnion
+)
+
+// Shape is the type of a field.
+type Shape struct {
+	Elem   *Shape // Element shape of a list or map.
+	Enum   *Enum  // Values of an enum.
+	Kind   Kind
+	Name   string  // Name of an enum, struct or union type in the `types` package.
+	Struct *Struct // Fields of a struct.
+}
+
+// Field is a member of an input, output or nested structure.
+type Field struct {
+	Doc      string // First sentence of the field's documentation.
+	Name     string
+	Required bool
+	Shape    *Shape
+}
+
+// Struct is an input, output or nested structure.
+type Struct struct {
+	Fields []*Field
+	Name   string
+}
+
+// Field returns the field with the specified name.
+func (s *Struct) Field(name string) (*Field, bool) {
+	if s == nil {
+		return nil, false
+	}
+
+	for _, field := range s.Fields {
+		if field.Name == name {
+			return field, true
+		}
+	}
+
+	return nil, false
+}
+
+// EnumValue is a value of an enum.
+type EnumValue struct {
+	Name  string // Name of the Go constant, e.g. WidgetStatusActive.
+	Value string // e.g. ACTIVE.
+}
+
+// Enum is a string enumeration.
+type Enum struct {
+	Name   string
+	Values []EnumValue
+}
+
+// Operation is an API operation.
+type Operation struct {
+	Input     *Struct
+	Name      string
+	Output    *Struct
+	Paginated bool
+}
+
+// Service is an AWS SDK for Go v2 service package.
+type Service struct {
+	Errors     []string // Names of error types.
+	Operations map[string]*Operation
+	Package    string // e.g. ec2.
+
+	enums   map[string]*Enum
+	structs map[string]*ast.StructType
+	unions  map[string]struct{}
+	shapes  map[string]*Struct
+}
+
+// Operation returns the operation with the specified name.
+func (s *Service) Operation(name string) (*Operation, bool) {
+	op, ok := s.Operations[name]
+	return op, ok
+}
+
+// NotFoundError returns the name of the error type returned when a resource is not found.
+func (s *Service) NotFoundError() (string, bool) {
+	if slices.Contains(s.Errors, "ResourceNotFoundException") {
+		return "ResourceNotFoundException", true
+	}
+
+	for _, v := range s.Errors {
+		if strings.Contains(v, "NotFound") || strings.HasPrefix(v, "NoSuch") {
+			return v, true
+		}
+	}
+
+	return "", false
+}
+
+// Load loads the model for the specified AWS SDK for Go v2 service package, e.g. `ec2`.
+// The package is located relative to the Go module in the current working directory.
+func Load(sdkPackage string) (*Service, error) {
+	importPath := "github.com/aws/aws-sdk-go-v2/service/" + sdkPackage
+
+	cmd := exec.Command("go", "list", "-f", "{{.Dir}}", importPath)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("locating %s: %w: %s", importPath, err, stderr.String())
+	}
+
+	return LoadDir(sdkPackage, strings.TrimSpace(string(output)))
+}
+
+// LoadDir loads the model for the AWS SDK for Go v2 service package in the specified directory.
+func LoadDir(sdkPackage, dirname string) (*Service, error) {
+	s := &Service{
+		Operations: make(map[string]*Operation),
+		Package:    sdkPackage,
+		enums:      make(map[string]*Enum),
+		structs:    make(map[string]*ast.StructType),
+		unions:     make(map[string]struct{}),
+		shapes:     make(map[string]*Struct),
+	}
+
+	typesFiles, err := parseDir(filepath.Join(dirname, "types"))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, file := range typesFiles {
+		s.collectTypes(file)
+	}
+
+	files, err := parseDir(dirname)
+	if err != nil {
+		return nil, err
+	}
+
+	operations := make(map[string]*ast.StructType)
+	var paginators []string
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				name := decl.Name.Name
+				if decl.Recv != nil && len(decl.Recv.List) == 1 && exprString(decl.Recv.List[0].Type) == "*Client" && ast.IsExported(name) {
+					s.Operations[name] = &Operation{Name: name}
+				} else if decl.Recv == nil && strings.HasPrefix(name, "New") && strings.HasSuffix(name, "Paginator") {
+					paginators = append(paginators, strings.TrimSuffix(strings.TrimPrefix(name, "New"), "Paginator"))
+				}
+
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					if spec, ok := spec.(*ast.TypeSpec); ok {
+						if v, ok := spec.Type.(*ast.StructType); ok {
+							operations[spec.Name.Name] = v
+						}
+					}
+				}
+			}
+		}
+	}
+
+	for name, op := range s.Operations {
+		if v, ok := operations[name+"Input"]; ok {
+			op.Input = s.newStruct(name+"Input", v, false)
+		}
+		if v, ok := operations[name+"Output"]; ok {
+			op.Output = s.newStruct(name+"Output", v, false)
+		}
+		op.Paginated = slices.Contains(paginators, name)
+	}
+
+	return s, nil
+}
+
+func parseDir(dirname string) ([]*ast.File, error) {
+	entries, err := os.ReadDir(dirname)
+	if err != nil {
+		return nil, err
+	}
+
+	fset := token.NewFileSet()
+	var files []*ast.File
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, filepath.Join(dirname, name), nil, parser.ParseComments|parser.SkipObjectResolution)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", name, err)
+		}
+
+		files = append(files, file)
+	}
+
+	return files, nil
+}
+
+// collectTypes collects the structures, enums, unions and errors declared in the `types` package.
+func (s *Service) collectTypes(file *ast.File) {
+	for _, decl := range file.Decls {
+		decl, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+
+		for _, spec := range decl.Specs {
+			switch spec := spec.(type) {
+			case *ast.TypeSpec:
+				name := spec.Name.Name
+				switch typ := spec.Type.(type) {
+				case *ast.StructType:
+					s.structs[name] = typ
+					if isErrorStruct(typ) {
+						s.Errors = append(s.Errors, name)
+					}
+				case *ast.InterfaceType:
+					s.unions[name] = struct{}{}
+				case *ast.Ident:
+					if typ.Name == "string" {
+						s.enums[name] = &Enum{Name: name}
+					}
+				}
+
+			case *ast.ValueSpec:
+				// Enum values.
+				if decl.Tok != token.CONST || spec.Type == nil || len(spec.Values) != 1 {
+					continue
+				}
+				enum, ok := s.enums[exprString(spec.Type)]
+				if !ok {
+					continue
+				}
+				if v, ok := spec.Values[0].(*ast.BasicLit); ok && v.Kind == token.STRING {
+					enum.Values = append(enum.Values, EnumValue{
+						Name:  spec.Names[0].Name,
+						Value: strings.Trim(v.Value, `"`),
+					})
+				}
+			}
+		}
+	}
+}
+
+// isErrorStruct returns whether the structure is an API error, which has an ErrorCodeOverride field.
+func isErrorStruct(typ *ast.StructType) bool {
+	for _, field := range typ.Fields.List {
+		for _, name := range field.Names {
+			if name.Name == "ErrorCodeOverride" {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// newStruct returns the structure declared as `typ`.
+// Structures in the `types` package are shared, so recursive structures are cyclic.
+func (s *Service) newStruct(name string, typ *ast.StructType, inTypesPackage bool) *Struct {
+	if v, ok := s.shapes[name]; ok && inTypesPackage {
+		return v
+	}
+
+	result := &Struct{
+		Name: name,
+	}
+	if inTypesPackage {
+		s.shapes[name] = result
+	}
+
+	for _, field := range typ.Fields.List {
+		for _, ident := range field.Names {
+			if !ident.IsExported() || ident.Name == "ResultMetadata" {
+				continue
+			}
+
+			doc := field.Doc.Text()
+			result.Fields = append(result.Fields, &Field{
+				Doc:      firstSentence(doc),
+				Name:     ident.Name,
+				Required: strings.Contains(doc, "This member is required."),
+				Shape:    s.newShape(field.Type, inTypesPackage),
+			})
+		}
+	}
+
+	return result
+}
+
+func (s *Service) newShape(expr ast.Expr, inTypesPackage bool) *Shape {
+	switch expr := expr.(type) {
+	case *ast.StarExpr:
+		return s.newShape(expr.X, inTypesPackage)
+
+	case *ast.ArrayType:
+		if exprString(expr.Elt) == "byte" {
+			return &Shape{Kind: KindUnsupported, Name: "[]byte"}
+		}
+		return &Shape{Kind: KindList, Elem: s.newShape(expr.Elt, inTypesPackage)}
+
+	case *ast.MapType:
+		if exprString(expr.Key) != "string" {
+			return &Shape{Kind: KindUnsupported, Name: exprString(expr)}
+		}
+		return &Shape{Kind: KindMap, Elem: s.newShape(expr.Value, inTypesPackage)}
+	}
+
+	name := exprString(expr)
+	switch name {
+	case "bool":
+		return &Shape{Kind: KindBool}
+	case "float32", "float64":
+		return &Shape{Kind: KindFloat64}
+	case "int32":
+		return &Shape{Kind: KindInt32}
+	case "int64":
+		return &Shape{Kind: KindInt64}
+	case "string":
+		return &Shape{Kind: KindString}
+	case "time.Time":
+		return &Shape{Kind: KindTimestamp}
+	}
+
+	if !inTypesPackage {
+		var ok bool
+		if name, ok = strings.CutPrefix(name, "types."); !ok {
+			return &Shape{Kind: KindUnsupported, Name: name}
+		}
+	}
+
+	if v, ok := s.enums[name]; ok {
+		return &Shape{Kind: KindEnum, Name: name, Enum: v}
+	}
+	if _, ok := s.unions[name]; ok {
+		return &Shape{Kind: KindUnion, Name: name}
+	}
+	if v, ok := s.structs[name]; ok {
+		return &Shape{Kind: KindStruct, Name: name, Struct: s.newStruct(name, v, true)}
+	}
+
+	return &Shape{Kind: KindUnsupported, Name: name}
+}
+
+func exprString(expr ast.Expr) string {
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		return expr.Name
+	case *ast.SelectorExpr:
+		return exprString(expr.X) + "." + expr.Sel.Name
+	case *ast.StarExpr:
+		return "*" + exprString(expr.X)
+	case *ast.ArrayType:
+		return "[]" + exprString(expr.Elt)
+	case *ast.MapType:
+		return "map[" + exprString(expr.Key) + "]" + exprString(expr.Value)
+	}
+
+	return fmt.Sprintf("%T", expr)
+}
+
+// firstSentence returns the first sentence of a documentation comment, on a single line.
+func firstSentence(doc string) string {
+	doc = strings.Join(strings.Fields(doc), " ")
+
+	if i := strings.Index(doc, ". "); i >= 0 {
+		return doc[:i+1]
+	}
+
+	return doc
+}
@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apimodel
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadDir(t *testing.T) {
+	t.Parallel()
+
+	service, err := LoadDir("widgets", filepath.Join("testdata", "widgets"))
+	if err != nil {
+		t.Fatalf("loading model: %s", err)
+	}
+
+	if got, want := len(service.Operations), 8; got != want {
+		t.Errorf("operations = %d, want %d", got, want)
+	}
+
+	if got, ok := service.NotFoundError(); !ok || got != "ResourceNotFoundException" {
+		t.Errorf("NotFoundError() = %q, %t", got, ok)
+	}
+
+	if op, ok := service.Operation("ListWidgets"); !ok || !op.Paginated {
+		t.Errorf("ListWidgets is not paginated")
+	}
+
+	op, ok := service.Operation("CreateWidget")
+	if !ok {
+		t.Fatal("CreateWidget not found")
+	}
+
+	testCases := []struct {
+		TestName string
+		Field    string
+		Kind     Kind
+		Required bool
+		Doc      string
+	}{
+		{
+			TestName: "required string",
+			Field:    "WidgetName",
+			Kind:     KindString,
+			Required: true,
+			Doc:      "The name of the widget.",
+		},
+		{
+			TestName: "enum",
+			Field:    "Type",
+			Kind:     KindEnum,
+			Required: true,
+			Doc:      "The widget's type.",
+		},
+		{
+			TestName: "optional int32",
+			Field:    "Size",
+			Kind:     KindInt32,
+			Doc:      "The size of the widget.",
+		},
+		{
+			TestName: "struct",
+			Field:    "Configuration",
+			Kind:     KindStruct,
+			Doc:      "The widget's configuration.",
+		},
+		{
+			TestName: "map",
+			Field:    "Tags",
+			Kind:     KindMap,
+			Doc:      "The tags to apply to the widget.",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			field, ok := op.Input.Field(testCase.Field)
+			if !ok {
+				t.Fatalf("field %s not found", testCase.Field)
+			}
+
+			if got, want := field.Shape.Kind, testCase.Kind; got != want {
+				t.Errorf("kind = %d, want %d", got, want)
+			}
+			if got, want := field.Required, testCase.Required; got != want {
+				t.Errorf("required = %t, want %t", got, want)
+			}
+			if got, want := field.Doc, testCase.Doc; got != want {
+				t.Errorf("doc = %q, want %q", got, want)
+			}
+		})
+	}
+
+	if _, ok := op.Input.Field("ResultMetadata"); ok {
+		t.Error("unexported or metadata fields should be skipped")
+	}
+
+	field, _ := op.Input.Field("Type")
+	if got, want := len(field.Shape.Enum.Values), 2; got != want {
+		t.Errorf("enum values = %d, want %d", got, want)
+	}
+
+	// Recursive structures are cyclic.
+	configuration, _ := op.Input.Field("Configuration")
+	children, ok := configuration.Shape.Struct.Field("Children")
+	if !ok {
+		t.Fatal("Children not found")
+	}
+	if children.Shape.Kind != KindList || children.Shape.Elem.Struct != configuration.Shape.Struct {
+		t.Error("recursive structure is not shared")
+	}
+
+	settings, _ := configuration.Shape.Struct.Field("Settings")
+	if got, want := settings.Shape.Kind, KindUnsupported; got != want {
+		t.Errorf("document kind = %d, want %d", got, want)
+	}
+}
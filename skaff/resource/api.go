@@ -0,0 +1,1061 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-provider-aws/skaff/apimodel"
+)
+
+// APIData is the part of the template data derived from the AWS SDK for Go v2 API model.
+type APIData struct {
+	CreateOperation         string // e.g. CreateWidget
+	CreateOutputField       string // Field of the Create output containing the resource, if any.
+	DeleteIDField           string // e.g. WidgetId
+	DeleteOperation         string
+	DocArguments            []DocAttribute
+	DocAttributes           []DocAttribute
+	DocBlocks               []DocBlock
+	FieldNamePrefix         string
+	Finder                  APIFinder
+	IDAttribute             string // Go expression of the identifier's Terraform attribute name, e.g. names.AttrID.
+	IDField                 string // Model field of the identifier, e.g. ID.
+	Imports                 []string
+	List                    *APIList
+	Model                   string // Fields of the resource model.
+	Models                  []APIModel
+	NotFoundError           string
+	Schema                  string // Attributes of the resource schema.
+	SchemaBlocks            string // Blocks of the resource schema.
+	Status                  *APIStatus
+	TagsIdentifierAttribute string
+	UpdateOperation         string
+}
+
+// APIFinder describes the operation used to find the resource by its identifier.
+type APIFinder struct {
+	IDField     string // e.g. WidgetId or WidgetIds
+	IDList      bool   // The identifier is passed in a list.
+	Operation   string // e.g. GetWidget
+	OutputField string // Field of the output containing the resource, if any.
+	OutputList  bool   // The resource is returned in a list.
+	ResultType  string // e.g. awstypes.Widget
+}
+
+// APIList describes the operation used to list resources for sweeping.
+type APIList struct {
+	IDField     string // e.g. WidgetId
+	Operation   string // e.g. ListWidgets
+	OutputField string // e.g. Widgets
+	Paginated   bool
+}
+
+// APIStatus describes the resource's status field and its values, used in waiters.
+type APIStatus struct {
+	CreatePending []string // Names of enum constants.
+	CreateTarget  []string
+	DeletePending []string // Includes the creation target states.
+	Field         string
+	UpdatePending []string
+}
+
+// Values returns the Go expression for a waiter's list of states.
+func (s *APIStatus) Values(names []string) string {
+	if len(names) == 0 {
+		return "[]string{}"
+	}
+
+	values := make([]string, len(names))
+	for i, v := range names {
+		values[i] = "awstypes." + v
+	}
+
+	return fmt.Sprintf("enum.Slice(%s)", strings.Join(values, ", "))
+}
+
+// APIModel is a model for a nested object.
+type APIModel struct {
+	Fields string
+	Name   string
+}
+
+// DocAttribute is an attribute in the website documentation.
+type DocAttribute struct {
+	Description string
+	Name        string
+	Required    bool
+}
+
+// DocBlock is a nested block in the website documentation.
+type DocBlock struct {
+	Arguments []DocAttribute
+	Name      string
+}
+
+// skippedInputFields are API input fields that are not resource arguments.
+var skippedInputFields = []string{
+	"ClientRequestToken",
+	"ClientToken",
+	"DryRun",
+	"IdempotencyToken",
+	"MaxResults",
+	"NextToken",
+	"Tags",
+}
+
+// Status enum values that waiters treat as pending or target states.
+var (
+	createPendingStatuses = []string{"CREATING", "PENDING", "IN_PROGRESS", "PROVISIONING", "STARTING"}
+	createTargetStatuses  = []string{"ACTIVE", "AVAILABLE", "COMPLETED", "CREATED", "ENABLED", "READY", "RUNNING", "SUCCEEDED"}
+	deletePendingStatuses = []string{"DELETING", "DELETE_IN_PROGRESS", "DISABLING"}
+	updatePendingStatuses = []string{"UPDATING", "UPDATE_IN_PROGRESS", "MODIFYING"}
+)
+
+// initialisms are the words that Go names capitalize, keyed by their AWS API spelling.
+var initialisms = map[string]string{
+	"Acl":   "ACL",
+	"Api":   "API",
+	"Arn":   "ARN",
+	"Arns":  "ARNs",
+	"Cidr":  "CIDR",
+	"Dns":   "DNS",
+	"Http":  "HTTP",
+	"Https": "HTTPS",
+	"Iam":   "IAM",
+	"Id":    "ID",
+	"Ids":   "IDs",
+	"Ip":    "IP",
+	"Json":  "JSON",
+	"Kms":   "KMS",
+	"Sql":   "SQL",
+	"Ssl":   "SSL",
+	"Tls":   "TLS",
+	"Uri":   "URI",
+	"Url":   "URL",
+	"Vpc":   "VPC",
+}
+
+// attrConstantsFile is the list of Terraform attribute names with constants in the `names` package,
+// relative to a service package directory.
+var attrConstantsFile = filepath.Join("..", "..", "..", "names", "attr_constants.csv")
+
+// FromAPI returns the template data for a resource created by the specified API operation.
+func FromAPI(service *apimodel.Service, resName, createOp string, includeTags bool) (*APIData, error) {
+	create, ok := service.Operation(createOp)
+	if !ok {
+		return nil, fmt.Errorf("operation %q not found in %s", createOp, service.Package)
+	}
+	if create.Input == nil {
+		return nil, fmt.Errorf("operation %q has no input", createOp)
+	}
+
+	data := &APIData{
+		CreateOperation: createOp,
+		FieldNamePrefix: resName,
+	}
+
+	finder, resource, err := findFinder(service, resName)
+	if err != nil {
+		return nil, err
+	}
+	data.Finder = *finder
+
+	if v, ok := create.Output.Field(resName); ok && v.Shape.Kind == apimodel.KindStruct {
+		data.CreateOutputField = v.Name
+		if resource == nil {
+			resource = v.Shape.Struct
+		}
+	}
+	if resource == nil {
+		resource = create.Output
+	}
+
+	var update *apimodel.Operation
+	if v, ok := service.Operation("Update" + resName); ok && v.Input != nil {
+		update = v
+		data.UpdateOperation = v.Name
+	}
+
+	if v, ok := service.Operation("Delete" + resName); ok && v.Input != nil {
+		data.DeleteOperation = v.Name
+		data.DeleteIDField = identifierField(v.Input, resName)
+		if data.DeleteIDField == "" {
+			data.DeleteOperation = ""
+		}
+	}
+
+	data.NotFoundError, _ = service.NotFoundError()
+
+	e := newAPIEmitter(service.Package)
+	if includeTags {
+		e.use(`tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"`)
+	}
+
+	// The identifier is the finder's input, which is an attribute of the resource or an argument.
+	idName := finder.IDField
+	if finder.IDList {
+		idName = strings.TrimSuffix(idName, "s")
+	}
+	_, inResource := resource.Field(idName)
+	_, inInput := create.Input.Field(idName)
+	if !inResource && !inInput {
+		return nil, fmt.Errorf("identifier %q not found in %s or %s", idName, resource.Name, create.Input.Name)
+	}
+	data.IDField, _ = e.names(idName, resName)
+
+	// Arguments are the fields of the Create input, attributes are the additional fields of the resource.
+	var fields []*topLevelField
+	for _, field := range create.Input.Fields {
+		if slices.Contains(skippedInputFields, field.Name) {
+			continue
+		}
+
+		f := &topLevelField{Field: field, mode: modeOptional}
+		if field.Required {
+			f.mode = modeRequired
+		} else if _, ok := resource.Field(field.Name); ok {
+			f.mode = modeOptionalComputed
+		}
+		if update == nil {
+			f.requiresReplace = true
+		} else if _, ok := update.Input.Field(field.Name); !ok {
+			f.requiresReplace = true
+		}
+		fields = append(fields, f)
+	}
+	for _, field := range resource.Fields {
+		if slices.Contains(skippedInputFields, field.Name) {
+			continue
+		}
+		if _, ok := create.Input.Field(field.Name); ok {
+			continue
+		}
+
+		fields = append(fields, &topLevelField{Field: field, mode: modeComputed})
+	}
+
+	var schema, blocks, model []entry
+	for _, f := range fields {
+		goName, tfName := e.names(f.Name, resName)
+		attr, block, modelType := e.field(goName, tfName, f.Field, f.mode, f.requiresReplace, true, nil)
+		if attr != "" {
+			schema = append(schema, entry{tfName, attr})
+		}
+		if block != "" {
+			blocks = append(blocks, entry{tfName, block})
+		}
+		if modelType != "" {
+			model = append(model, entry{goName, fmt.Sprintf("%s %s `tfsdk:%q`", goName, modelType, tfName)})
+		}
+
+		doc := DocAttribute{
+			Description: description(f.Doc),
+			Name:        tfName,
+			Required:    f.mode == modeRequired,
+		}
+		if f.mode == modeComputed {
+			data.DocAttributes = append(data.DocAttributes, doc)
+		} else {
+			data.DocArguments = append(data.DocArguments, doc)
+		}
+
+		if goName == data.IDField {
+			data.IDAttribute = e.attrName(tfName)
+			if data.TagsIdentifierAttribute == "" {
+				data.TagsIdentifierAttribute = tfName
+			}
+		}
+		if goName == "ARN" {
+			data.TagsIdentifierAttribute = tfName
+		}
+	}
+
+	if includeTags {
+		schema = append(schema, entry{"tags", "names.AttrTags: tftags.TagsAttribute(),"}, entry{"tags_all", "names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),"})
+		model = append(model, entry{"Tags", "Tags tftags.Map `tfsdk:\"tags\"`"}, entry{"TagsAll", "TagsAll tftags.Map `tfsdk:\"tags_all\"`"})
+	}
+	model = append(model, entry{"Timeouts", "Timeouts timeouts.Value `tfsdk:\"timeouts\"`"})
+
+	data.Schema = joinEntries(schema)
+	data.SchemaBlocks = joinEntries(blocks)
+	data.Model = joinEntries(model)
+	data.Models = e.models
+	data.DocBlocks = e.docBlocks
+	sortDoc(data.DocArguments)
+	sortDoc(data.DocAttributes)
+
+	data.Status = findStatus(resource, data.UpdateOperation != "")
+	data.List = findList(service, resName, finder)
+	if data.NotFoundError != "" || data.Status != nil || strings.HasPrefix(finder.ResultType, "awstypes.") {
+		e.useAWSTypes()
+	}
+	data.Imports = e.imports()
+
+	return data, nil
+}
+
+// DefaultResourceName returns the resource name implied by a Create operation, e.g. CreateWidget -> Widget.
+func DefaultResourceName(createOp string) string {
+	return strings.TrimPrefix(createOp, "Create")
+}
+
+type topLevelField struct {
+	*apimodel.Field
+	mode            attrMode
+	requiresReplace bool
+}
+
+type attrMode int
+
+const (
+	modeRequired attrMode = iota
+	modeOptional
+	modeOptionalComputed
+	modeComputed
+)
+
+func (m attrMode) String() string {
+	switch m {
+	case modeRequired:
+		return "Required: true,\n"
+	case modeOptional:
+		return "Optional: true,\n"
+	case modeOptionalComputed:
+		return "Optional: true,\nComputed: true,\n"
+	default:
+		return "Computed: true,\n"
+	}
+}
+
+// entry is a keyed line or lines of generated code.
+type entry struct {
+	key  string
+	code string
+}
+
+func joinEntries(entries []entry) string {
+	slices.SortStableFunc(entries, func(a, b entry) int {
+		return strings.Compare(a.key, b.key)
+	})
+
+	var sb strings.Builder
+	for _, v := range entries {
+		sb.WriteString(v.code)
+		sb.WriteString("\n")
+	}
+
+	return sb.String()
+}
+
+func sortDoc(attrs []DocAttribute) {
+	slices.SortFunc(attrs, func(a, b DocAttribute) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+}
+
+// apiEmitter generates schema and model code for API shapes.
+type apiEmitter struct {
+	sdkPackage    string
+	attrConstants map[string]string // Terraform attribute name to `names` package constant.
+	docBlocks     []DocBlock
+	importPaths   map[string]struct{}
+	modelNames    map[string]struct{}
+	models        []APIModel
+}
+
+func newAPIEmitter(sdkPackage string) *apiEmitter {
+	return &apiEmitter{
+		sdkPackage:    sdkPackage,
+		attrConstants: loadAttrConstants(attrConstantsFile),
+		importPaths:   make(map[string]struct{}),
+		modelNames:    make(map[string]struct{}),
+	}
+}
+
+// loadAttrConstants loads the `names` package attribute constants.
+// If the list cannot be read, attribute names are emitted as string literals.
+func loadAttrConstants(filename string) map[string]string {
+	result := make(map[string]string)
+
+	f, err := os.Open(filename)
+	if err != nil {
+		return result
+	}
+	defer f.Close()
+
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		return result
+	}
+
+	for _, record := range records {
+		if len(record) == 2 {
+			result[record[0]] = "names.Attr" + record[1]
+		}
+	}
+
+	return result
+}
+
+func (e *apiEmitter) use(importSpec string) {
+	e.importPaths[importSpec] = struct{}{}
+}
+
+func (e *apiEmitter) useAWSTypes() {
+	e.use(fmt.Sprintf(`awstypes "github.com/aws/aws-sdk-go-v2/service/%s/types"`, e.sdkPackage))
+}
+
+func (e *apiEmitter) imports() []string {
+	var result []string
+	for v := range e.importPaths {
+		result = append(result, v)
+	}
+	slices.SortFunc(result, func(a, b string) int {
+		return strings.Compare(importPath(a), importPath(b))
+	})
+
+	return result
+}
+
+func importPath(spec string) string {
+	if _, v, ok := strings.Cut(spec, " "); ok {
+		return v
+	}
+	return spec
+}
+
+// attrName returns the Go expression for a Terraform attribute name.
+func (e *apiEmitter) attrName(tfName string) string {
+	if v, ok := e.attrConstants[tfName]; ok {
+		return v
+	}
+
+	return strconv.Quote(tfName)
+}
+
+// names returns the model field name and Terraform attribute name for an API field.
+// The resource name prefix is removed from top-level fields, e.g. WidgetName -> Name.
+func (e *apiEmitter) names(apiName, prefix string) (string, string) {
+	if v, ok := strings.CutPrefix(apiName, prefix); ok && v != "" && unicode.IsUpper(rune(v[0])) {
+		apiName = v
+	}
+
+	words := splitWords(apiName)
+	goWords := make([]string, len(words))
+	tfWords := make([]string, len(words))
+	for i, word := range words {
+		goWords[i] = word
+		if v, ok := initialisms[word]; ok {
+			goWords[i] = v
+		}
+		tfWords[i] = strings.ToLower(word)
+	}
+
+	return strings.Join(goWords, ""), strings.Join(tfWords, "_")
+}
+
+// splitWords splits a CamelCase name into words, e.g. KMSKeyId -> KMS, Key, Id.
+func splitWords(s string) []string {
+	var words []string
+	runes := []rune(s)
+	start := 0
+	for i := 1; i < len(runes); i++ {
+		prev, curr := runes[i-1], runes[i]
+		switch {
+		case unicode.IsUpper(curr) && (unicode.IsLower(prev) || unicode.IsDigit(prev)):
+		case unicode.IsUpper(curr) && unicode.IsUpper(prev) && i+1 < len(runes) && unicode.IsLower(runes[i+1]):
+		default:
+			continue
+		}
+		words = append(words, string(runes[start:i]))
+		start = i
+	}
+
+	return append(words, string(runes[start:]))
+}
+
+// field returns the schema attribute or block and the model type for an API field.
+// Fields that cannot be generated are marked with a TODO comment and have no model type.
+// `stack` is the names of the enclosing structures, used to detect recursion.
+func (e *apiEmitter) field(goName, tfName string, field *apimodel.Field, mode attrMode, requiresReplace, topLevel bool, stack []string) (string, string, string) {
+	key := e.attrName(tfName)
+	shape := field.Shape
+
+	todo := func(reason string) (string, string, string) {
+		return fmt.Sprintf("// TODO %s: %s.", tfName, reason), "", ""
+	}
+
+	switch shape.Kind {
+	case apimodel.KindStruct:
+		if slices.Contains(stack, shape.Name) {
+			return todo("recursive structure " + shape.Name)
+		}
+		return e.object(key, shape.Struct, mode, requiresReplace, true, topLevel, stack)
+
+	case apimodel.KindList:
+		elem := shape.Elem
+		if elem.Kind == apimodel.KindStruct {
+			if slices.Contains(stack, elem.Name) {
+				return todo("recursive structure " + elem.Name)
+			}
+			return e.object(key, elem.Struct, mode, requiresReplace, false, topLevel, stack)
+		}
+
+		elemType, modelType, ok := e.collectionElem(elem)
+		if !ok {
+			return todo(fmt.Sprintf("unsupported list element (%s)", describeShape(elem)))
+		}
+		return e.attribute(key, "List", modelType, elemType, goName, mode, requiresReplace, topLevel), "", modelType
+
+	case apimodel.KindMap:
+		if shape.Elem.Kind != apimodel.KindString {
+			return todo(fmt.Sprintf("unsupported map element (%s)", describeShape(shape.Elem)))
+		}
+		e.use(`fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"`)
+		return e.attribute(key, "Map", "fwtypes.MapOfString", "", goName, mode, requiresReplace, topLevel), "", "fwtypes.MapOfString"
+
+	case apimodel.KindUnion:
+		return todo(fmt.Sprintf("union %s is not supported by AutoFlex without a custom model", shape.Name))
+
+	case apimodel.KindUnsupported:
+		return todo(fmt.Sprintf("unsupported type (%s)", shape.Name))
+	}
+
+	// Primitive.
+	if topLevel && mode == modeComputed && shape.Kind == apimodel.KindString {
+		switch goName {
+		case "ID":
+			e.use(`"github.com/hashicorp/terraform-provider-aws/internal/framework"`)
+			return fmt.Sprintf("%s: framework.IDAttribute(),", key), "", "types.String"
+		case "ARN":
+			e.use(`"github.com/hashicorp/terraform-provider-aws/internal/framework"`)
+			return fmt.Sprintf("%s: framework.ARNAttributeComputedOnly(),", key), "", "types.String"
+		}
+	}
+
+	attrType, modelType := e.primitive(shape, goName)
+
+	return e.attribute(key, attrType, modelType, "", goName, mode, requiresReplace, topLevel), "", modelType
+}
+
+// primitive returns the attribute type (e.g. String) and model type of a primitive shape.
+func (e *apiEmitter) primitive(shape *apimodel.Shape, goName string) (string, string) {
+	switch shape.Kind {
+	case apimodel.KindBool:
+		return "Bool", "types.Bool"
+	case apimodel.KindEnum:
+		e.useAWSTypes()
+		e.use(`fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"`)
+		return "String", fmt.Sprintf("fwtypes.StringEnum[awstypes.%s]", shape.Name)
+	case apimodel.KindFloat64:
+		return "Float64", "types.Float64"
+	case apimodel.KindInt32:
+		return "Int32", "types.Int32"
+	case apimodel.KindInt64:
+		return "Int64", "types.Int64"
+	case apimodel.KindTimestamp:
+		e.use(`"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"`)
+		return "String", "timetypes.RFC3339"
+	}
+
+	if strings.HasSuffix(goName, "ARN") {
+		e.use(`fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"`)
+		return "String", "fwtypes.ARN"
+	}
+
+	return "String", "types.String"
+}
+
+// customType returns the CustomType of a primitive attribute with the specified model type, if any.
+func customType(modelType string) string {
+	switch {
+	case modelType == "fwtypes.ARN":
+		return "fwtypes.ARNType"
+	case modelType == "timetypes.RFC3339":
+		return "timetypes.RFC3339Type{}"
+	case strings.HasPrefix(modelType, "fwtypes.StringEnum["):
+		return strings.Replace(modelType, "fwtypes.StringEnum[", "fwtypes.StringEnumType[", 1) + "()"
+	}
+
+	return ""
+}
+
+// collectionElem returns the CustomType and model type of a list of primitives.
+func (e *apiEmitter) collectionElem(elem *apimodel.Shape) (string, string, bool) {
+	e.use(`fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"`)
+
+	switch elem.Kind {
+	case apimodel.KindString:
+		return "types.StringType", "fwtypes.ListOfString", true
+	case apimodel.KindEnum:
+		e.useAWSTypes()
+		return fmt.Sprintf("fwtypes.StringEnumType[awstypes.%s]()", elem.Name), fmt.Sprintf("fwtypes.ListValueOf[fwtypes.StringEnum[awstypes.%s]]", elem.Name), true
+	case apimodel.KindBool, apimodel.KindFloat64, apimodel.KindInt32, apimodel.KindInt64:
+		attrType, modelType := e.primitive(elem, "")
+		return fmt.Sprintf("types.%sType", attrType), fmt.Sprintf("fwtypes.ListValueOf[%s]", modelType), true
+	}
+
+	return "", "", false
+}
+
+// attribute returns a schema attribute.
+// `attrType` is the attribute type, e.g. String or List, and `elemType` is the ElementType of a collection.
+func (e *apiEmitter) attribute(key, attrType, modelType, elemType, goName string, mode attrMode, requiresReplace, topLevel bool) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "%s: schema.%sAttribute{\n", key, attrType)
+	switch attrType {
+	case "List":
+		if modelType == "fwtypes.ListOfString" {
+			sb.WriteString("CustomType: fwtypes.ListOfStringType,\n")
+		} else {
+			fmt.Fprintf(&sb, "CustomType: fwtypes.NewListTypeOf[%s](ctx),\n", strings.TrimSuffix(strings.TrimPrefix(modelType, "fwtypes.ListValueOf["), "]"))
+		}
+	case "Map":
+		sb.WriteString("CustomType: fwtypes.MapOfStringType,\n")
+		elemType = "types.StringType"
+	default:
+		if v := customType(modelType); v != "" {
+			fmt.Fprintf(&sb, "CustomType: %s,\n", v)
+		}
+	}
+	sb.WriteString(mode.String())
+	if elemType != "" {
+		fmt.Fprintf(&sb, "ElementType: %s,\n", elemType)
+	}
+	sb.WriteString(e.planModifiers(attrType, mode, requiresReplace, topLevel))
+	sb.WriteString("},")
+
+	return sb.String()
+}
+
+// planModifiers returns the PlanModifiers of a top-level attribute.
+func (e *apiEmitter) planModifiers(attrType string, mode attrMode, requiresReplace, topLevel bool) string {
+	if !topLevel {
+		return ""
+	}
+
+	var modifiers []string
+	if requiresReplace && mode != modeComputed {
+		modifiers = append(modifiers, "RequiresReplace()")
+	}
+	if mode == modeComputed || mode == modeOptionalComputed {
+		modifiers = append(modifiers, "UseStateForUnknown()")
+	}
+	if len(modifiers) == 0 {
+		return ""
+	}
+
+	pkg := strings.ToLower(attrType) + "planmodifier"
+	e.use(`"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"`)
+	e.use(fmt.Sprintf(`"github.com/hashicorp/terraform-plugin-framework/resource/schema/%s"`, pkg))
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "PlanModifiers: []planmodifier.%s{\n", attrType)
+	for _, v := range modifiers {
+		fmt.Fprintf(&sb, "%s.%s,\n", pkg, v)
+	}
+	sb.WriteString("},\n")
+
+	return sb.String()
+}
+
+// object returns a nested block, or for computed objects a list attribute, and its model type.
+func (e *apiEmitter) object(key string, s *apimodel.Struct, mode attrMode, requiresReplace, single, topLevel bool, stack []string) (string, string, string) {
+	e.use(`fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"`)
+
+	stack = append(slices.Clone(stack), s.Name)
+	modelName := lowerFirst(s.Name) + "Model"
+	modelType := fmt.Sprintf("fwtypes.ListNestedObjectValueOf[%s]", modelName)
+	var sb strings.Builder
+
+	if mode == modeComputed {
+		e.model(modelName, s, stack, true)
+
+		fmt.Fprintf(&sb, "%s: schema.ListAttribute{\n", key)
+		fmt.Fprintf(&sb, "CustomType: fwtypes.NewListNestedObjectTypeOf[%s](ctx),\n", modelName)
+		sb.WriteString(mode.String())
+		fmt.Fprintf(&sb, "ElementType: fwtypes.NewObjectTypeOf[%s](ctx),\n", modelName)
+		sb.WriteString(e.planModifiers("List", mode, requiresReplace, topLevel))
+		sb.WriteString("},")
+
+		return sb.String(), "", modelType
+	}
+
+	attrs, blocks, docs := e.model(modelName, s, stack, false)
+
+	fmt.Fprintf(&sb, "%s: schema.ListNestedBlock{\n", key)
+	fmt.Fprintf(&sb, "CustomType: fwtypes.NewListNestedObjectTypeOf[%s](ctx),\n", modelName)
+	var validators []string
+	if mode == modeRequired {
+		validators = append(validators, "listvalidator.IsRequired()")
+	}
+	if single {
+		validators = append(validators, "listvalidator.SizeAtMost(1)")
+	}
+	if len(validators) > 0 {
+		e.use(`"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"`)
+		e.use(`"github.com/hashicorp/terraform-plugin-framework/schema/validator"`)
+		sb.WriteString("Validators: []validator.List{\n")
+		for _, v := range validators {
+			sb.WriteString(v + ",\n")
+		}
+		sb.WriteString("},\n")
+	}
+	// Blocks cannot be computed.
+	sb.WriteString(e.planModifiers("List", modeOptional, requiresReplace, topLevel))
+	sb.WriteString("NestedObject: schema.NestedBlockObject{\n")
+	if attrs != "" {
+		fmt.Fprintf(&sb, "Attributes: map[string]schema.Attribute{\n%s},\n", attrs)
+	}
+	if blocks != "" {
+		fmt.Fprintf(&sb, "Blocks: map[string]schema.Block{\n%s},\n", blocks)
+	}
+	sb.WriteString("},\n")
+	sb.WriteString("},")
+
+	if name := tfNameOf(key, e.attrConstants); !slices.ContainsFunc(e.docBlocks, func(v DocBlock) bool { return v.Name == name }) {
+		e.docBlocks = append(e.docBlocks, DocBlock{Arguments: docs, Name: name})
+	}
+
+	return "", sb.String(), modelType
+}
+
+// model adds the model for a nested structure, if not already added, and returns its schema attributes, blocks and documentation.
+// The fields of computed structures are computed.
+func (e *apiEmitter) model(modelName string, s *apimodel.Struct, stack []string, computed bool) (string, string, []DocAttribute) {
+	var attrs, blocks, fields []entry
+	var docs []DocAttribute
+
+	for _, field := range s.Fields {
+		goName, tfName := e.names(field.Name, "")
+		mode := modeOptional
+		if computed {
+			mode = modeComputed
+		} else if field.Required {
+			mode = modeRequired
+		}
+
+		attr, block, modelType := e.field(goName, tfName, field, mode, false, false, stack)
+		if attr != "" {
+			attrs = append(attrs, entry{tfName, attr})
+		}
+		if block != "" {
+			blocks = append(blocks, entry{tfName, block})
+		}
+		if modelType != "" {
+			fields = append(fields, entry{goName, fmt.Sprintf("%s %s `tfsdk:%q`", goName, modelType, tfName)})
+			docs = append(docs, DocAttribute{
+				Description: description(field.Doc),
+				Name:        tfName,
+				Required:    field.Required,
+			})
+		}
+	}
+	sortDoc(docs)
+
+	if _, ok := e.modelNames[modelName]; !ok {
+		e.modelNames[modelName] = struct{}{}
+		e.models = append(e.models, APIModel{
+			Fields: joinEntries(fields),
+			Name:   modelName,
+		})
+	}
+
+	return joinEntries(attrs), joinEntries(blocks), docs
+}
+
+func tfNameOf(key string, attrConstants map[string]string) string {
+	for k, v := range attrConstants {
+		if v == key {
+			return k
+		}
+	}
+
+	return strings.Trim(key, `"`)
+}
+
+func lowerFirst(s string) string {
+	words := splitWords(s)
+	words[0] = strings.ToLower(words[0])
+
+	return strings.Join(words, "")
+}
+
+func describeShape(shape *apimodel.Shape) string {
+	switch shape.Kind {
+	case apimodel.KindList:
+		return "list"
+	case apimodel.KindMap:
+		return "map"
+	case apimodel.KindUnion:
+		return "union " + shape.Name
+	}
+
+	return shape.Name
+}
+
+// description returns the website documentation description of an API field.
+func description(doc string) string {
+	if doc == "" {
+		return "TODO."
+	}
+
+	return doc
+}
+
+// identifierField returns the field of an operation's input that identifies the resource.
+func identifierField(input *apimodel.Struct, resName string) string {
+	for _, name := range []string{resName + "Id", "Id", resName + "Arn", "Arn", resName + "Name", "Name", resName + "Identifier", "Identifier"} {
+		if v, ok := input.Field(name); ok && v.Shape.Kind == apimodel.KindString {
+			return name
+		}
+	}
+
+	for _, v := range input.Fields {
+		if v.Required && v.Shape.Kind == apimodel.KindString {
+			return v.Name
+		}
+	}
+
+	return ""
+}
+
+// findFinder returns the finder for the resource and the resource's structure.
+// The structure is nil if the finder returns the operation's output.
+func findFinder(service *apimodel.Service, resName string) (*APIFinder, *apimodel.Struct, error) {
+	for _, opName := range []string{"Get" + resName, "Describe" + resName} {
+		op, ok := service.Operation(opName)
+		if !ok || op.Input == nil || op.Output == nil {
+			continue
+		}
+
+		finder := &APIFinder{
+			IDField:    identifierField(op.Input, resName),
+			Operation:  opName,
+			ResultType: fmt.Sprintf("%s.%sOutput", service.Package, opName),
+		}
+		if finder.IDField == "" {
+			continue
+		}
+
+		if v, ok := op.Output.Field(resName); ok && v.Shape.Kind == apimodel.KindStruct {
+			finder.OutputField = v.Name
+			finder.ResultType = "awstypes." + v.Shape.Name
+			return finder, v.Shape.Struct, nil
+		}
+
+		return finder, op.Output, nil
+	}
+
+	// DescribeWidgets(WidgetIds: []string{id}).
+	opName := "Describe" + resName + "s"
+	if op, ok := service.Operation(opName); ok && op.Input != nil && op.Output != nil {
+		for _, suffix := range []string{"Ids", "Arns", "Names"} {
+			field, ok := op.Input.Field(resName + suffix)
+			if !ok || field.Shape.Kind != apimodel.KindList || field.Shape.Elem.Kind != apimodel.KindString {
+				continue
+			}
+
+			for _, v := range op.Output.Fields {
+				if v.Shape.Kind == apimodel.KindList && v.Shape.Elem.Kind == apimodel.KindStruct {
+					return &APIFinder{
+						IDField:     field.Name,
+						IDList:      true,
+						Operation:   opName,
+						OutputField: v.Name,
+						OutputList:  true,
+						ResultType:  "awstypes." + v.Shape.Elem.Name,
+					}, v.Shape.Elem.Struct, nil
+				}
+			}
+		}
+	}
+
+	return nil, nil, fmt.Errorf("no Get%[1]s, Describe%[1]s or Describe%[1]ss operation found in %[2]s", resName, service.Package)
+}
+
+// findStatus returns the resource's status field and the values used by waiters.
+// Waiters are only generated for status fields that are enums.
+func findStatus(resource *apimodel.Struct, update bool) *APIStatus {
+	for _, name := range []string{"Status", "State"} {
+		field, ok := resource.Field(name)
+		if !ok || field.Shape.Kind != apimodel.KindEnum {
+			continue
+		}
+
+		status := &APIStatus{Field: name}
+		for _, v := range field.Shape.Enum.Values {
+			switch {
+			case matchStatus(v.Value, createPendingStatuses):
+				status.CreatePending = append(status.CreatePending, v.Name)
+			case matchStatus(v.Value, createTargetStatuses):
+				status.CreateTarget = append(status.CreateTarget, v.Name)
+			case matchStatus(v.Value, deletePendingStatuses):
+				status.DeletePending = append(status.DeletePending, v.Name)
+			case update && matchStatus(v.Value, updatePendingStatuses):
+				status.UpdatePending = append(status.UpdatePending, v.Name)
+			}
+		}
+		if len(status.CreateTarget) == 0 {
+			return nil
+		}
+		status.DeletePending = append(status.DeletePending, status.CreateTarget...)
+
+		return status
+	}
+
+	return nil
+}
+
+func matchStatus(value string, statuses []string) bool {
+	value = strings.ToUpper(strings.ReplaceAll(value, "-", "_"))
+	return slices.Contains(statuses, value)
+}
+
+// findList returns the operation used to list resources, if any.
+func findList(service *apimodel.Service, resName string, finder *APIFinder) *APIList {
+	op, ok := service.Operation("List" + resName + "s")
+	if !ok || op.Output == nil {
+		return nil
+	}
+
+	for _, field := range op.Output.Fields {
+		if field.Shape.Kind != apimodel.KindList || field.Shape.Elem.Kind != apimodel.KindStruct {
+			continue
+		}
+
+		summary := field.Shape.Elem.Struct
+		idField := identifierField(summary, resName)
+		if v := strings.TrimSuffix(finder.IDField, "s"); finder.IDList {
+			if _, ok := summary.Field(v); ok {
+				idField = v
+			}
+		} else if _, ok := summary.Field(finder.IDField); ok {
+			idField = finder.IDField
+		}
+		if idField == "" {
+			return nil
+		}
+
+		return &APIList{
+			IDField:     idField,
+			Operation:   op.Name,
+			OutputField: field.Name,
+			Paginated:   op.Paginated,
+		}
+	}
+
+	return nil
+}
+
+// tagsGeneratorFlags returns the flags of the tags generator for the service's tagging operations.
+// ok is false if the service does not support tagging.
+func tagsGeneratorFlags(service *apimodel.Service, createInput *apimodel.Struct) (string, bool) {
+	tags, ok := createInput.Field("Tags")
+	if !ok {
+		return "", false
+	}
+
+	var tagOp, untagOp, listTagsOp string
+	for _, v := range []string{"TagResource", "AddTagsToResource", "AddTags", "CreateTags"} {
+		if _, ok := service.Operation(v); ok {
+			tagOp = v
+			break
+		}
+	}
+	for _, v := range []string{"UntagResource", "RemoveTagsFromResource", "RemoveTags", "DeleteTags"} {
+		if _, ok := service.Operation(v); ok {
+			untagOp = v
+			break
+		}
+	}
+	for _, v := range []string{"ListTagsForResource", "ListTags", "DescribeTags"} {
+		if _, ok := service.Operation(v); ok {
+			listTagsOp = v
+			break
+		}
+	}
+	if tagOp == "" || untagOp == "" || listTagsOp == "" {
+		return "", false
+	}
+
+	var flags []string
+	flags = append(flags, "-ListTags")
+	if listTagsOp != "ListTagsForResource" {
+		flags = append(flags, "-ListTagsOp="+listTagsOp)
+	}
+	if op, _ := service.Operation(listTagsOp); op.Input != nil {
+		if v := identifierField(op.Input, "Resource"); v != "" && v != "ResourceArn" {
+			flags = append(flags, "-ListTagsInIDElem="+v)
+		}
+	}
+	if op, _ := service.Operation(listTagsOp); op.Output != nil {
+		if _, ok := op.Output.Field("Tags"); !ok {
+			for _, v := range op.Output.Fields {
+				if strings.HasPrefix(v.Name, "Tag") {
+					flags = append(flags, "-ListTagsOutTagsElem="+v.Name)
+					break
+				}
+			}
+		}
+	}
+
+	if tags.Shape.Kind == apimodel.KindMap {
+		flags = append(flags, "-ServiceTagsMap", "-KVTValues")
+	} else {
+		flags = append(flags, "-ServiceTagsSlice")
+	}
+
+	if tagOp != "TagResource" {
+		flags = append(flags, "-TagOp="+tagOp)
+	}
+	if op, _ := service.Operation(tagOp); op.Input != nil {
+		if v := identifierField(op.Input, "Resource"); v != "" && v != "ResourceArn" {
+			flags = append(flags, "-TagInIDElem="+v)
+		}
+	}
+	if untagOp != "UntagResource" {
+		flags = append(flags, "-UntagOp="+untagOp)
+	}
+	flags = append(flags, "-UpdateTags")
+
+	return strings.Join(flags, " "), true
+}
+
+// addTagsGenerateDirective adds the tags generator directive to the service package's generate.go, if not already present.
+func addTagsGenerateDirective(filename, flags string) error {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+
+	const tagsGenerator = "//go:generate go run ../../generate/tags/main.go"
+	if strings.Contains(string(b), tagsGenerator) {
+		return nil
+	}
+
+	var lines []string
+	var added bool
+	for _, line := range strings.Split(string(b), "\n") {
+		lines = append(lines, line)
+		if !added && strings.HasPrefix(line, "//go:generate go run ../../generate/servicepackage/main.go") {
+			lines = append(lines, tagsGenerator+" "+flags)
+			added = true
+		}
+	}
+	if !added {
+		return fmt.Errorf("servicepackage generate directive not found in %s", filename)
+	}
+
+	return os.WriteFile(filename, []byte(strings.Join(lines, "\n")), 0644)
+}
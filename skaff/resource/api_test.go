@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/skaff/apimodel"
+)
+
+func TestAPIEmitterNames(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName string
+		Input    string
+		Prefix   string
+		GoName   string
+		TFName   string
+	}{
+		{
+			TestName: "simple",
+			Input:    "Description",
+			GoName:   "Description",
+			TFName:   "description",
+		},
+		{
+			TestName: "prefix",
+			Input:    "WidgetName",
+			Prefix:   "Widget",
+			GoName:   "Name",
+			TFName:   "name",
+		},
+		{
+			TestName: "prefix not at word boundary",
+			Input:    "Widgets",
+			Prefix:   "Widget",
+			GoName:   "Widgets",
+			TFName:   "widgets",
+		},
+		{
+			TestName: "initialisms",
+			Input:    "KmsKeyId",
+			GoName:   "KMSKeyID",
+			TFName:   "kms_key_id",
+		},
+		{
+			TestName: "upper case run",
+			Input:    "DBSubnetGroupArns",
+			GoName:   "DBSubnetGroupARNs",
+			TFName:   "db_subnet_group_arns",
+		},
+	}
+
+	e := newAPIEmitter("widgets")
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			goName, tfName := e.names(testCase.Input, testCase.Prefix)
+
+			if got, want := goName, testCase.GoName; got != want {
+				t.Errorf("Go name = %q, want %q", got, want)
+			}
+			if got, want := tfName, testCase.TFName; got != want {
+				t.Errorf("Terraform name = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestFromAPI(t *testing.T) {
+	t.Parallel()
+
+	service, err := apimodel.LoadDir("widgets", filepath.Join("..", "apimodel", "testdata", "widgets"))
+	if err != nil {
+		t.Fatalf("loading model: %s", err)
+	}
+
+	data, err := FromAPI(service, "Widget", "CreateWidget", true)
+	if err != nil {
+		t.Fatalf("generating from API: %s", err)
+	}
+
+	if got, want := data.IDField, "ID"; got != want {
+		t.Errorf("IDField = %q, want %q", got, want)
+	}
+	if got, want := data.Finder.Operation, "GetWidget"; got != want {
+		t.Errorf("Finder.Operation = %q, want %q", got, want)
+	}
+	if got, want := data.Finder.ResultType, "awstypes.Widget"; got != want {
+		t.Errorf("Finder.ResultType = %q, want %q", got, want)
+	}
+	if got, want := data.NotFoundError, "ResourceNotFoundException"; got != want {
+		t.Errorf("NotFoundError = %q, want %q", got, want)
+	}
+	if got, want := data.TagsIdentifierAttribute, "arn"; got != want {
+		t.Errorf("TagsIdentifierAttribute = %q, want %q", got, want)
+	}
+
+	if data.Status == nil {
+		t.Fatal("no status")
+	}
+	if got, want := data.Status.Values(data.Status.CreatePending), "enum.Slice(awstypes.WidgetStatusCreating)"; got != want {
+		t.Errorf("create pending = %q, want %q", got, want)
+	}
+
+	if data.List == nil || data.List.Operation != "ListWidgets" || data.List.IDField != "WidgetId" || !data.List.Paginated {
+		t.Errorf("List = %+v", data.List)
+	}
+
+	for _, want := range []string{
+		"CustomType: fwtypes.StringEnumType[awstypes.WidgetType]()",
+		"int32planmodifier.RequiresReplace()",
+		"framework.ARNAttributeComputedOnly(),",
+	} {
+		if !strings.Contains(data.Schema, want) {
+			t.Errorf("schema does not contain %q", want)
+		}
+	}
+	if !strings.Contains(data.SchemaBlocks, "// TODO children: recursive structure WidgetConfiguration.") {
+		t.Error("recursive structure is not marked TODO")
+	}
+
+	flags, ok := tagsGeneratorFlags(service, service.Operations["CreateWidget"].Input)
+	if !ok {
+		t.Fatal("no tags generator flags")
+	}
+	if got, want := flags, "-ListTags -ServiceTagsMap -KVTValues -UpdateTags"; got != want {
+		t.Errorf("tags generator flags = %q, want %q", got, want)
+	}
+}
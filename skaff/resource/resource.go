@@ -8,6 +8,7 @@ import (
 	_ "embed"
 	"errors"
 	"fmt"
+	"go/format"
 	"io/fs"
 	"os"
 	"path/filepath"
@@ -16,6 +17,7 @@ import (
 
 	"github.com/hashicorp/terraform-provider-aws/names"
 	"github.com/hashicorp/terraform-provider-aws/names/data"
+	"github.com/hashicorp/terraform-provider-aws/skaff/apimodel"
 	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
 )
 
@@ -25,6 +27,9 @@ var resourceTmpl string
 //go:embed resourcefw.gtpl
 var resourceFrameworkTmpl string
 
+//go:embed resourceapi.gtpl
+var resourceAPITmpl string
+
 //go:embed resourcetest.gtpl
 var resourceTestTmpl string
 
@@ -46,9 +51,10 @@ type TemplateData struct {
 	PluginFramework      bool
 	HumanResourceName    string
 	ProviderResourceName string
+	API                  *APIData
 }
 
-func Create(resName, snakeName string, comments, force, pluginFramework, tags bool) error {
+func Create(resName, snakeName string, comments, force, pluginFramework, tags bool, apiOperation string) error {
 	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
 	if err != nil {
 		return fmt.Errorf("error reading working directory: %s", err)
@@ -56,6 +62,14 @@ func Create(resName, snakeName string, comments, force, pluginFramework, tags bo
 
 	servicePackage := filepath.Base(wd)
 
+	if apiOperation != "" && !pluginFramework {
+		return fmt.Errorf("error checking: --from-api is only supported for Terraform Plugin Framework resources")
+	}
+
+	if resName == "" && apiOperation != "" {
+		resName = DefaultResourceName(apiOperation)
+	}
+
 	if resName == "" {
 		return fmt.Errorf("error checking: no name given")
 	}
@@ -94,10 +108,37 @@ func Create(resName, snakeName string, comments, force, pluginFramework, tags bo
 		ProviderResourceName: convert.ToProviderResourceName(servicePackage, snakeName),
 	}
 
+	var tagsFlags string
+	if apiOperation != "" {
+		service, err := apimodel.Load(templateData.SDKPackage)
+		if err != nil {
+			return fmt.Errorf("loading AWS SDK for Go v2 API model: %w", err)
+		}
+
+		create, _ := service.Operation(apiOperation)
+		if create != nil && !tags {
+			if _, ok := create.Input.Field("Tags"); ok {
+				templateData.IncludeTags = true
+			}
+		}
+
+		templateData.API, err = FromAPI(service, resName, apiOperation, templateData.IncludeTags)
+		if err != nil {
+			return fmt.Errorf("reading %s API model: %w", apiOperation, err)
+		}
+
+		if templateData.IncludeTags {
+			tagsFlags, _ = tagsGeneratorFlags(service, create.Input)
+		}
+	}
+
 	tmpl := resourceTmpl
 	if pluginFramework {
 		tmpl = resourceFrameworkTmpl
 	}
+	if templateData.API != nil {
+		tmpl = resourceAPITmpl
+	}
 	f := fmt.Sprintf("%s.go", snakeName)
 	if err = writeTemplate("newres", f, tmpl, force, templateData); err != nil {
 		return fmt.Errorf("writing resource template: %w", err)
@@ -114,6 +155,12 @@ func Create(resName, snakeName string, comments, force, pluginFramework, tags bo
 		return fmt.Errorf("writing resource website doc template: %w", err)
 	}
 
+	if tagsFlags != "" {
+		if err := addTagsGenerateDirective("generate.go", tagsFlags); err != nil {
+			return fmt.Errorf("adding tags generate directive: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -138,13 +185,18 @@ func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateD
 		return fmt.Errorf("error executing template: %s", err)
 	}
 
-	//contents, err := format.Source(buffer.Bytes())
-	//if err != nil {
-	//	return fmt.Errorf("error formatting generated file: %s", err)
-	//}
+	// Code generated from the API model is built from unindented fragments.
+	contents := buffer.Bytes()
+	var formatErr error
+	if td.API != nil && templateName == "newres" {
+		if v, err := format.Source(contents); err != nil {
+			formatErr = fmt.Errorf("error formatting generated file (%s): %s", filename, err)
+		} else {
+			contents = v
+		}
+	}
 
-	//if _, err := f.Write(contents); err != nil {
-	if _, err := f.Write(buffer.Bytes()); err != nil {
+	if _, err := f.Write(contents); err != nil {
 		f.Close() // ignore error; Write error takes precedence
 		return fmt.Errorf("error writing to file (%s): %s", filename, err)
 	}
@@ -153,5 +205,5 @@ func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateD
 		return fmt.Errorf("error closing file (%s): %s", filename, err)
 	}
 
-	return nil
+	return formatErr
 }
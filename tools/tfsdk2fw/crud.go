@@ -0,0 +1,274 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// crud describes the implementation of a Plugin SDK resource's CRUD handlers.
+type crud struct {
+	ClientMethod  string // e.g. EC2Client
+	SDKImportPath string // e.g. github.com/aws/aws-sdk-go-v2/service/ec2
+	SDKPackage    string // e.g. ec2
+	Create        handler
+	Read          handler
+	Update        handler
+	Delete        handler
+}
+
+// handler describes the implementation of a single CRUD handler.
+type handler struct {
+	APIOperation string // e.g. CreateVpc
+	Finder       *call  // e.g. findVPCByID
+	Waiter       *call  // e.g. waitVPCCreated
+}
+
+// call is a call to a package-level function.
+type call struct {
+	Args        []string // Arguments in the generated code.
+	Name        string
+	ResultCount int
+	UsesTimeout bool
+}
+
+// Assign returns the left-hand side of an assignment of the call's results.
+// The first result is assigned to `first` and the last to `err`.
+func (c *call) Assign(first string) string {
+	if c.ResultCount < 2 {
+		return "err"
+	}
+
+	return first + strings.Repeat(", _", c.ResultCount-2) + ", err"
+}
+
+// Call returns the call expression.
+func (c *call) Call() string {
+	return fmt.Sprintf("%s(%s)", c.Name, strings.Join(c.Args, ", "))
+}
+
+// handlerFunc returns the first non-nil function.
+func handlerFunc(fs ...any) any {
+	for _, f := range fs {
+		if v := reflect.ValueOf(f); v.Kind() == reflect.Func && !v.IsNil() {
+			return f
+		}
+	}
+
+	return nil
+}
+
+// functionName returns the package-level name and source file of the function `f`.
+// Closures are not package-level functions and are not found.
+func functionName(f any) (string, string, bool) {
+	v := reflect.ValueOf(f)
+	if v.Kind() != reflect.Func || v.IsNil() {
+		return "", "", false
+	}
+
+	fn := runtime.FuncForPC(v.Pointer())
+	if fn == nil {
+		return "", "", false
+	}
+
+	name := fn.Name()
+	name = name[strings.LastIndex(name, ".")+1:]
+	if strings.HasPrefix(name, "func") {
+		return "", "", false
+	}
+
+	filename, _ := fn.FileLine(fn.Entry())
+
+	return name, filename, true
+}
+
+// sourcePackage is the parsed source of a Go package.
+type sourcePackage struct {
+	files map[string]*ast.File     // Keyed by function name.
+	funcs map[string]*ast.FuncDecl // Keyed by function name.
+}
+
+func parseSourcePackage(dirname string) (*sourcePackage, error) {
+	entries, err := os.ReadDir(dirname)
+	if err != nil {
+		return nil, err
+	}
+
+	pkg := &sourcePackage{
+		files: make(map[string]*ast.File),
+		funcs: make(map[string]*ast.FuncDecl),
+	}
+	fset := token.NewFileSet()
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, filepath.Join(dirname, name), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", name, err)
+		}
+
+		for _, decl := range file.Decls {
+			if decl, ok := decl.(*ast.FuncDecl); ok && decl.Recv == nil {
+				pkg.files[decl.Name.Name] = file
+				pkg.funcs[decl.Name.Name] = decl
+			}
+		}
+	}
+
+	return pkg, nil
+}
+
+// analyzeCRUD inspects the source of a Plugin SDK resource's CRUD handlers.
+// Any part of the implementation that cannot be determined is left empty.
+func analyzeCRUD(createFunc, readFunc, updateFunc, deleteFunc any, timeouts handlerTimeouts) (*crud, error) {
+	result := &crud{}
+
+	name, filename, ok := functionName(readFunc)
+	if !ok {
+		return result, nil
+	}
+
+	pkg, err := parseSourcePackage(filepath.Dir(filename))
+	if err != nil {
+		return nil, err
+	}
+
+	if file, ok := pkg.files[name]; ok {
+		for _, spec := range file.Imports {
+			path, _ := strconv.Unquote(spec.Path.Value)
+			if strings.HasPrefix(path, "github.com/aws/aws-sdk-go-v2/service/") && !strings.HasSuffix(path, "/types") {
+				result.SDKImportPath = path
+				result.SDKPackage = filepath.Base(path)
+				if spec.Name != nil {
+					result.SDKPackage = spec.Name.Name
+				}
+				break
+			}
+		}
+	}
+
+	for _, v := range []struct {
+		f       any
+		h       *handler
+		prefix  string
+		data    string // Name of the model variable in the generated handler.
+		timeout string
+	}{
+		{createFunc, &result.Create, "wait", "data", timeouts.Create},
+		{readFunc, &result.Read, "find", "data", timeouts.Read},
+		{updateFunc, &result.Update, "wait", "new", timeouts.Update},
+		{deleteFunc, &result.Delete, "wait", "data", timeouts.Delete},
+	} {
+		name, _, ok := functionName(v.f)
+		if !ok {
+			continue
+		}
+		decl, ok := pkg.funcs[name]
+		if !ok || decl.Body == nil {
+			continue
+		}
+
+		ast.Inspect(decl.Body, func(n ast.Node) bool {
+			expr, ok := n.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+
+			switch fun := expr.Fun.(type) {
+			case *ast.SelectorExpr:
+				// meta.(*conns.AWSClient).EC2Client(ctx).
+				if _, ok := fun.X.(*ast.TypeAssertExpr); ok && strings.HasSuffix(fun.Sel.Name, "Client") && result.ClientMethod == "" {
+					result.ClientMethod = fun.Sel.Name
+				}
+				// conn.CreateVpc(ctx, &input).
+				if x, ok := fun.X.(*ast.Ident); ok && x.Name == "conn" && v.h.APIOperation == "" {
+					v.h.APIOperation = fun.Sel.Name
+				}
+
+			case *ast.Ident:
+				if !strings.HasPrefix(fun.Name, v.prefix) {
+					return true
+				}
+				if decl, ok := pkg.funcs[fun.Name]; ok {
+					c := newCall(decl, v.data, v.timeout)
+					if v.prefix == "find" && v.h.Finder == nil {
+						v.h.Finder = c
+					} else if v.prefix == "wait" && v.h.Waiter == nil {
+						v.h.Waiter = c
+					}
+				}
+			}
+
+			return true
+		})
+	}
+
+	return result, nil
+}
+
+// handlerTimeouts are the names of the timeout variables in the generated CRUD handlers.
+type handlerTimeouts struct {
+	Create string
+	Read   string
+	Update string
+	Delete string
+}
+
+// newCall returns a call to the specified function with arguments mapped from its parameter types.
+// The first string parameter is assumed to be the resource's ID.
+func newCall(decl *ast.FuncDecl, data, timeout string) *call {
+	c := &call{
+		Name: decl.Name.Name,
+	}
+
+	if v := decl.Type.Results; v != nil {
+		c.ResultCount = v.NumFields()
+	}
+
+	var hasID bool
+	for _, field := range decl.Type.Params.List {
+		var arg string
+		switch typ := types.ExprString(field.Type); {
+		case typ == "context.Context":
+			arg = "ctx"
+		case strings.HasSuffix(typ, ".Client"):
+			arg = "conn"
+		case typ == "string" && !hasID:
+			arg = data + ".ID.ValueString()"
+			hasID = true
+		case typ == "time.Duration" && timeout != "":
+			arg = timeout
+			c.UsesTimeout = true
+		case strings.HasPrefix(typ, "..."):
+			// Optional functional options.
+			continue
+		default:
+			arg = fmt.Sprintf("nil /* TODO %s */", typ)
+		}
+
+		n := len(field.Names)
+		if n == 0 {
+			n = 1
+		}
+		for range n {
+			c.Args = append(c.Args, arg)
+		}
+	}
+
+	return c
+}
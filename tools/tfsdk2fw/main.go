@@ -22,12 +22,13 @@ import (
 
 var (
 	dataSourceType = flag.String("data-source", "", "Data Source type")
+	reportFilename = flag.String("report", "", "File to write a report of untranslated behaviors to")
 	resourceType   = flag.String("resource", "", "Resource type")
 )
 
 func usage() {
 	fmt.Fprintf(os.Stderr, "Usage:\n")
-	fmt.Fprintf(os.Stderr, "\ttfsdk2fw [-resource <resource-type>|-data-source <data-source-type>] <package-name> <name> <generated-file>\n\n")
+	fmt.Fprintf(os.Stderr, "\ttfsdk2fw [-resource <resource-type>|-data-source <data-source-type>] [-report <report-file>] <package-name> <name> <generated-file>\n\n")
 }
 
 func main() {
@@ -52,12 +53,14 @@ func main() {
 	// }
 	g := common.NewGenerator()
 	migrator := &migrator{
-		Generator:   g,
-		Name:        name,
-		PackageName: packageName,
+		Generator:      g,
+		Name:           name,
+		PackageName:    packageName,
+		ReportFilename: *reportFilename,
 	}
 
-	p, err := provider.New(context.Background())
+	ctx := context.Background()
+	p, err := provider.New(ctx)
 
 	if err != nil {
 		g.Fatalf(err.Error())
@@ -75,7 +78,8 @@ func main() {
 		migrator.Template = datasourceImpl
 		migrator.TFTypeName = v
 	} else if v := *resourceType; v != "" {
-		resource, ok := p.ResourcesMap[v]
+		// The provider's resources have wrapped CRUD handlers, which hide the implementation.
+		resource, ok := provider.SDKResource(ctx, v)
 
 		if !ok {
 			g.Fatalf("resource type %s not found", v)
@@ -92,13 +96,14 @@ func main() {
 }
 
 type migrator struct {
-	Generator    *common.Generator
-	IsDataSource bool
-	Name         string
-	PackageName  string
-	Resource     *schema.Resource
-	Template     string
-	TFTypeName   string
+	Generator      *common.Generator
+	IsDataSource   bool
+	Name           string
+	PackageName    string
+	ReportFilename string
+	Resource       *schema.Resource
+	Template       string
+	TFTypeName     string
 }
 
 // migrate generates an identical schema into the specified output file.
@@ -125,7 +130,29 @@ func (m *migrator) migrate(outputFilename string) error {
 		return err
 	}
 
-	return d.Write()
+	if err := d.Write(); err != nil {
+		return err
+	}
+
+	for _, v := range templateData.Untranslated {
+		m.Generator.Warnf("%s not translated", v)
+	}
+
+	if m.ReportFilename != "" {
+		m.infof("writing report into %[1]q", m.ReportFilename)
+
+		d := m.Generator.NewUnformattedFileDestination(m.ReportFilename)
+
+		if err := d.BufferTemplate("report", reportImpl, templateData); err != nil {
+			return err
+		}
+
+		if err := d.Write(); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func (m *migrator) generateTemplateData() (*templateData, error) {
@@ -155,11 +182,19 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 		HasTimeouts:                  emitter.HasTimeouts,
 		ImportFrameworkAttr:          emitter.ImportFrameworkAttr,
 		ImportProviderFrameworkTypes: emitter.ImportProviderFrameworkTypes,
+		Models:                       emitter.Models,
 		Name:                         m.Name,
 		PackageName:                  m.PackageName,
 		Schema:                       sbSchema.String(),
 		Struct:                       sbStruct.String(),
 		TFTypeName:                   m.TFTypeName,
+		Untranslated:                 emitter.Untranslated,
+	}
+
+	if !m.IsDataSource {
+		if err := m.generateResourceTemplateData(templateData); err != nil {
+			return nil, err
+		}
 	}
 
 	for _, v := range emitter.FrameworkPlanModifierPackages {
@@ -181,6 +216,93 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 	return templateData, nil
 }
 
+// generateResourceTemplateData adds the template data for a resource's CRUD handlers and state upgraders.
+func (m *migrator) generateResourceTemplateData(templateData *templateData) error {
+	resource := m.Resource
+
+	var timeouts handlerTimeouts
+	if templateData.DefaultCreateTimeout > 0 {
+		timeouts.Create = "createTimeout"
+	}
+	if templateData.DefaultReadTimeout > 0 {
+		timeouts.Read = "readTimeout"
+	}
+	if templateData.DefaultUpdateTimeout > 0 {
+		timeouts.Update = "updateTimeout"
+	}
+	if templateData.DefaultDeleteTimeout > 0 {
+		timeouts.Delete = "deleteTimeout"
+	}
+
+	handlers, err := analyzeCRUD(
+		handlerFunc(resource.CreateWithoutTimeout, resource.CreateContext, resource.Create), //nolint:staticcheck // Legacy handlers are still found in the wild.
+		handlerFunc(resource.ReadWithoutTimeout, resource.ReadContext, resource.Read),       //nolint:staticcheck // Legacy handlers are still found in the wild.
+		handlerFunc(resource.UpdateWithoutTimeout, resource.UpdateContext, resource.Update), //nolint:staticcheck // Legacy handlers are still found in the wild.
+		handlerFunc(resource.DeleteWithoutTimeout, resource.DeleteContext, resource.Delete), //nolint:staticcheck // Legacy handlers are still found in the wild.
+		timeouts,
+	)
+
+	if err != nil {
+		return fmt.Errorf("analyzing CRUD handlers: %w", err)
+	}
+
+	templateData.CRUD = handlers
+
+	if v := handlers.SDKImportPath; v != "" {
+		goImport := goImport{
+			Path: v,
+		}
+		if alias := handlers.SDKPackage; alias != path.Base(v) {
+			goImport.Alias = alias
+		}
+		templateData.GoImports = append(templateData.GoImports, goImport)
+	}
+
+	// Resource-level behaviors.
+	if f := resource.CustomizeDiff; f != nil {
+		templateData.Untranslated = append(templateData.Untranslated, newUntranslated(nil, "CustomizeDiff", f))
+	}
+	if v := resource.Importer; v != nil {
+		f := handlerFunc(v.StateContext, v.State) //nolint:staticcheck // Legacy handlers are still found in the wild.
+		if name, _, _ := functionName(f); name != "ImportStatePassthroughContext" && f != nil {
+			templateData.Untranslated = append(templateData.Untranslated, newUntranslated(nil, "Importer", f))
+		}
+	}
+	if f := resource.MigrateState; f != nil { //nolint:staticcheck // Legacy state migrations are still found in the wild.
+		templateData.Untranslated = append(templateData.Untranslated, newUntranslated(nil, "MigrateState", f))
+	}
+
+	// Existing state, at any Plugin SDK schema version, is upgraded.
+	upgraders := slices.Clone(resource.StateUpgraders)
+	slices.SortFunc(upgraders, func(a, b schema.StateUpgrader) int {
+		return a.Version - b.Version
+	})
+
+	funcs := make([]string, len(upgraders))
+	for i, upgrader := range upgraders {
+		name, _, ok := functionName(upgrader.Upgrade)
+		if !ok {
+			templateData.Untranslated = append(templateData.Untranslated, newUntranslated(nil, fmt.Sprintf("StateUpgraders[%d]", upgrader.Version), upgrader.Upgrade))
+			name = fmt.Sprintf("nil /* TODO StateUpgraders[%d] */", upgrader.Version)
+		}
+		funcs[i] = name
+	}
+
+	// Each prior version is upgraded by its own and all subsequent Plugin SDK state upgraders.
+	for i, upgrader := range upgraders {
+		templateData.StateUpgraders = append(templateData.StateUpgraders, stateUpgrader{
+			Funcs:   funcs[i:],
+			Version: int64(upgrader.Version),
+		})
+	}
+
+	templateData.StateUpgraders = append(templateData.StateUpgraders, stateUpgrader{
+		Version: int64(resource.SchemaVersion),
+	})
+
+	return nil
+}
+
 func (m *migrator) infof(format string, a ...any) {
 	m.Generator.Infof(format, a...)
 }
@@ -200,16 +322,20 @@ type emitter struct {
 	ImportFrameworkAttr           bool
 	ImportProviderFrameworkTypes  bool
 	IsDataSource                  bool
+	Models                        []model // Nested object models, in the order emitted.
 	SchemaWriter                  io.Writer
 	StructWriter                  io.Writer
+	Untranslated                  []untranslated
 }
 
 // emitSchemaForResource generates the Plugin Framework code for a Plugin SDK Resource and emits the generated code to the emitter's Writer.
 func (e *emitter) emitSchemaForResource(resource *schema.Resource) error {
-	if _, ok := resource.Schema["id"]; ok {
+	s := resource.SchemaMap()
+
+	if _, ok := s["id"]; ok {
 		e.warnf("Explicit `id` attribute defined")
 	} else {
-		resource.Schema["id"] = &schema.Schema{
+		s["id"] = &schema.Schema{
 			Type:     schema.TypeString,
 			Optional: e.IsDataSource,
 			Computed: true,
@@ -235,14 +361,16 @@ func (e *emitter) emitSchemaForResource(resource *schema.Resource) error {
 
 	fprintf(e.SchemaWriter, "schema.Schema{\n")
 
-	err := e.emitAttributesAndBlocks(nil, resource.Schema)
+	err := e.emitAttributesAndBlocks(nil, s)
 
 	if err != nil {
 		return err
 	}
 
-	if version := resource.SchemaVersion; version > 0 {
-		fprintf(e.SchemaWriter, "Version:%d,\n", version)
+	// The Plugin Framework schema version is one greater than the Plugin SDK schema version,
+	// so that existing state is upgraded from the Plugin SDK schema.
+	if !e.IsDataSource {
+		fprintf(e.SchemaWriter, "Version:%d,\n", resource.SchemaVersion+1)
 	}
 
 	if description := resource.Description; description != "" {
@@ -294,22 +422,18 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 			}
 		}
 		fprintf(e.SchemaWriter, "%q:", name)
-
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
-		}
+		fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
 
 		if name == "id" && isTopLevelAttribute {
 			fprintf(e.SchemaWriter, "framework.IDAttribute()")
+			fprintf(e.StructWriter, "types.String")
 		} else {
 			if err := e.emitAttributeProperty(append(path, name), property); err != nil {
 				return err
 			}
 		}
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
-		}
+		fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
 
 		fprintf(e.SchemaWriter, ",\n")
 	}
@@ -331,6 +455,7 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 		}
 
 		fprintf(e.SchemaWriter, "%q:", name)
+		fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
 
 		err := e.emitBlockProperty(append(path, name), property)
 
@@ -338,6 +463,7 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 			return err
 		}
 
+		fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
 		fprintf(e.SchemaWriter, ",\n")
 	}
 	if emittedFieldName {
@@ -357,6 +483,31 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	var defaultSpec string
 	var fwPlanModifierPackage, fwPlanModifierType, fwValidatorsPackage, fwValidatorType string
 
+	// Special handling for 'tags' and 'tags_all'.
+	if isTopLevelAttribute && property.Type == schema.TypeMap && (attributeName == "tags" || attributeName == "tags_all") {
+		if v, ok := property.Elem.(*schema.Schema); ok && v.Type == schema.TypeString {
+			e.GoImports = append(e.GoImports, goImport{
+				Path:  "github.com/hashicorp/terraform-provider-aws/internal/tags",
+				Alias: "tftags",
+			})
+
+			if attributeName == "tags" {
+				e.HasTopLevelTagsMap = true
+			} else {
+				e.HasTopLevelTagsAllMap = true
+			}
+
+			if attributeName == "tags" && property.Optional {
+				fprintf(e.SchemaWriter, "tftags.TagsAttribute()")
+			} else {
+				fprintf(e.SchemaWriter, "tftags.TagsAttributeComputedOnly()")
+			}
+			fprintf(e.StructWriter, "tftags.Map")
+
+			return nil
+		}
+	}
+
 	// At this point we are emitting code for the values of a schema.Schema's Attributes (map[string]schema.Attribute).
 	switch v := property.Type; v {
 	//
@@ -364,30 +515,21 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	//
 	case schema.TypeBool:
 		fprintf(e.SchemaWriter, "schema.BoolAttribute{\n")
-
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Bool")
-		}
+		fprintf(e.StructWriter, "types.Bool")
 
 		fwPlanModifierPackage = "boolplanmodifier"
 		fwPlanModifierType = "Bool"
 
 	case schema.TypeFloat:
 		fprintf(e.SchemaWriter, "schema.Float64Attribute{\n")
-
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Float64")
-		}
+		fprintf(e.StructWriter, "types.Float64")
 
 		fwPlanModifierPackage = "float64planmodifier"
 		fwPlanModifierType = "Float64"
 
 	case schema.TypeInt:
 		fprintf(e.SchemaWriter, "schema.Int64Attribute{\n")
-
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Int64")
-		}
+		fprintf(e.StructWriter, "types.Int64")
 
 		fwPlanModifierPackage = "int64planmodifier"
 		fwPlanModifierType = "Int64"
@@ -399,16 +541,10 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 
 			fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
 			fprintf(e.SchemaWriter, "CustomType:fwtypes.ARNType,\n")
-
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "fwtypes.ARN")
-			}
+			fprintf(e.StructWriter, "fwtypes.ARN")
 		} else {
 			fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
-
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.String")
-			}
+			fprintf(e.StructWriter, "types.String")
 		}
 
 		fwPlanModifierPackage = "stringplanmodifier"
@@ -418,17 +554,14 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	// Complex types.
 	//
 	case schema.TypeList, schema.TypeMap, schema.TypeSet:
-		var aggregateSchemaFactory, typeName string
+		var aggregateSchemaFactory, aggregateType, typeName string
 
 		switch v {
 		case schema.TypeList:
 			aggregateSchemaFactory = "schema.ListAttribute{"
+			aggregateType = "List"
 			typeName = "list"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.List")
-			}
-
 			fwPlanModifierPackage = "listplanmodifier"
 			fwPlanModifierType = "List"
 			fwValidatorsPackage = "listvalidator"
@@ -436,12 +569,9 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 
 		case schema.TypeMap:
 			aggregateSchemaFactory = "schema.MapAttribute{"
+			aggregateType = "Map"
 			typeName = "map"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Map")
-			}
-
 			fwPlanModifierPackage = "mapplanmodifier"
 			fwPlanModifierType = "Map"
 			fwValidatorsPackage = "mapvalidator"
@@ -449,12 +579,9 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 
 		case schema.TypeSet:
 			aggregateSchemaFactory = "schema.SetAttribute{"
+			aggregateType = "Set"
 			typeName = "set"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Set")
-			}
-
 			fwPlanModifierPackage = "setplanmodifier"
 			fwPlanModifierType = "Set"
 			fwValidatorsPackage = "setvalidator"
@@ -463,52 +590,65 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 
 		switch v := property.Elem.(type) {
 		case *schema.Schema:
-			var elementType string
+			var elementType, valueType string
 
 			switch v := v.Type; v {
 			case schema.TypeBool:
 				elementType = "types.BoolType"
+				valueType = "types.Bool"
 
 			case schema.TypeFloat:
 				elementType = "types.Float64Type"
+				valueType = "types.Float64"
 
 			case schema.TypeInt:
 				elementType = "types.Int64Type"
+				valueType = "types.Int64"
 
 			case schema.TypeString:
 				elementType = "types.StringType"
-				// Special handling for 'tags' and 'tags_all'.
-				if typeName == "map" && isTopLevelAttribute {
-					if attributeName == "tags" {
-						e.HasTopLevelTagsMap = true
-						if property.Optional {
-							fprintf(e.SchemaWriter, "// TODO tftags.TagsAttribute()\n")
-						} else if property.Computed {
-							fprintf(e.SchemaWriter, "// TODO tftags.TagsAttributeComputedOnly()\n")
-						}
-					} else if attributeName == "tags_all" {
-						e.HasTopLevelTagsAllMap = true
-						fprintf(e.SchemaWriter, "// TODO tftags.TagsAttributeComputedOnly()\n")
-					}
-				}
 
 			default:
 				return unsupportedTypeError(path, fmt.Sprintf("(Attribute) %s of %s", typeName, v.String()))
 			}
 
+			e.ImportProviderFrameworkTypes = true
+
 			fprintf(e.SchemaWriter, "%s\n", aggregateSchemaFactory)
+			if valueType == "" {
+				fprintf(e.SchemaWriter, "CustomType:fwtypes.%sOfStringType,\n", aggregateType)
+				fprintf(e.StructWriter, "fwtypes.%sOfString", aggregateType)
+			} else {
+				fprintf(e.SchemaWriter, "CustomType:fwtypes.New%sTypeOf[%s](ctx),\n", aggregateType, valueType)
+				fprintf(e.StructWriter, "fwtypes.%sValueOf[%s]", aggregateType, valueType)
+			}
 			fprintf(e.SchemaWriter, "ElementType:%s,\n", elementType)
 
 		case *schema.Resource:
 			// We get here for Computed-only nested blocks or when ConfigMode is SchemaConfigModeBlock.
 			fprintf(e.SchemaWriter, "%s\n", aggregateSchemaFactory)
-			fprintf(e.SchemaWriter, "ElementType:")
 
-			if err := e.emitComputedOnlyBlock(path, v.Schema); err != nil {
-				return err
-			}
+			if property.Type == schema.TypeMap {
+				fprintf(e.SchemaWriter, "ElementType:")
+
+				if err := e.emitComputedOnlyBlock(path, v.Schema); err != nil {
+					return err
+				}
+
+				fprintf(e.SchemaWriter, ",\n")
+				fprintf(e.StructWriter, "types.Map")
+			} else {
+				modelName := modelName(path)
+				e.ImportProviderFrameworkTypes = true
+
+				fprintf(e.SchemaWriter, "CustomType:fwtypes.New%sNestedObjectTypeOf[%s](ctx),\n", aggregateType, modelName)
+				fprintf(e.SchemaWriter, "ElementType:fwtypes.NewObjectTypeOf[%s](ctx),\n", modelName)
+				fprintf(e.StructWriter, "fwtypes.%sNestedObjectValueOf[%s]", aggregateType, modelName)
 
-			fprintf(e.SchemaWriter, ",\n")
+				if err := e.emitComputedOnlyModel(path, modelName, v.Schema); err != nil {
+					return err
+				}
+			}
 
 		default:
 			return unsupportedTypeError(path, fmt.Sprintf("(Attribute) %s of %T", typeName, v))
@@ -587,7 +727,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			})
 			defaultSpec = fmt.Sprintf("stringdefault.StaticString(%q)", v)
 		default:
-			fprintf(e.SchemaWriter, "// TODO Default: %#[1]v (%[1]T),\n", def)
+			e.emitUntranslated(path, fmt.Sprintf("Default: %#[1]v (%[1]T)", def), nil)
 		}
 	}
 
@@ -604,10 +744,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	}
 
 	// Features that we can't (yet) migrate:
-
-	if property.ValidateFunc != nil || property.ValidateDiagFunc != nil {
-		fprintf(e.SchemaWriter, "// TODO Validate,\n")
-	}
+	e.emitUntranslatedBehaviors(path, property)
 
 	fprintf(e.SchemaWriter, "}")
 
@@ -633,10 +770,17 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 			fwValidatorsPackage = "listvalidator"
 			fwValidatorType = "List"
 
+			modelName := modelName(path)
+			e.ImportProviderFrameworkTypes = true
+
 			fprintf(e.SchemaWriter, "schema.ListNestedBlock{\n")
+			fprintf(e.SchemaWriter, "CustomType:fwtypes.NewListNestedObjectTypeOf[%s](ctx),\n", modelName)
 			fprintf(e.SchemaWriter, "NestedObject:schema.NestedBlockObject{\n")
+			fprintf(e.StructWriter, "fwtypes.ListNestedObjectValueOf[%s]", modelName)
 
-			err := e.emitAttributesAndBlocks(path, v.Schema)
+			err := e.emitModel(modelName, func() error {
+				return e.emitAttributesAndBlocks(path, v.Schema)
+			})
 
 			if err != nil {
 				return err
@@ -656,10 +800,17 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 			fwValidatorsPackage = "setvalidator"
 			fwValidatorType = "Set"
 
+			modelName := modelName(path)
+			e.ImportProviderFrameworkTypes = true
+
 			fprintf(e.SchemaWriter, "schema.SetNestedBlock{\n")
+			fprintf(e.SchemaWriter, "CustomType:fwtypes.NewSetNestedObjectTypeOf[%s](ctx),\n", modelName)
 			fprintf(e.SchemaWriter, "NestedObject:schema.NestedBlockObject{\n")
+			fprintf(e.StructWriter, "fwtypes.SetNestedObjectValueOf[%s]", modelName)
 
-			err := e.emitAttributesAndBlocks(path, v.Schema)
+			err := e.emitModel(modelName, func() error {
+				return e.emitAttributesAndBlocks(path, v.Schema)
+			})
 
 			if err != nil {
 				return err
@@ -726,6 +877,9 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 		e.warnf("Block %s has non-nil Default: %v", strings.Join(path, "/"), def)
 	}
 
+	// Features that we can't (yet) migrate:
+	e.emitUntranslatedBehaviors(path, property)
+
 	fprintf(e.SchemaWriter, "}")
 
 	return nil
@@ -854,6 +1008,91 @@ func (e *emitter) emitComputedOnlyBlockProperty(path []string, property *schema.
 	return nil
 }
 
+// emitModel generates the model struct for a nested object.
+// Struct fields emitted by `f` are written to the model.
+func (e *emitter) emitModel(name string, f func() error) error {
+	sb := strings.Builder{}
+	w := e.StructWriter
+	e.StructWriter = &sb
+	defer func() {
+		e.StructWriter = w
+	}()
+
+	if err := f(); err != nil {
+		return err
+	}
+
+	e.Models = append(e.Models, model{
+		Name:   name,
+		Struct: sb.String(),
+	})
+
+	return nil
+}
+
+// emitComputedOnlyModel generates the model struct for a Plugin SDK Computed-only nested block.
+// The block's element type is derived from the model, so the schema code is discarded.
+func (e *emitter) emitComputedOnlyModel(path []string, name string, schema map[string]*schema.Schema) error {
+	w := e.SchemaWriter
+	frameworkPlanModifierPackages, frameworkValidatorsPackages := e.FrameworkPlanModifierPackages, e.FrameworkValidatorsPackages
+	e.SchemaWriter = io.Discard
+	defer func() {
+		e.SchemaWriter = w
+		e.FrameworkPlanModifierPackages, e.FrameworkValidatorsPackages = frameworkPlanModifierPackages, frameworkValidatorsPackages
+	}()
+
+	return e.emitModel(name, func() error {
+		return e.emitAttributesAndBlocks(path, schema)
+	})
+}
+
+// emitUntranslatedBehaviors records the Plugin SDK property's behaviors that have no generated
+// Plugin Framework equivalent and emits a TODO comment for each.
+func (e *emitter) emitUntranslatedBehaviors(path []string, property *schema.Schema) {
+	if f := property.DiffSuppressFunc; f != nil {
+		e.emitUntranslated(path, "DiffSuppressFunc", f)
+	}
+	if f := property.StateFunc; f != nil {
+		e.emitUntranslated(path, "StateFunc", f)
+	}
+	if f := property.Set; f != nil {
+		// The default hash functions need no translation.
+		if name, _, _ := functionName(f); name != "HashString" && name != "HashInt" && name != "HashSchema" {
+			e.emitUntranslated(path, "Set", f)
+		}
+	}
+	if f := property.ValidateFunc; f != nil {
+		e.emitUntranslated(path, "ValidateFunc", f)
+	}
+	if f := property.ValidateDiagFunc; f != nil {
+		e.emitUntranslated(path, "ValidateDiagFunc", f)
+	}
+	if f := property.DefaultFunc; f != nil {
+		e.emitUntranslated(path, "DefaultFunc", f)
+	}
+	for _, v := range []struct {
+		behavior string
+		paths    []string
+	}{
+		{"AtLeastOneOf", property.AtLeastOneOf},
+		{"ConflictsWith", property.ConflictsWith},
+		{"ExactlyOneOf", property.ExactlyOneOf},
+		{"RequiredWith", property.RequiredWith},
+	} {
+		if len(v.paths) > 0 {
+			e.emitUntranslated(path, fmt.Sprintf("%s: %s", v.behavior, strings.Join(v.paths, ", ")), nil)
+		}
+	}
+}
+
+// emitUntranslated records a behavior that has no generated Plugin Framework equivalent and emits a TODO comment.
+func (e *emitter) emitUntranslated(path []string, behavior string, f any) {
+	v := newUntranslated(path, behavior, f)
+	e.Untranslated = append(e.Untranslated, v)
+
+	fprintf(e.SchemaWriter, "// TODO %s\n", v.Description())
+}
+
 // warnf emits a formatted warning message to the UI.
 func (e *emitter) warnf(format string, a ...any) {
 	e.Generator.Warnf(format, a...)
@@ -901,7 +1140,64 @@ func unsupportedTypeError(path []string, typ string) error {
 	return fmt.Errorf("%s is of unsupported type: %s", strings.Join(path, "/"), typ)
 }
 
+// modelName returns the name of the model struct for the nested object at the specified path.
+func modelName(path []string) string {
+	s := naming.ToCamelCase(strings.Join(path, "_"))
+
+	return strings.ToLower(s[:1]) + s[1:] + "Model"
+}
+
+// model is the struct for a nested object.
+type model struct {
+	Name   string
+	Struct string
+}
+
+// untranslated is a Plugin SDK behavior that has no generated Plugin Framework equivalent.
+type untranslated struct {
+	Behavior string
+	Func     string // Name of the implementing function, if known.
+	Path     string // Empty for resource-level behaviors.
+}
+
+func newUntranslated(path []string, behavior string, f any) untranslated {
+	v := untranslated{
+		Behavior: behavior,
+		Path:     strings.Join(path, "."),
+	}
+
+	if name, _, ok := functionName(f); ok {
+		v.Func = name
+	}
+
+	return v
+}
+
+// Description returns a description of the behavior, without its path.
+func (u untranslated) Description() string {
+	if u.Func != "" {
+		return fmt.Sprintf("%s (%s)", u.Behavior, u.Func)
+	}
+
+	return u.Behavior
+}
+
+func (u untranslated) String() string {
+	if u.Path == "" {
+		return u.Description()
+	}
+
+	return fmt.Sprintf("%s: %s", u.Path, u.Description())
+}
+
+// stateUpgrader upgrades prior state at a Plugin SDK schema version.
+type stateUpgrader struct {
+	Funcs   []string // Names of the Plugin SDK state upgrade functions, in order.
+	Version int64
+}
+
 type templateData struct {
+	CRUD                          *crud
 	DefaultCreateTimeout          int64
 	DefaultReadTimeout            int64
 	DefaultUpdateTimeout          int64
@@ -915,11 +1211,21 @@ type templateData struct {
 	HasTimeouts                   bool
 	ImportFrameworkAttr           bool
 	ImportProviderFrameworkTypes  bool
+	Models                        []model
 	Name                          string // e.g. Instance
 	PackageName                   string // e.g. ec2
 	Schema                        string
+	StateUpgraders                []stateUpgrader
 	Struct                        string
 	TFTypeName                    string // e.g. aws_instance
+	Untranslated                  []untranslated
+}
+
+// ResourceUntranslated returns the untranslated resource-level behaviors.
+func (d *templateData) ResourceUntranslated() []untranslated {
+	return slices.DeleteFunc(slices.Clone(d.Untranslated), func(v untranslated) bool {
+		return v.Path != ""
+	})
 }
 
 //go:embed datasource.gtpl
@@ -928,6 +1234,9 @@ var datasourceImpl string
 //go:embed resource.gtpl
 var resourceImpl string
 
+//go:embed report.gtpl
+var reportImpl string
+
 type goImport struct {
 	Path  string
 	Alias string